@@ -120,6 +120,26 @@ type AppMetadata struct {
 	Custom map[string]string
 }
 
+// MemoryWarningLevel describes the severity of a low memory condition reported to
+// the Lifecycle memory warning hook.
+//
+// Since: 2.6
+type MemoryWarningLevel int
+
+const (
+	// MemoryWarningLow indicates that memory is becoming scarce and apps should release
+	// what they easily can, such as prefetched data.
+	//
+	// Since: 2.6
+	MemoryWarningLow MemoryWarningLevel = iota
+
+	// MemoryWarningCritical indicates that the app may be terminated if memory usage
+	// does not drop, so apps should free everything that can be recomputed.
+	//
+	// Since: 2.6
+	MemoryWarningCritical
+)
+
 // Lifecycle represents the various phases that an app can transition through.
 //
 // Since: 2.1
@@ -132,4 +152,10 @@ type Lifecycle interface {
 	SetOnStarted(func())
 	// SetOnStopped hooks into an event that says the app is no longer running.
 	SetOnStopped(func())
+	// SetOnMemoryWarning hooks into the system reporting that memory is running low,
+	// either through an OS warning on mobile or a heuristic on desktop. Fyne shrinks
+	// its internal caches before this hook is called.
+	//
+	// Since: 2.6
+	SetOnMemoryWarning(func(level MemoryWarningLevel))
 }
@@ -3,6 +3,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"os"
@@ -97,5 +98,7 @@ func runScript(name, script string) {
 }
 
 func watchTheme(s *settings) {
-	go internalapp.WatchTheme(s.setupTheme)
+	internalapp.WatchTheme(context.Background(), func(internalapp.ThemeSetting) {
+		s.setupTheme()
+	})
 }
@@ -12,13 +12,10 @@ import (
 	"github.com/godbus/dbus/v5"
 	"github.com/rymdport/portal/notification"
 	"github.com/rymdport/portal/openuri"
-	portalSettings "github.com/rymdport/portal/settings"
-	"github.com/rymdport/portal/settings/appearance"
 
 	"fyne.io/fyne/v2"
 	internalapp "fyne.io/fyne/v2/internal/app"
 	"fyne.io/fyne/v2/internal/build"
-	"fyne.io/fyne/v2/theme"
 )
 
 func (a *fyneApp) OpenURL(url *url.URL) error {
@@ -35,28 +32,6 @@ func (a *fyneApp) OpenURL(url *url.URL) error {
 	return cmd.Start()
 }
 
-// fetch color variant from dbus portal desktop settings.
-func findFreedesktopColorScheme() fyne.ThemeVariant {
-	colorScheme, err := appearance.GetColorScheme()
-	if err != nil {
-		return theme.VariantDark
-	}
-
-	return colorSchemeToThemeVariant(colorScheme)
-}
-
-func colorSchemeToThemeVariant(colorScheme appearance.ColorScheme) fyne.ThemeVariant {
-	switch colorScheme {
-	case appearance.Light:
-		return theme.VariantLight
-	case appearance.Dark:
-		return theme.VariantDark
-	}
-
-	// Default to light theme to support Gnome's default see https://github.com/fyne-io/fyne/pull/3561
-	return theme.VariantLight
-}
-
 func (a *fyneApp) SendNotification(n *fyne.Notification) {
 	if build.IsFlatpak {
 		err := a.sendNotificationThroughPortal(n)
@@ -120,17 +95,5 @@ func rootConfigDir() string {
 }
 
 func watchTheme(s *settings) {
-	go func() {
-		// Theme lookup hangs on some desktops. Update theme variant cache from within goroutine.
-		internalapp.CurrentVariant.Store(uint64(findFreedesktopColorScheme()))
-		s.setupTheme()
-
-		portalSettings.OnSignalSettingChanged(func(changed portalSettings.Changed) {
-			if changed.Namespace == "org.freedesktop.appearance" && changed.Key == "color-scheme" {
-				themeVariant := colorSchemeToThemeVariant(appearance.ColorScheme(changed.Value.(uint32)))
-				internalapp.CurrentVariant.Store(uint64(themeVariant))
-				s.setupTheme()
-			}
-		})
-	}()
+	go internalapp.WatchTheme(s.setupTheme)
 }
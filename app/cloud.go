@@ -1,6 +1,10 @@
 package app
 
-import "fyne.io/fyne/v2"
+import (
+	"reflect"
+
+	"fyne.io/fyne/v2"
+)
 
 func (a *fyneApp) SetCloudProvider(p fyne.CloudProvider) {
 	if p == nil {
@@ -27,7 +31,11 @@ func (a *fyneApp) transitionCloud(p fyne.CloudProvider) {
 	if pp, ok := p.(fyne.CloudProviderPreferences); ok {
 		a.prefs = pp.CloudPreferences(a)
 	} else {
-		a.prefs = a.newDefaultPreferences()
+		prefs := a.newDefaultPreferences()
+		a.prefs = prefs
+		if sync, ok := p.(fyne.CloudProviderPreferenceSync); ok {
+			a.syncCloudPreferences(sync, prefs)
+		}
 	}
 	if cloud, ok := p.(fyne.CloudProviderStorage); ok {
 		a.storage = cloud.CloudStorage(a)
@@ -45,3 +53,37 @@ func (a *fyneApp) transitionCloud(p fyne.CloudProvider) {
 	// after transition ensure settings listener is fired
 	a.settings.apply()
 }
+
+// syncCloudPreferences merges the remotely stored values into the local preferences,
+// asking the provider to resolve any conflicting keys, then writes changes back as they happen.
+func (a *fyneApp) syncCloudPreferences(p fyne.CloudProviderPreferenceSync, prefs *preferences) {
+	remote, err := p.ReadPreferenceValues(a)
+	if err != nil {
+		fyne.LogError("Failed to read preferences from cloud provider", err)
+		return
+	}
+
+	prefs.WriteValues(func(values map[string]any) {
+		for key, remoteValue := range remote {
+			if local, ok := values[key]; ok && !reflect.DeepEqual(local, remoteValue) {
+				values[key] = p.ResolvePreferenceConflict(key, local, remoteValue)
+				continue
+			}
+			values[key] = remoteValue
+		}
+	})
+
+	prefs.AddChangeListener(func() {
+		out := map[string]any{}
+		prefs.ReadValues(func(values map[string]any) {
+			for key, value := range values {
+				out[key] = value
+			}
+		})
+
+		err := p.WritePreferenceValues(a, out)
+		if err != nil {
+			fyne.LogError("Failed to write preferences to cloud provider", err)
+		}
+	})
+}
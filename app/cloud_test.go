@@ -77,6 +77,19 @@ func TestFyneApp_transitionCloud_Storage(t *testing.T) {
 	assert.Equal(t, 0, len(l))
 }
 
+func TestFyneApp_transitionCloud_PreferenceSync(t *testing.T) {
+	a := NewWithID("io.fyne.test")
+	p := &mockSyncCloud{remote: map[string]any{"remoteKey": "remoteValue"}}
+	a.SetCloudProvider(p)
+
+	assert.Equal(t, "remoteValue", a.Preferences().String("remoteKey"))
+
+	a.Preferences().SetString("localKey", "localValue")
+
+	assert.Equal(t, "localValue", p.written["localKey"])
+	assert.Equal(t, "remoteValue", p.written["remoteKey"])
+}
+
 type mockCloud struct {
 	configured, cleaned bool
 }
@@ -110,6 +123,43 @@ func (c *mockCloud) Setup(_ fyne.App) error {
 	return nil
 }
 
+type mockSyncCloud struct {
+	remote  map[string]any
+	written map[string]any
+}
+
+func (c *mockSyncCloud) Cleanup(_ fyne.App) {
+}
+
+func (c *mockSyncCloud) ProviderDescription() string {
+	return "Mock cloud preference sync implementation"
+}
+
+func (c *mockSyncCloud) ProviderIcon() fyne.Resource {
+	return theme.ComputerIcon()
+}
+
+func (c *mockSyncCloud) ProviderName() string {
+	return "mockSync"
+}
+
+func (c *mockSyncCloud) Setup(_ fyne.App) error {
+	return nil
+}
+
+func (c *mockSyncCloud) ReadPreferenceValues(_ fyne.App) (map[string]any, error) {
+	return c.remote, nil
+}
+
+func (c *mockSyncCloud) WritePreferenceValues(_ fyne.App, values map[string]any) error {
+	c.written = values
+	return nil
+}
+
+func (c *mockSyncCloud) ResolvePreferenceConflict(_ string, local, _ any) any {
+	return local
+}
+
 type mockCloudStorage struct {
 }
 
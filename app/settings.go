@@ -9,6 +9,7 @@ import (
 	"fyne.io/fyne/v2"
 	internalapp "fyne.io/fyne/v2/internal/app"
 	"fyne.io/fyne/v2/internal/build"
+	internaltheme "fyne.io/fyne/v2/internal/theme"
 	"fyne.io/fyne/v2/theme"
 )
 
@@ -23,6 +24,7 @@ type SettingsSchema struct {
 	CloudName         string  `json:"cloud_name"`
 	CloudConfig       string  `json:"cloud_config"`
 	DisableAnimations bool    `json:"no_animations"`
+	AnimationScale    float32 `json:"animation_scale"`
 }
 
 // StoragePath returns the location of the settings storage
@@ -38,6 +40,7 @@ type settings struct {
 	theme          fyne.Theme
 	themeSpecified bool
 	variant        fyne.ThemeVariant
+	reducedMotion  bool
 
 	changeListeners sync.Map // map[chan fyne.Settings]bool
 	watcher         any      // normally *fsnotify.Watcher or nil - avoid import in this file
@@ -85,6 +88,25 @@ func (s *settings) ShowAnimations() bool {
 	return !s.schema.DisableAnimations && !noAnimations
 }
 
+func (s *settings) ReducedMotion() bool {
+	if !s.ShowAnimations() {
+		return true
+	}
+
+	s.propertyLock.RLock()
+	defer s.propertyLock.RUnlock()
+	return s.reducedMotion
+}
+
+func (s *settings) AnimationScale() float32 {
+	s.propertyLock.RLock()
+	defer s.propertyLock.RUnlock()
+	if s.schema.AnimationScale <= 0 {
+		return 1.0 // unset data defaults to normal speed
+	}
+	return s.schema.AnimationScale
+}
+
 func (s *settings) ThemeVariant() fyne.ThemeVariant {
 	return s.variant
 }
@@ -154,6 +176,12 @@ func (s *settings) setupTheme() {
 	}
 
 	variant := internalapp.DefaultVariant()
+	if accent, ok := internalapp.SystemAccentColor(); ok {
+		internaltheme.SetSystemAccentColor(accent)
+	}
+	s.propertyLock.Lock()
+	s.reducedMotion = internalapp.ReducedMotion()
+	s.propertyLock.Unlock()
 	effectiveTheme := s.theme
 	if !s.themeSpecified {
 		effectiveTheme = s.loadSystemTheme()
@@ -41,6 +41,26 @@ func TestSettingsLoad(t *testing.T) {
 	assert.Equal(t, "dark", settings.schema.ThemeName)
 }
 
+func TestSettingsAnimationScale(t *testing.T) {
+	set := &settings{}
+	assert.Equal(t, float32(1.0), set.AnimationScale()) // unset data defaults to normal speed
+
+	set.schema.AnimationScale = 2
+	assert.Equal(t, float32(2.0), set.AnimationScale())
+}
+
+func TestSettingsReducedMotion(t *testing.T) {
+	set := &settings{}
+	assert.False(t, set.ReducedMotion())
+
+	set.reducedMotion = true
+	assert.True(t, set.ReducedMotion())
+
+	set.reducedMotion = false
+	set.schema.DisableAnimations = true // disabled animations imply reduced motion
+	assert.True(t, set.ReducedMotion())
+}
+
 func TestOverrideTheme(t *testing.T) {
 	set := &settings{}
 	set.setupTheme()
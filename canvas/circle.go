@@ -19,6 +19,17 @@ type Circle struct {
 	FillColor   color.Color // The circle fill color
 	StrokeColor color.Color // The circle stroke color
 	StrokeWidth float32     // The stroke width of the circle
+
+	// StrokeDashPattern is a sequence of alternating dash and gap lengths used to
+	// stroke the circle. An empty slice draws a solid stroke.
+	//
+	// Since: 2.6
+	StrokeDashPattern []float32
+
+	// StrokeDashOffset is the distance into the dash pattern at which the stroke starts.
+	//
+	// Since: 2.6
+	StrokeDashOffset float32
 }
 
 // NewCircle returns a new Circle instance
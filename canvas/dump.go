@@ -0,0 +1,64 @@
+package canvas
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal/cache"
+)
+
+// TreeNode describes a single canvas object within a dump written by DumpTree.
+//
+// Since: 2.6
+type TreeNode struct {
+	Type     string      `json:"type"`
+	X        float32     `json:"x"`
+	Y        float32     `json:"y"`
+	Width    float32     `json:"width"`
+	Height   float32     `json:"height"`
+	Visible  bool        `json:"visible"`
+	Rendered bool        `json:"rendered,omitempty"`
+	Children []*TreeNode `json:"children,omitempty"`
+}
+
+// DumpTree writes a JSON description of the object tree rooted at the passed object,
+// recording the type, position, size and visibility of every object along with whether
+// a renderer is currently cached for it. The output is intended for debugging layout
+// issues and attaching to bug reports - the test package can reconstruct a placeholder
+// layout from it using LoadTree.
+//
+// Since: 2.6
+func DumpTree(w io.Writer, root fyne.CanvasObject) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(dumpObject(root))
+}
+
+func dumpObject(o fyne.CanvasObject) *TreeNode {
+	pos, size := o.Position(), o.Size()
+	node := &TreeNode{
+		Type:    fmt.Sprintf("%T", o),
+		X:       pos.X,
+		Y:       pos.Y,
+		Width:   size.Width,
+		Height:  size.Height,
+		Visible: o.Visible(),
+	}
+
+	var children []fyne.CanvasObject
+	switch c := o.(type) {
+	case *fyne.Container:
+		children = c.Objects
+	case fyne.Widget:
+		if cache.IsRendered(c) {
+			node.Rendered = true
+			children = cache.Renderer(c).Objects()
+		}
+	}
+	for _, child := range children {
+		node.Children = append(node.Children, dumpObject(child))
+	}
+	return node
+}
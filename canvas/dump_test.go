@@ -0,0 +1,41 @@
+package canvas_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDumpTree(t *testing.T) {
+	rect := canvas.NewRectangle(color.Black)
+	rect.Move(fyne.NewPos(10, 20))
+	rect.Resize(fyne.NewSize(30, 40))
+	circle := canvas.NewCircle(color.White)
+	circle.Hide()
+	root := &fyne.Container{Objects: []fyne.CanvasObject{rect, circle}}
+	root.Resize(fyne.NewSize(100, 100))
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, canvas.DumpTree(buf, root))
+
+	node := &canvas.TreeNode{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), node))
+	assert.Equal(t, "*fyne.Container", node.Type)
+	assert.Equal(t, float32(100), node.Width)
+	assert.True(t, node.Visible)
+	require.Len(t, node.Children, 2)
+
+	assert.Equal(t, "*canvas.Rectangle", node.Children[0].Type)
+	assert.Equal(t, float32(10), node.Children[0].X)
+	assert.Equal(t, float32(20), node.Children[0].Y)
+	assert.Equal(t, float32(30), node.Children[0].Width)
+	assert.Equal(t, float32(40), node.Children[0].Height)
+	assert.False(t, node.Children[1].Visible)
+}
@@ -8,6 +8,15 @@ import (
 	"fyne.io/fyne/v2"
 )
 
+// GradientStop is a color at a normalised position along a gradient.
+// Offsets should be in the range 0 to 1 and listed in ascending order.
+//
+// Since: 2.6
+type GradientStop struct {
+	Color  color.Color // The color at this position of the gradient
+	Offset float32     // The position of this color, 0 at the start through to 1 at the end
+}
+
 // LinearGradient defines a Gradient travelling straight at a given angle.
 // The only supported values for the angle are `0.0` (vertical) and `90.0` (horizontal), currently.
 type LinearGradient struct {
@@ -16,6 +25,12 @@ type LinearGradient struct {
 	StartColor color.Color // The beginning color of the gradient
 	EndColor   color.Color // The end color of the gradient
 	Angle      float64     // The angle of the gradient (0/180 for vertical; 90/270 for horizontal)
+
+	// Stops are intermediate colors along the gradient.
+	// When set they are used instead of StartColor and EndColor.
+	//
+	// Since: 2.6
+	Stops []GradientStop
 }
 
 // Generate calculates an image of the gradient with the specified width and height.
@@ -56,6 +71,9 @@ func (g *LinearGradient) Generate(iw, ih int) image.Image {
 			return y / h
 		}
 	}
+	if len(g.Stops) > 0 {
+		return computeGradientStops(generator, iw, ih, g.Stops)
+	}
 	return computeGradient(generator, iw, ih, g.StartColor, g.EndColor)
 }
 
@@ -99,6 +117,12 @@ type RadialGradient struct {
 	// This is not a DP measure but relates to the width/height.
 	// A value of 0.5 would move the center by the half width/height.
 	CenterOffsetX, CenterOffsetY float64
+
+	// Stops are intermediate colors along the gradient.
+	// When set they are used instead of StartColor and EndColor.
+	//
+	// Since: 2.6
+	Stops []GradientStop
 }
 
 // Generate calculates an image of the gradient with the specified width and height.
@@ -130,6 +154,9 @@ func (g *RadialGradient) Generate(iw, ih int) image.Image {
 		}
 		return da / a
 	}
+	if len(g.Stops) > 0 {
+		return computeGradientStops(generator, iw, ih, g.Stops)
+	}
 	return computeGradient(generator, iw, ih, g.StartColor, g.EndColor)
 }
 
@@ -163,6 +190,78 @@ func (g *RadialGradient) Refresh() {
 	Refresh(g)
 }
 
+// ConicGradient defines a Gradient sweeping around a center point, such as used
+// for progress rings and color wheels.
+//
+// Since: 2.6
+type ConicGradient struct {
+	baseObject
+
+	StartColor color.Color // The color at the start of the sweep
+	EndColor   color.Color // The color at the end of the sweep
+	// StartAngle is the angle in degrees, clockwise from the top, that the sweep begins.
+	StartAngle float64
+	// The offset of the center for generation of the gradient.
+	// This is not a DP measure but relates to the width/height.
+	// A value of 0.5 would move the center by the half width/height.
+	CenterOffsetX, CenterOffsetY float64
+
+	// Stops are intermediate colors along the gradient.
+	// When set they are used instead of StartColor and EndColor.
+	//
+	// Since: 2.6
+	Stops []GradientStop
+}
+
+// Generate calculates an image of the gradient with the specified width and height.
+func (g *ConicGradient) Generate(iw, ih int) image.Image {
+	w, h := float64(iw), float64(ih)
+	centerX := w/2 + w*g.CenterOffsetX
+	centerY := h/2 + h*g.CenterOffsetY
+	start := g.StartAngle * math.Pi / 180
+
+	generator := func(x, y float64) float64 {
+		angle := math.Atan2(x-centerX, centerY-y) - start // clockwise from the top
+		turn := angle / (2 * math.Pi)
+		turn -= math.Floor(turn)
+		return turn
+	}
+	if len(g.Stops) > 0 {
+		return computeGradientStops(generator, iw, ih, g.Stops)
+	}
+	return computeGradient(generator, iw, ih, g.StartColor, g.EndColor)
+}
+
+// Hide will set this gradient to not be visible
+func (g *ConicGradient) Hide() {
+	g.baseObject.Hide()
+
+	repaint(g)
+}
+
+// Move the gradient to a new position, relative to its parent / canvas
+func (g *ConicGradient) Move(pos fyne.Position) {
+	g.baseObject.Move(pos)
+
+	repaint(g)
+}
+
+// Resize resizes the gradient to a new size.
+func (g *ConicGradient) Resize(size fyne.Size) {
+	if size == g.Size() {
+		return
+	}
+	g.baseObject.Resize(size)
+
+	// refresh needed to invalidate cached textures
+	g.Refresh()
+}
+
+// Refresh causes this gradient to be redrawn with its configured state.
+func (g *ConicGradient) Refresh() {
+	Refresh(g)
+}
+
 func calculatePixel(d float64, startColor, endColor color.Color) color.Color {
 	// fetch RGBA values
 	aR, aG, aB, aA := startColor.RGBA()
@@ -205,6 +304,51 @@ func computeGradient(generator func(x, y float64) float64, w, h int, startColor,
 	return img
 }
 
+func computeGradientStops(generator func(x, y float64) float64, w, h int, stops []GradientStop) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+
+	for x := 0; x < w; x++ {
+		for y := 0; y < h; y++ {
+			distance := generator(float64(x)+0.5, float64(y)+0.5)
+			img.Set(x, y, calculateStopPixel(distance, stops))
+		}
+	}
+	return img
+}
+
+func calculateStopPixel(d float64, stops []GradientStop) color.Color {
+	before := stops[0]
+	if d <= float64(before.Offset) {
+		return stopColor(before)
+	}
+
+	for _, after := range stops[1:] {
+		if d <= float64(after.Offset) {
+			span := float64(after.Offset - before.Offset)
+			if span <= 0 {
+				return stopColor(after)
+			}
+			return calculatePixel((d-float64(before.Offset))/span, stopColor(before), stopColor(after))
+		}
+		before = after
+	}
+	return stopColor(before)
+}
+
+func stopColor(stop GradientStop) color.Color {
+	if stop.Color == nil {
+		return color.Transparent
+	}
+	return stop.Color
+}
+
+// NewConicGradient creates a new conic gradient sweeping clockwise from the top.
+//
+// Since: 2.6
+func NewConicGradient(start, end color.Color) *ConicGradient {
+	return &ConicGradient{StartColor: start, EndColor: end}
+}
+
 // NewHorizontalGradient creates a new horizontally travelling linear gradient.
 // The start color will be at the left of the gradient and the end color will be at the right.
 func NewHorizontalGradient(start, end color.Color) *LinearGradient {
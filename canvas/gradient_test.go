@@ -291,6 +291,36 @@ func TestNewRadialGradient(t *testing.T) {
 	assert.Equal(t, color.NRGBA{0, 0, 0, 0x83}, imgCircleOffset.At(1, 5))
 }
 
+func TestNewConicGradient(t *testing.T) {
+	conic := canvas.NewConicGradient(color.Black, color.Transparent)
+
+	img := conic.Generate(9, 9)
+	assert.Equal(t, color.NRGBA{0, 0, 0, 0xff}, img.At(4, 1)) // above center, start of sweep
+	assert.Equal(t, color.NRGBA{0, 0, 0, 0xbf}, img.At(7, 4)) // right, a quarter turn
+	assert.Equal(t, color.NRGBA{0, 0, 0, 0x7f}, img.At(4, 7)) // below, half way
+	assert.Equal(t, color.NRGBA{0, 0, 0, 0x3f}, img.At(1, 4)) // left, three quarters
+
+	conic.StartAngle = 90
+	img = conic.Generate(9, 9)
+	assert.Equal(t, color.NRGBA{0, 0, 0, 0xff}, img.At(7, 4))
+	assert.Equal(t, color.NRGBA{0, 0, 0, 0x7f}, img.At(1, 4))
+}
+
+func TestGradient_Stops(t *testing.T) {
+	horizontal := canvas.NewHorizontalGradient(color.Black, color.White)
+	horizontal.Stops = []canvas.GradientStop{
+		{Color: color.Black, Offset: 0},
+		{Color: color.Transparent, Offset: 0.5},
+		{Color: color.Black, Offset: 1},
+	}
+
+	img := horizontal.Generate(4, 1)
+	assert.Equal(t, color.NRGBA{0, 0, 0, 0xbf}, img.At(0, 0))
+	assert.Equal(t, color.NRGBA{0, 0, 0, 0x3f}, img.At(1, 0))
+	assert.Equal(t, color.NRGBA{0, 0, 0, 0x3f}, img.At(2, 0))
+	assert.Equal(t, color.NRGBA{0, 0, 0, 0xbf}, img.At(3, 0))
+}
+
 func TestGradient_colorComputation(t *testing.T) {
 	bg := internalTest.NewCheckedImage(50, 50, 1, 2)
 	bounds := image.Rect(0, 0, 49, 49)
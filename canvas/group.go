@@ -0,0 +1,79 @@
+package canvas
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// Declare conformity with CanvasObject interface
+var _ fyne.CanvasObject = (*Group)(nil)
+
+// Group wraps another canvas object, clipping its drawing to the bounds of this
+// object and applying an opacity to everything drawn within it. The content is
+// rasterized when drawn, so after changing it call Refresh on the group to redraw.
+// Interactive content within the bounds receives events as normal.
+//
+// Since: 2.6
+type Group struct {
+	baseObject
+
+	// Content is the object drawn clipped and faded by this group.
+	Content fyne.CanvasObject
+
+	// CornerRadius rounds the corners of the clip region.
+	//
+	// Since: 2.6
+	CornerRadius float32
+
+	// Translucency is used to fade the whole group, 0 is fully opaque and 1 is invisible.
+	Translucency float64
+}
+
+// NewGroup returns a new Group instance that clips the drawing of the passed
+// object to the group bounds.
+//
+// Since: 2.6
+func NewGroup(content fyne.CanvasObject) *Group {
+	return &Group{Content: content}
+}
+
+// Hide will set this group and its content to not be visible
+func (g *Group) Hide() {
+	g.baseObject.Hide()
+
+	repaint(g)
+}
+
+// MinSize returns the minimum size of the content, the group never clips that away.
+func (g *Group) MinSize() fyne.Size {
+	if g.Content == nil {
+		return fyne.NewSize(1, 1)
+	}
+
+	return g.Content.MinSize()
+}
+
+// Move the group to a new position, relative to its parent / canvas
+func (g *Group) Move(pos fyne.Position) {
+	g.baseObject.Move(pos)
+
+	repaint(g)
+}
+
+// Refresh causes this group to be redrawn with its configured state.
+func (g *Group) Refresh() {
+	Refresh(g)
+}
+
+// Resize on a group updates the size of this object and its content.
+func (g *Group) Resize(s fyne.Size) {
+	if s == g.Size() {
+		return
+	}
+
+	g.baseObject.Resize(s)
+	if g.Content != nil {
+		g.Content.Resize(s)
+	}
+
+	Refresh(g)
+}
@@ -0,0 +1,36 @@
+package canvas_test
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGroup(t *testing.T) {
+	rect := canvas.NewRectangle(color.Black)
+	group := canvas.NewGroup(rect)
+
+	assert.Equal(t, rect, group.Content)
+	assert.Equal(t, float64(0), group.Translucency)
+}
+
+func TestGroup_MinSize(t *testing.T) {
+	rect := canvas.NewRectangle(color.Black)
+	rect.SetMinSize(fyne.NewSize(30, 20))
+	group := canvas.NewGroup(rect)
+
+	assert.Equal(t, fyne.NewSize(30, 20), group.MinSize())
+}
+
+func TestGroup_Resize(t *testing.T) {
+	rect := canvas.NewRectangle(color.Black)
+	group := canvas.NewGroup(rect)
+	group.Resize(fyne.NewSize(50, 30))
+
+	assert.Equal(t, fyne.NewSize(50, 30), group.Size())
+	assert.Equal(t, fyne.NewSize(50, 30), rect.Size())
+}
@@ -48,6 +48,12 @@ const (
 	//
 	// Since: 2.0
 	ImageScaleFastest
+	// ImageScaleTrilinear will scale the image using mipmaps with linear interpolation
+	// between the nearest levels, best for photographs that are heavily downscaled.
+	// Platforms that cannot provide mipmaps fall back to ImageScaleSmooth.
+	//
+	// Since: 2.6
+	ImageScaleTrilinear
 )
 
 // Declare conformity with CanvasObject interface
@@ -72,6 +78,22 @@ type Image struct {
 	Translucency float64    // Set a translucency value > 0.0 to fade the image
 	FillMode     ImageFill  // Specify how the image should expand to fill or fit the available space
 	ScaleMode    ImageScale // Specify the type of scaling interpolation applied to the image
+
+	// CapInsets defines the edge regions, in source image pixels, that are not stretched
+	// when this image is scaled (often called 9-patch scaling). The corner regions are
+	// drawn unscaled, the edges stretch along one axis only and the center fills the rest,
+	// keeping skinned buttons, bubbles and frames crisp. Only applies to bitmap images.
+	//
+	// Since: 2.6
+	CapInsets CapInsets
+}
+
+// CapInsets describes the margins of a bitmap image that keep their size when the
+// image is stretched, for use in the CapInsets field of an Image.
+//
+// Since: 2.6
+type CapInsets struct {
+	Top, Right, Bottom, Left float32
 }
 
 // Alpha is a convenience function that returns the alpha value for an image
@@ -20,6 +20,17 @@ type Line struct {
 
 	StrokeColor color.Color // The line stroke color
 	StrokeWidth float32     // The stroke width of the line
+
+	// StrokeDashPattern is a sequence of alternating dash and gap lengths used to
+	// stroke the line. An empty slice draws a solid stroke.
+	//
+	// Since: 2.6
+	StrokeDashPattern []float32
+
+	// StrokeDashOffset is the distance into the dash pattern at which the stroke starts.
+	//
+	// Since: 2.6
+	StrokeDashOffset float32
 }
 
 // Size returns the current size of bounding box for this line object
@@ -0,0 +1,223 @@
+package canvas
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// Declare conformity with CanvasObject interface
+var _ fyne.CanvasObject = (*Path)(nil)
+
+// LineCap describes how the ends of an open stroke are drawn.
+//
+// Since: 2.6
+type LineCap int
+
+const (
+	// LineCapButt ends a stroke squarely at the end point.
+	//
+	// Since: 2.6
+	LineCapButt LineCap = iota
+
+	// LineCapRound ends a stroke with a semicircle centered on the end point.
+	//
+	// Since: 2.6
+	LineCapRound
+
+	// LineCapSquare ends a stroke with a half square extending past the end point.
+	//
+	// Since: 2.6
+	LineCapSquare
+)
+
+// LineJoin describes how the corner between two stroke segments is drawn.
+//
+// Since: 2.6
+type LineJoin int
+
+const (
+	// LineJoinMiter extends the outer edges of a corner until they meet.
+	//
+	// Since: 2.6
+	LineJoinMiter LineJoin = iota
+
+	// LineJoinRound draws a circular arc around the corner point.
+	//
+	// Since: 2.6
+	LineJoinRound
+
+	// LineJoinBevel connects the outer edges of a corner with a straight line.
+	//
+	// Since: 2.6
+	LineJoinBevel
+)
+
+// PathOp identifies the drawing operation of a single path segment.
+//
+// Since: 2.6
+type PathOp int
+
+const (
+	// PathMoveTo starts a new sub-path at the first point of the segment.
+	//
+	// Since: 2.6
+	PathMoveTo PathOp = iota
+
+	// PathLineTo draws a straight line to the first point of the segment.
+	//
+	// Since: 2.6
+	PathLineTo
+
+	// PathQuadTo draws a quadratic bézier curve through one control point to an end point.
+	//
+	// Since: 2.6
+	PathQuadTo
+
+	// PathCubicTo draws a cubic bézier curve through two control points to an end point.
+	//
+	// Since: 2.6
+	PathCubicTo
+
+	// PathClose joins the current sub-path back to its starting point.
+	//
+	// Since: 2.6
+	PathClose
+)
+
+// PathSegment is a single drawing operation within a Path.
+// The points used depend on the operation - control points come before the end point.
+//
+// Since: 2.6
+type PathSegment struct {
+	Op     PathOp
+	Points [3]fyne.Position
+}
+
+// Path describes a vector drawing primitive in a Fyne canvas, built up from move,
+// line, curve and arc segments. Coordinates are relative to the top-left of the
+// object and content outside its current size will be clipped when drawn.
+//
+// Since: 2.6
+type Path struct {
+	baseObject
+
+	FillColor   color.Color // The path fill color, or nil for no fill
+	StrokeColor color.Color // The path stroke color, or nil for no stroke
+	StrokeWidth float32     // The stroke width of the path
+	StrokeCap   LineCap     // How the ends of open sub-paths are drawn
+	StrokeJoin  LineJoin    // How corners between stroke segments are drawn
+	// Dashes is a sequence of alternating dash and gap lengths used to stroke the
+	// path. An empty slice draws a solid stroke.
+	Dashes []float32
+
+	segments []PathSegment
+}
+
+// NewPath returns a new Path instance with no segments defined.
+//
+// Since: 2.6
+func NewPath() *Path {
+	return &Path{
+		StrokeWidth: 1,
+	}
+}
+
+// MoveTo starts a new sub-path at the given position.
+func (p *Path) MoveTo(x, y float32) {
+	p.segments = append(p.segments, PathSegment{Op: PathMoveTo, Points: [3]fyne.Position{{X: x, Y: y}}})
+}
+
+// LineTo draws a straight line from the current position to the given position.
+func (p *Path) LineTo(x, y float32) {
+	p.segments = append(p.segments, PathSegment{Op: PathLineTo, Points: [3]fyne.Position{{X: x, Y: y}}})
+}
+
+// QuadTo draws a quadratic bézier curve from the current position to x, y using
+// the control point cx, cy.
+func (p *Path) QuadTo(cx, cy, x, y float32) {
+	p.segments = append(p.segments, PathSegment{Op: PathQuadTo,
+		Points: [3]fyne.Position{{X: cx, Y: cy}, {X: x, Y: y}}})
+}
+
+// CubicTo draws a cubic bézier curve from the current position to x, y using
+// the control points c1x, c1y and c2x, c2y.
+func (p *Path) CubicTo(c1x, c1y, c2x, c2y, x, y float32) {
+	p.segments = append(p.segments, PathSegment{Op: PathCubicTo,
+		Points: [3]fyne.Position{{X: c1x, Y: c1y}, {X: c2x, Y: c2y}, {X: x, Y: y}}})
+}
+
+// Arc draws a circular arc centered on cx, cy with the given radius, from startAngle
+// sweeping by the angle sweep, both in radians. Positive angles sweep clockwise with
+// zero pointing right. A line connects the current position to the arc start unless
+// the arc begins the path.
+func (p *Path) Arc(cx, cy, radius, startAngle, sweep float32) {
+	segments := int(math.Ceil(math.Abs(float64(sweep)) / (math.Pi / 2)))
+	if segments == 0 {
+		return
+	}
+	delta := sweep / float32(segments)
+	// magic number to approximate a circular arc segment with a cubic bézier
+	k := radius * 4 / 3 * float32(math.Tan(float64(delta)/4))
+
+	angle := float64(startAngle)
+	sin, cos := float32(math.Sin(angle)), float32(math.Cos(angle))
+	if len(p.segments) == 0 {
+		p.MoveTo(cx+radius*cos, cy+radius*sin)
+	} else {
+		p.LineTo(cx+radius*cos, cy+radius*sin)
+	}
+
+	for i := 0; i < segments; i++ {
+		next := angle + float64(delta)
+		nextSin, nextCos := float32(math.Sin(next)), float32(math.Cos(next))
+
+		p.CubicTo(
+			cx+radius*cos-k*sin, cy+radius*sin+k*cos,
+			cx+radius*nextCos+k*nextSin, cy+radius*nextSin-k*nextCos,
+			cx+radius*nextCos, cy+radius*nextSin)
+
+		angle, sin, cos = next, nextSin, nextCos
+	}
+}
+
+// Close joins the current sub-path back to its starting point with a straight line.
+func (p *Path) Close() {
+	p.segments = append(p.segments, PathSegment{Op: PathClose})
+}
+
+// Segments returns the drawing operations that make up this path, in order.
+func (p *Path) Segments() []PathSegment {
+	return p.segments
+}
+
+// Hide will set this path to not be visible
+func (p *Path) Hide() {
+	p.baseObject.Hide()
+
+	repaint(p)
+}
+
+// Move the path to a new position, relative to its parent / canvas
+func (p *Path) Move(pos fyne.Position) {
+	p.baseObject.Move(pos)
+
+	repaint(p)
+}
+
+// Refresh causes this path to be redrawn with its configured state.
+func (p *Path) Refresh() {
+	Refresh(p)
+}
+
+// Resize on a path updates the new size of this object, content does not scale.
+func (p *Path) Resize(s fyne.Size) {
+	if s == p.Size() {
+		return
+	}
+
+	p.baseObject.Resize(s)
+
+	Refresh(p)
+}
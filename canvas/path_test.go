@@ -0,0 +1,58 @@
+package canvas_test
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+func TestPath_Segments(t *testing.T) {
+	path := canvas.NewPath()
+	path.MoveTo(10, 10)
+	path.LineTo(90, 10)
+	path.QuadTo(90, 90, 50, 90)
+	path.CubicTo(30, 90, 10, 70, 10, 50)
+	path.Close()
+
+	segs := path.Segments()
+	assert.Len(t, segs, 5)
+	assert.Equal(t, canvas.PathMoveTo, segs[0].Op)
+	assert.Equal(t, fyne.NewPos(10, 10), segs[0].Points[0])
+	assert.Equal(t, canvas.PathQuadTo, segs[2].Op)
+	assert.Equal(t, fyne.NewPos(90, 90), segs[2].Points[0])
+	assert.Equal(t, fyne.NewPos(50, 90), segs[2].Points[1])
+	assert.Equal(t, canvas.PathClose, segs[4].Op)
+}
+
+func TestPath_Arc(t *testing.T) {
+	path := canvas.NewPath()
+	path.Arc(50, 50, 40, 0, math.Pi) // bottom half circle, clockwise from the right
+
+	segs := path.Segments()
+	assert.Equal(t, canvas.PathMoveTo, segs[0].Op, "arc starting a path moves to its start point")
+	assert.Equal(t, fyne.NewPos(90, 50), segs[0].Points[0])
+
+	end := segs[len(segs)-1]
+	assert.Equal(t, canvas.PathCubicTo, end.Op)
+	assert.InDelta(t, 10, end.Points[2].X, 0.001)
+	assert.InDelta(t, 50, end.Points[2].Y, 0.001)
+
+	count := len(segs)
+	path.Arc(50, 50, 40, math.Pi, math.Pi)
+	segs = path.Segments()
+	assert.Equal(t, canvas.PathLineTo, segs[count].Op, "later arcs connect with a line")
+}
+
+func TestPath_MinSize(t *testing.T) {
+	path := canvas.NewPath()
+	path.FillColor = color.NRGBA{R: 0xff, A: 0xff}
+	min := path.MinSize()
+
+	assert.True(t, min.Width > 0)
+	assert.True(t, min.Height > 0)
+}
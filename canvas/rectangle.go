@@ -9,6 +9,45 @@ import (
 // Declare conformity with CanvasObject interface
 var _ fyne.CanvasObject = (*Rectangle)(nil)
 
+// CornerRadii specifies a separate radius for each corner of a rectangle.
+//
+// Since: 2.6
+type CornerRadii struct {
+	TopLeft, TopRight, BottomRight, BottomLeft float32
+}
+
+// RectangleSides is a bit mask selecting sides of a rectangle.
+//
+// Since: 2.6
+type RectangleSides uint8
+
+const (
+	// RectangleSideTop selects the top side of a rectangle.
+	//
+	// Since: 2.6
+	RectangleSideTop RectangleSides = 1 << iota
+
+	// RectangleSideRight selects the right side of a rectangle.
+	//
+	// Since: 2.6
+	RectangleSideRight
+
+	// RectangleSideBottom selects the bottom side of a rectangle.
+	//
+	// Since: 2.6
+	RectangleSideBottom
+
+	// RectangleSideLeft selects the left side of a rectangle.
+	//
+	// Since: 2.6
+	RectangleSideLeft
+)
+
+// RectangleSideAll selects every side of a rectangle.
+//
+// Since: 2.6
+const RectangleSideAll = RectangleSideTop | RectangleSideRight | RectangleSideBottom | RectangleSideLeft
+
 // Rectangle describes a colored rectangle primitive in a Fyne canvas
 type Rectangle struct {
 	baseObject
@@ -20,6 +59,29 @@ type Rectangle struct {
 	//
 	// Since: 2.4
 	CornerRadius float32
+
+	// CornerRadii sets a different radius for individual corners.
+	// Any corner left at zero falls back to CornerRadius.
+	//
+	// Since: 2.6
+	CornerRadii CornerRadii
+
+	// StrokeSides limits the stroke to the selected sides of the rectangle.
+	// The zero value, like RectangleSideAll, strokes every side.
+	//
+	// Since: 2.6
+	StrokeSides RectangleSides
+
+	// StrokeDashPattern is a sequence of alternating dash and gap lengths used to
+	// stroke the rectangle. An empty slice draws a solid stroke.
+	//
+	// Since: 2.6
+	StrokeDashPattern []float32
+
+	// StrokeDashOffset is the distance into the dash pattern at which the stroke starts.
+	//
+	// Since: 2.6
+	StrokeDashOffset float32
 }
 
 // Hide will set this rectangle to not be visible
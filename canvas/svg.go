@@ -0,0 +1,82 @@
+package canvas
+
+import (
+	"image/color"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal/svg"
+)
+
+// SVG is a retained document model for a Scalable Vector Graphic resource.
+// The content is parsed once and can then be manipulated at runtime - changing
+// fill colors, hiding elements by id or adjusting the viewBox - without string
+// templating of the SVG text. Call Resource to get the current state for display
+// in an Image or as an icon, it will be re-rasterized as needed.
+//
+// Since: 2.6
+type SVG struct {
+	doc      *svg.Document
+	name     string
+	revision int
+}
+
+// NewSVG parses the passed SVG content into a document model.
+// The name is used to identify this graphic, much like a file name.
+//
+// Since: 2.6
+func NewSVG(name string, content []byte) (*SVG, error) {
+	doc, err := svg.ParseDocument(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SVG{doc: doc, name: name}, nil
+}
+
+// NewSVGFromResource parses the content of an SVG resource into a document model.
+//
+// Since: 2.6
+func NewSVGFromResource(res fyne.Resource) (*SVG, error) {
+	return NewSVG(res.Name(), res.Content())
+}
+
+// Resource returns the current state of this graphic for use wherever a
+// fyne.Resource is accepted. The resource name changes with each manipulation
+// so that cached rasterizations of older states are not reused.
+func (s *SVG) Resource() fyne.Resource {
+	content, err := s.doc.Marshal()
+	if err != nil {
+		fyne.LogError("Failed to encode SVG document", err)
+	}
+
+	return fyne.NewStaticResource(strconv.Itoa(s.revision)+"_"+s.name, content)
+}
+
+// SetElementFillColor sets the fill color of the elements with the given id,
+// returning false if no element matched.
+func (s *SVG) SetElementFillColor(id string, clr color.Color) bool {
+	s.revision++
+	return s.doc.SetFillColor(id, clr)
+}
+
+// SetElementVisible shows or hides the elements with the given id,
+// returning false if no element matched.
+func (s *SVG) SetElementVisible(id string, visible bool) bool {
+	s.revision++
+	return s.doc.SetVisible(id, visible)
+}
+
+// SetFillColor sets the fill color of every element in the document,
+// in the same way icon theming does.
+func (s *SVG) SetFillColor(clr color.Color) {
+	s.revision++
+	s.doc.ReplaceFillColor(clr)
+}
+
+// SetViewBox updates the visible region of the document coordinate system,
+// which can be used to crop or zoom into part of the graphic.
+func (s *SVG) SetViewBox(x, y, w, h float32) {
+	s.revision++
+	s.doc.SetViewBox(float64(x), float64(y), float64(w), float64(h))
+}
@@ -0,0 +1,56 @@
+package canvas_test
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSVG = `<?xml version="1.0"?>
+<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24">
+  <circle id="dot" fill="#ff0000" cx="12" cy="12" r="8"/>
+</svg>`
+
+func TestNewSVG(t *testing.T) {
+	s, err := canvas.NewSVG("dot.svg", []byte(testSVG))
+	require.NoError(t, err)
+
+	res := s.Resource()
+	assert.Equal(t, "0_dot.svg", res.Name())
+	assert.Contains(t, string(res.Content()), "#ff0000")
+
+	_, err = canvas.NewSVG("bad.svg", []byte("not svg"))
+	assert.Error(t, err)
+}
+
+func TestNewSVGFromResource(t *testing.T) {
+	s, err := canvas.NewSVGFromResource(fyne.NewStaticResource("dot.svg", []byte(testSVG)))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(s.Resource().Content()), "#ff0000")
+}
+
+func TestSVG_SetElementFillColor(t *testing.T) {
+	s, err := canvas.NewSVG("dot.svg", []byte(testSVG))
+	require.NoError(t, err)
+
+	assert.True(t, s.SetElementFillColor("dot", color.NRGBA{B: 0xff, A: 0xff}))
+	assert.False(t, s.SetElementFillColor("missing", color.Black))
+
+	res := s.Resource()
+	assert.Equal(t, "2_dot.svg", res.Name()) // name changes so cached rasters are dropped
+	assert.Contains(t, string(res.Content()), "#0000ff")
+}
+
+func TestSVG_SetElementVisible(t *testing.T) {
+	s, err := canvas.NewSVG("dot.svg", []byte(testSVG))
+	require.NoError(t, err)
+
+	assert.True(t, s.SetElementVisible("dot", false))
+	assert.Contains(t, string(s.Resource().Content()), `display="none"`)
+}
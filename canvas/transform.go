@@ -0,0 +1,122 @@
+package canvas
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// Declare conformity with CanvasObject interface
+var _ fyne.CanvasObject = (*Transform)(nil)
+
+// Transform wraps another canvas object, drawing it rotated and scaled around an
+// origin point. The content is rasterized when drawn, so after changing it call
+// Refresh on the transform to redraw. Interactive content receives events at the
+// transformed location.
+//
+// Since: 2.6
+type Transform struct {
+	baseObject
+
+	// Content is the object drawn with the transform applied.
+	Content fyne.CanvasObject
+
+	// Rotation is the clockwise rotation angle in degrees.
+	Rotation float32
+
+	// Scale is the multiplier applied to the content when drawn, 1 being actual size.
+	// A zero value is treated as 1 so an empty Transform draws its content unchanged.
+	Scale float32
+
+	// Origin is the point, relative to the top-left of this object, that the content
+	// rotates and scales around. The zero value uses the center of the object.
+	Origin fyne.Position
+}
+
+// NewTransform returns a new Transform instance drawing the passed object unchanged,
+// rotating and scaling around its center when those fields are set.
+//
+// Since: 2.6
+func NewTransform(content fyne.CanvasObject) *Transform {
+	return &Transform{
+		Content: content,
+		Scale:   1,
+	}
+}
+
+// ToContentPosition maps a point in this object's coordinate space to the content
+// coordinate space, reversing the rotation and scale around the origin.
+// It is used to route events to interactive content drawn transformed.
+func (t *Transform) ToContentPosition(pos fyne.Position) fyne.Position {
+	scale := float64(t.Scale)
+	if scale == 0 {
+		scale = 1
+	}
+	origin := t.effectiveOrigin()
+	sin, cos := math.Sincos(-float64(t.Rotation) * math.Pi / 180)
+
+	dx, dy := float64(pos.X-origin.X), float64(pos.Y-origin.Y)
+	return fyne.NewPos(
+		origin.X+float32((dx*cos-dy*sin)/scale),
+		origin.Y+float32((dx*sin+dy*cos)/scale))
+}
+
+// Hide will set this transform and its content to not be visible
+func (t *Transform) Hide() {
+	t.baseObject.Hide()
+
+	repaint(t)
+}
+
+// MinSize returns the bounding box size of the content minimum size after
+// rotation and scaling.
+func (t *Transform) MinSize() fyne.Size {
+	if t.Content == nil {
+		return fyne.NewSize(1, 1)
+	}
+
+	min := t.Content.MinSize()
+	scale := t.Scale
+	if scale == 0 {
+		scale = 1
+	}
+	sin, cos := math.Sincos(float64(t.Rotation) * math.Pi / 180)
+	w, h := float64(min.Width*scale), float64(min.Height*scale)
+	return fyne.NewSize(
+		float32(math.Abs(w*cos)+math.Abs(h*sin)),
+		float32(math.Abs(w*sin)+math.Abs(h*cos)))
+}
+
+// Move the transform to a new position, relative to its parent / canvas
+func (t *Transform) Move(pos fyne.Position) {
+	t.baseObject.Move(pos)
+
+	repaint(t)
+}
+
+// Refresh causes this transform to be redrawn with its configured state.
+func (t *Transform) Refresh() {
+	Refresh(t)
+}
+
+// Resize on a transform updates the size of this object and its content.
+func (t *Transform) Resize(s fyne.Size) {
+	if s == t.Size() {
+		return
+	}
+
+	t.baseObject.Resize(s)
+	if t.Content != nil {
+		t.Content.Resize(s)
+	}
+
+	Refresh(t)
+}
+
+func (t *Transform) effectiveOrigin() fyne.Position {
+	if t.Origin == (fyne.Position{}) {
+		size := t.Size()
+		return fyne.NewPos(size.Width/2, size.Height/2)
+	}
+	return t.Origin
+}
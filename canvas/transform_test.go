@@ -0,0 +1,66 @@
+package canvas_test
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTransform(t *testing.T) {
+	rect := canvas.NewRectangle(color.Black)
+	transform := canvas.NewTransform(rect)
+
+	assert.Equal(t, rect, transform.Content)
+	assert.Equal(t, float32(1), transform.Scale)
+	assert.Equal(t, float32(0), transform.Rotation)
+}
+
+func TestTransform_MinSize(t *testing.T) {
+	rect := canvas.NewRectangle(color.Black)
+	rect.SetMinSize(fyne.NewSize(40, 20))
+	transform := canvas.NewTransform(rect)
+
+	assert.Equal(t, fyne.NewSize(40, 20), transform.MinSize())
+
+	transform.Rotation = 90
+	min := transform.MinSize()
+	assert.InDelta(t, 20, min.Width, 0.0001)
+	assert.InDelta(t, 40, min.Height, 0.0001)
+
+	transform.Rotation = 0
+	transform.Scale = 2
+	assert.Equal(t, fyne.NewSize(80, 40), transform.MinSize())
+}
+
+func TestTransform_ToContentPosition(t *testing.T) {
+	rect := canvas.NewRectangle(color.Black)
+	transform := canvas.NewTransform(rect)
+	transform.Resize(fyne.NewSize(100, 100))
+
+	pos := transform.ToContentPosition(fyne.NewPos(25, 75))
+	assert.Equal(t, fyne.NewPos(25, 75), pos)
+
+	transform.Rotation = 90 // clockwise quarter turn around the center
+	pos = transform.ToContentPosition(fyne.NewPos(100, 50))
+	assert.InDelta(t, 50, pos.X, 0.0001)
+	assert.InDelta(t, 0, pos.Y, 0.0001)
+
+	transform.Rotation = 0
+	transform.Scale = 2
+	pos = transform.ToContentPosition(fyne.NewPos(100, 100))
+	assert.InDelta(t, 75, pos.X, 0.0001)
+	assert.InDelta(t, 75, pos.Y, 0.0001)
+}
+
+func TestTransform_Resize(t *testing.T) {
+	rect := canvas.NewRectangle(color.Black)
+	transform := canvas.NewTransform(rect)
+	transform.Resize(fyne.NewSize(50, 30))
+
+	assert.Equal(t, fyne.NewSize(50, 30), transform.Size())
+	assert.Equal(t, fyne.NewSize(50, 30), rect.Size())
+}
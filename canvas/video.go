@@ -0,0 +1,192 @@
+package canvas
+
+import (
+	"image"
+	"time"
+
+	"golang.org/x/image/draw"
+
+	"fyne.io/fyne/v2"
+)
+
+// Declare conformity with CanvasObject interface
+var _ fyne.CanvasObject = (*Video)(nil)
+
+// VideoDecoder provides frames of a video stream to a Video canvas object.
+// Implementations wrap a codec or media library, which keeps heavyweight
+// decoding dependencies out of applications that do not play video.
+//
+// Since: 2.6
+type VideoDecoder interface {
+	// Duration returns the total length of the video stream.
+	Duration() time.Duration
+	// FrameAt returns the image for the frame at, or nearest to, the given position.
+	FrameAt(at time.Duration) (image.Image, error)
+}
+
+// VideoAudioOutput is an optional interface for VideoDecoder implementations
+// that also output sound. Playback state changes on the Video are forwarded
+// so the audio can stay in sync.
+//
+// Since: 2.6
+type VideoAudioOutput interface {
+	// Play starts or resumes audio output.
+	Play()
+	// Pause suspends audio output.
+	Pause()
+}
+
+// Video describes a canvas object that plays video frames from a decoder.
+// Frames are scaled to fill the object, playback is advanced on the animation
+// clock of the current app. Use Play, Pause and Seek to control the position.
+//
+// Since: 2.6
+type Video struct {
+	baseObject
+
+	// Decoder is the backend that provides the video frames.
+	Decoder VideoDecoder
+
+	// OnFrame is called each time a new frame is decoded, with its position.
+	OnFrame func(at time.Duration) `json:"-"`
+
+	playing  bool
+	position time.Duration
+	frame    image.Image
+	anim     *fyne.Animation
+}
+
+// NewVideo returns a new Video that plays the stream of the passed decoder.
+//
+// Since: 2.6
+func NewVideo(decoder VideoDecoder) *Video {
+	v := &Video{Decoder: decoder}
+	v.loadFrame(0)
+	return v
+}
+
+// Generate returns the current frame scaled to the requested width and height.
+// This is used by the painters and would not normally be called by applications.
+func (v *Video) Generate(w, h int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	if v.frame == nil || w == 0 || h == 0 {
+		return img
+	}
+
+	draw.ApproxBiLinear.Scale(img, img.Bounds(), v.frame, v.frame.Bounds(), draw.Src, nil)
+	return img
+}
+
+// Hide will set this video to not be visible
+func (v *Video) Hide() {
+	v.baseObject.Hide()
+
+	repaint(v)
+}
+
+// Move the video to a new position, relative to its parent / canvas
+func (v *Video) Move(pos fyne.Position) {
+	v.baseObject.Move(pos)
+
+	repaint(v)
+}
+
+// Pause suspends playback, keeping the current frame on screen.
+func (v *Video) Pause() {
+	if !v.playing {
+		return
+	}
+	v.playing = false
+	if v.anim != nil {
+		v.anim.Stop()
+		v.anim = nil
+	}
+	if audio, ok := v.Decoder.(VideoAudioOutput); ok {
+		audio.Pause()
+	}
+}
+
+// Play starts, or resumes, playback from the current position.
+func (v *Video) Play() {
+	if v.playing || v.Decoder == nil {
+		return
+	}
+
+	start := v.position
+	remaining := v.Decoder.Duration() - start
+	if remaining <= 0 {
+		return
+	}
+
+	v.playing = true
+	v.anim = fyne.NewAnimation(remaining, func(done float32) {
+		v.loadFrame(start + time.Duration(float64(remaining)*float64(done)))
+		if done >= 1 {
+			v.playing = false
+			v.anim = nil
+		}
+	})
+	v.anim.Curve = fyne.AnimationLinear
+	v.anim.Start()
+	if audio, ok := v.Decoder.(VideoAudioOutput); ok {
+		audio.Play()
+	}
+}
+
+// Playing returns true if the video is currently advancing through its frames.
+func (v *Video) Playing() bool {
+	return v.playing
+}
+
+// PlayPosition returns the current playback position within the stream.
+func (v *Video) PlayPosition() time.Duration {
+	return v.position
+}
+
+// Refresh causes this video to be redrawn with its configured state.
+func (v *Video) Refresh() {
+	Refresh(v)
+}
+
+// Resize resizes the video to a new size.
+func (v *Video) Resize(size fyne.Size) {
+	if size == v.Size() {
+		return
+	}
+	v.baseObject.Resize(size)
+
+	// refresh needed to invalidate cached textures
+	v.Refresh()
+}
+
+// Seek moves playback to the given position, continuing from there if playing.
+func (v *Video) Seek(to time.Duration) {
+	wasPlaying := v.playing
+	v.Pause()
+	v.loadFrame(to)
+	if wasPlaying {
+		v.Play()
+	}
+}
+
+func (v *Video) loadFrame(at time.Duration) {
+	if v.Decoder == nil {
+		return
+	}
+	if max := v.Decoder.Duration(); at > max {
+		at = max
+	}
+
+	frame, err := v.Decoder.FrameAt(at)
+	if err != nil {
+		fyne.LogError("Failed to decode video frame", err)
+		return
+	}
+
+	v.position = at
+	v.frame = frame
+	if v.OnFrame != nil {
+		v.OnFrame(at)
+	}
+	v.Refresh()
+}
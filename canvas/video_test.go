@@ -0,0 +1,61 @@
+package canvas_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/canvas"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubDecoder struct {
+	length time.Duration
+}
+
+func (d *stubDecoder) Duration() time.Duration {
+	return d.length
+}
+
+func (d *stubDecoder) FrameAt(at time.Duration) (image.Image, error) {
+	// brightness encodes the position so tests can tell frames apart
+	gray := uint8(255 * at / d.length)
+	return image.NewUniform(color.NRGBA{R: gray, G: gray, B: gray, A: 0xff}), nil
+}
+
+func TestNewVideo(t *testing.T) {
+	video := canvas.NewVideo(&stubDecoder{length: 10 * time.Second})
+
+	assert.False(t, video.Playing())
+	assert.Equal(t, time.Duration(0), video.PlayPosition())
+
+	img := video.Generate(2, 2)
+	assert.Equal(t, color.NRGBA{0, 0, 0, 0xff}, img.At(0, 0))
+}
+
+func TestVideo_Seek(t *testing.T) {
+	video := canvas.NewVideo(&stubDecoder{length: 10 * time.Second})
+	frames := 0
+	video.OnFrame = func(at time.Duration) {
+		frames++
+	}
+
+	video.Seek(5 * time.Second)
+	assert.Equal(t, 5*time.Second, video.PlayPosition())
+	assert.Equal(t, 1, frames)
+
+	img := video.Generate(2, 2)
+	assert.Equal(t, color.NRGBA{0x7f, 0x7f, 0x7f, 0xff}, img.At(1, 1))
+
+	video.Seek(time.Minute) // past the end clamps to the last frame
+	assert.Equal(t, 10*time.Second, video.PlayPosition())
+}
+
+func TestVideo_Generate_NoDecoder(t *testing.T) {
+	video := canvas.NewVideo(nil)
+
+	img := video.Generate(2, 2)
+	assert.Equal(t, color.NRGBA{}, img.At(0, 0))
+}
@@ -105,3 +105,19 @@ type Tabbable interface {
 type Tappable interface {
 	Tapped(*PointEvent)
 }
+
+// TextComposable describes a Focusable text input that supports input method (IME)
+// composition. While the user composes, the driver calls SetComposingText with each
+// pre-edit update and with an empty string once the composition ends, any committed
+// text then arriving through TypedRune. CaretArea reports where the caret is drawn
+// so that the candidate window can be positioned next to it.
+//
+// Since: 2.6
+type TextComposable interface {
+	Focusable
+
+	// SetComposingText replaces the pre-edit text shown at the caret.
+	SetComposingText(text string)
+	// CaretArea returns the position and size of the caret, relative to this object.
+	CaretArea() (Position, Size)
+}
@@ -0,0 +1,79 @@
+package fyne
+
+import (
+	"image"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"io"
+	"sync"
+	"time"
+)
+
+// CaptureFrames calls frame with a rendered capture of the canvas at the requested
+// rate, from a background goroutine, until the returned stop function is called.
+// A zero or negative fps defaults to 25 frames per second.
+//
+// Since: 2.6
+func CaptureFrames(c Canvas, fps int, frame func(image.Image)) (stop func()) {
+	if fps <= 0 {
+		fps = 25
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(time.Second / time.Duration(fps))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				frame(c.Capture())
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
+
+// CaptureVideo records the canvas for the given duration and writes the frames to
+// w as an animated GIF, giving a lightweight screen recording of a window for bug
+// reports and demos. It blocks until the recording completes, returning any error
+// from encoding the stream. A zero or negative fps defaults to 25 frames per second.
+//
+// Since: 2.6
+func CaptureVideo(c Canvas, w io.Writer, fps int, duration time.Duration) error {
+	if fps <= 0 {
+		fps = 25
+	}
+	delay := 100 / fps // GIF frame delays count hundredths of a second
+	if delay < 1 {
+		delay = 1
+	}
+	frames := int(duration * time.Duration(fps) / time.Second)
+	if frames < 1 {
+		frames = 1
+	}
+
+	anim := &gif.GIF{}
+	interval := time.Second / time.Duration(fps)
+	for i := 0; i < frames; i++ {
+		src := c.Capture()
+		bounds := src.Bounds()
+		frame := image.NewPaletted(bounds, palette.Plan9)
+		draw.FloydSteinberg.Draw(frame, bounds, src, bounds.Min)
+		anim.Image = append(anim.Image, frame)
+		anim.Delay = append(anim.Delay, delay)
+
+		if i < frames-1 {
+			time.Sleep(interval)
+		}
+	}
+	return gif.EncodeAll(w, anim)
+}
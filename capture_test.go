@@ -0,0 +1,72 @@
+package fyne
+
+import (
+	"bytes"
+	"image"
+	"image/gif"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureVideo(t *testing.T) {
+	c := &captureCanvas{}
+
+	var buf bytes.Buffer
+	err := CaptureVideo(c, &buf, 20, 100*time.Millisecond)
+	assert.NoError(t, err)
+
+	anim, err := gif.DecodeAll(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(anim.Image))
+	assert.Equal(t, 4, anim.Image[0].Bounds().Dx())
+}
+
+func TestCaptureFrames(t *testing.T) {
+	c := &captureCanvas{}
+	frames := int32(0)
+
+	stop := CaptureFrames(c, 100, func(image.Image) {
+		atomic.AddInt32(&frames, 1)
+	})
+	time.Sleep(100 * time.Millisecond)
+	stop()
+	stop() // stopping twice is safe
+
+	captured := atomic.LoadInt32(&frames)
+	assert.Greater(t, captured, int32(0))
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, captured, atomic.LoadInt32(&frames)) // no frames after stop
+}
+
+type captureCanvas struct {
+	content CanvasObject
+}
+
+func (c *captureCanvas) Content() CanvasObject                         { return c.content }
+func (c *captureCanvas) SetContent(obj CanvasObject)                   { c.content = obj }
+func (c *captureCanvas) Refresh(CanvasObject)                          {}
+func (c *captureCanvas) Focus(Focusable)                               {}
+func (c *captureCanvas) FocusNext()                                    {}
+func (c *captureCanvas) FocusPrevious()                                {}
+func (c *captureCanvas) Unfocus()                                      {}
+func (c *captureCanvas) Focused() Focusable                            { return nil }
+func (c *captureCanvas) Size() Size                                    { return NewSize(4, 4) }
+func (c *captureCanvas) Scale() float32                                { return 1 }
+func (c *captureCanvas) Overlays() OverlayStack                        { return nil }
+func (c *captureCanvas) OnTypedRune() func(rune)                       { return nil }
+func (c *captureCanvas) SetOnTypedRune(func(rune))                     {}
+func (c *captureCanvas) OnTypedKey() func(*KeyEvent)                   { return nil }
+func (c *captureCanvas) SetOnTypedKey(func(*KeyEvent))                 {}
+func (c *captureCanvas) AddShortcut(Shortcut, func(shortcut Shortcut)) {}
+func (c *captureCanvas) RemoveShortcut(Shortcut)                       {}
+func (c *captureCanvas) Capture() image.Image {
+	return image.NewRGBA(image.Rect(0, 0, 4, 4))
+}
+func (c *captureCanvas) PixelCoordinateForPosition(Position) (int, int) { return 0, 0 }
+func (c *captureCanvas) InteractiveArea() (Position, Size) {
+	return NewPos(0, 0), NewSize(4, 4)
+}
@@ -29,6 +29,22 @@ type CloudProviderPreferences interface {
 	CloudPreferences(App) Preferences
 }
 
+// CloudProviderPreferenceSync interface defines the functionality that a cloud provider will include if it
+// stores raw preference values remotely instead of providing a complete Preferences implementation.
+// The standard preferences are kept as the local store and values are merged from, and written back to,
+// the provider as they change, so apps keep using the regular `Preferences` API for reads and writes.
+//
+// Since: 2.6
+type CloudProviderPreferenceSync interface {
+	// ReadPreferenceValues returns all remotely stored preference values, keyed by preference key.
+	ReadPreferenceValues(App) (map[string]any, error)
+	// WritePreferenceValues stores the given preference values remotely.
+	WritePreferenceValues(App, map[string]any) error
+	// ResolvePreferenceConflict is called during synchronization when a key holds a different value
+	// locally and remotely. The returned value will be used on both sides.
+	ResolvePreferenceConflict(key string, local, remote any) any
+}
+
 // CloudProviderStorage interface defines the functionality that a cloud provider will include if it is capable
 // of synchronizing user documents.
 //
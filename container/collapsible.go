@@ -0,0 +1,123 @@
+package container
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with CanvasObject interface
+var _ fyne.CanvasObject = (*Collapsible)(nil)
+
+// Collapsible is a container showing a single section of content below a
+// disclosure header that opens and closes it, like a one item Accordion.
+//
+// Since: 2.6
+type Collapsible struct {
+	widget.BaseWidget
+	Title   string
+	Content fyne.CanvasObject
+	Open    bool
+
+	// OnToggled is called when the section is opened or closed,
+	// with the new open state.
+	//
+	// Since: 2.6
+	OnToggled func(bool) `json:"-"`
+
+	header *widget.Button
+}
+
+// NewCollapsible creates a new collapsible section with the given header title
+// and content. The section starts closed.
+//
+// Since: 2.6
+func NewCollapsible(title string, content fyne.CanvasObject) *Collapsible {
+	c := &Collapsible{Title: title, Content: content}
+	c.BaseWidget.ExtendBaseWidget(c)
+	return c
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer
+func (c *Collapsible) CreateRenderer() fyne.WidgetRenderer {
+	c.BaseWidget.ExtendBaseWidget(c)
+	c.header = &widget.Button{
+		Alignment:     widget.ButtonAlignLeading,
+		IconPlacement: widget.ButtonIconLeadingText,
+		Importance:    widget.LowImportance,
+		OnTapped:      c.toggle,
+	}
+	r := &collapsibleRenderer{collapsible: c}
+	r.Refresh()
+	return r
+}
+
+// SetOpen opens or closes the section.
+func (c *Collapsible) SetOpen(open bool) {
+	if c.Open == open {
+		return
+	}
+
+	c.Open = open
+	if f := c.OnToggled; f != nil {
+		f(open)
+	}
+	c.Refresh()
+}
+
+func (c *Collapsible) toggle() {
+	c.SetOpen(!c.Open)
+}
+
+type collapsibleRenderer struct {
+	collapsible *Collapsible
+}
+
+func (r *collapsibleRenderer) Destroy() {
+}
+
+func (r *collapsibleRenderer) Layout(size fyne.Size) {
+	c := r.collapsible
+	headerMin := c.header.MinSize().Height
+	c.header.Move(fyne.NewPos(0, 0))
+	c.header.Resize(fyne.NewSize(size.Width, headerMin))
+
+	if !c.Open {
+		return
+	}
+	pad := c.Theme().Size(theme.SizeNamePadding)
+	c.Content.Move(fyne.NewPos(0, headerMin+pad))
+	c.Content.Resize(fyne.NewSize(size.Width, size.Height-headerMin-pad))
+}
+
+func (r *collapsibleRenderer) MinSize() fyne.Size {
+	c := r.collapsible
+	min := c.header.MinSize()
+	if c.Open {
+		contentMin := c.Content.MinSize()
+		min.Width = fyne.Max(min.Width, contentMin.Width)
+		min.Height += contentMin.Height + c.Theme().Size(theme.SizeNamePadding)
+	}
+	return min
+}
+
+func (r *collapsibleRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.collapsible.header, r.collapsible.Content}
+}
+
+func (r *collapsibleRenderer) Refresh() {
+	c := r.collapsible
+	th := c.Theme()
+	c.header.Text = c.Title
+	if c.Open {
+		c.header.Icon = th.Icon(theme.IconNameArrowDropUp)
+		c.Content.Show()
+	} else {
+		c.header.Icon = th.Icon(theme.IconNameArrowDropDown)
+		c.Content.Hide()
+	}
+	c.header.Refresh()
+	r.Layout(c.Size())
+	canvas.Refresh(c)
+}
@@ -0,0 +1,41 @@
+package container_test
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollapsible_SetOpen(t *testing.T) {
+	content := widget.NewLabel("Detail")
+	c := container.NewCollapsible("Section", content)
+	w := test.NewTempWindow(t, c)
+	w.Resize(fyne.NewSize(200, 200))
+
+	assert.False(t, c.Open)
+	assert.False(t, content.Visible())
+	closedMin := c.MinSize()
+
+	c.SetOpen(true)
+	assert.True(t, content.Visible())
+	assert.Greater(t, c.MinSize().Height, closedMin.Height)
+
+	c.SetOpen(false)
+	assert.False(t, content.Visible())
+}
+
+func TestCollapsible_OnToggled(t *testing.T) {
+	opened := []bool{}
+	c := container.NewCollapsible("Section", widget.NewLabel("Detail"))
+	c.OnToggled = func(open bool) { opened = append(opened, open) }
+
+	c.SetOpen(true)
+	c.SetOpen(true) // no change, no callback
+	c.SetOpen(false)
+	assert.Equal(t, []bool{true, false}, opened)
+}
@@ -0,0 +1,342 @@
+package container
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// DockEdge describes where a DockPanel is placed within a Dock container.
+//
+// Since: 2.6
+type DockEdge int
+
+const (
+	// DockEdgeLeading docks a panel at the left of the central content.
+	//
+	// Since: 2.6
+	DockEdgeLeading DockEdge = iota
+	// DockEdgeTrailing docks a panel at the right of the central content.
+	//
+	// Since: 2.6
+	DockEdgeTrailing
+	// DockEdgeTop docks a panel above the central content.
+	//
+	// Since: 2.6
+	DockEdgeTop
+	// DockEdgeBottom docks a panel below the central content.
+	//
+	// Since: 2.6
+	DockEdgeBottom
+	// DockFloating shows a panel in an inner window floating over the dock.
+	//
+	// Since: 2.6
+	DockFloating
+)
+
+var dockEdgeNames = map[DockEdge]string{
+	DockEdgeLeading:  "leading",
+	DockEdgeTrailing: "trailing",
+	DockEdgeTop:      "top",
+	DockEdgeBottom:   "bottom",
+	DockFloating:     "floating",
+}
+
+// DockPanel is a single tool panel managed by a Dock container.
+// The Title identifies the panel in tab groups, floating windows and saved
+// arrangements, so it should be unique within one Dock.
+//
+// Since: 2.6
+type DockPanel struct {
+	Title   string
+	Content fyne.CanvasObject
+
+	edge     DockEdge
+	lastEdge DockEdge
+	win      *InnerWindow
+}
+
+// NewDockPanel creates a tool panel that can be added to a Dock container.
+//
+// Since: 2.6
+func NewDockPanel(title string, content fyne.CanvasObject) *DockPanel {
+	return &DockPanel{Title: title, Content: content}
+}
+
+// Edge returns where this panel is currently docked.
+//
+// Since: 2.6
+func (p *DockPanel) Edge() DockEdge {
+	return p.edge
+}
+
+// Declare conformity with Widget interface.
+var _ fyne.Widget = (*Dock)(nil)
+
+// Dock is a container that arranges tool panels around a central content area.
+// Panels can be dragged by their header to dock at any edge, where multiple
+// panels on one edge stack as tabs, or float as inner windows above the
+// content. The arrangement can be saved and restored across sessions.
+//
+// Since: 2.6
+type Dock struct {
+	widget.BaseWidget
+
+	// OnChanged is called whenever a panel is moved, docked or floated,
+	// allowing apps to persist the new arrangement.
+	//
+	// Since: 2.6
+	OnChanged func() `json:"-"`
+
+	content fyne.CanvasObject
+	panels  []*DockPanel
+	root    *fyne.Container
+	floats  *fyne.Container
+}
+
+// NewDock creates a docking container with the given central content.
+// The panels passed to this constructor start at the leading edge.
+//
+// Since: 2.6
+func NewDock(content fyne.CanvasObject, panels ...*DockPanel) *Dock {
+	d := &Dock{content: content, panels: panels}
+	d.ExtendBaseWidget(d)
+	return d
+}
+
+// AddPanel adds a panel to this dock at the requested edge.
+//
+// Since: 2.6
+func (d *Dock) AddPanel(p *DockPanel, edge DockEdge) {
+	p.edge = edge
+	d.panels = append(d.panels, p)
+	d.rebuild()
+	d.changed()
+}
+
+// RemovePanel removes a panel from this dock.
+//
+// Since: 2.6
+func (d *Dock) RemovePanel(p *DockPanel) {
+	for i, panel := range d.panels {
+		if panel == p {
+			d.panels = append(d.panels[:i], d.panels[i+1:]...)
+			p.win = nil
+			d.rebuild()
+			d.changed()
+			return
+		}
+	}
+}
+
+// MovePanel docks a panel at the requested edge, or floats it when passed
+// DockFloating. Closing a floating panel docks it back at its previous edge.
+//
+// Since: 2.6
+func (d *Dock) MovePanel(p *DockPanel, edge DockEdge) {
+	if p.edge == edge {
+		return
+	}
+
+	if edge == DockFloating {
+		p.lastEdge = p.edge
+	} else {
+		p.win = nil
+	}
+	p.edge = edge
+	d.rebuild()
+	d.changed()
+}
+
+// Panels returns all panels managed by this dock, in the order they were added.
+//
+// Since: 2.6
+func (d *Dock) Panels() []*DockPanel {
+	return d.panels
+}
+
+// Arrangement returns a string describing where each panel is docked, suitable
+// for storing in the app preferences.
+//
+// Since: 2.6
+func (d *Dock) Arrangement() string {
+	var b strings.Builder
+	for i, p := range d.panels {
+		if i > 0 {
+			b.WriteByte(';')
+		}
+		b.WriteString(p.Title)
+		b.WriteByte(':')
+		b.WriteString(dockEdgeNames[p.edge])
+	}
+	return b.String()
+}
+
+// SetArrangement restores panel positions saved with Arrangement, matching
+// panels by title. Entries for unknown panels are ignored.
+//
+// Since: 2.6
+func (d *Dock) SetArrangement(arrangement string) {
+	for _, part := range strings.Split(arrangement, ";") {
+		title, name, ok := strings.Cut(part, ":")
+		if !ok {
+			continue
+		}
+
+		for _, p := range d.panels {
+			if p.Title != title {
+				continue
+			}
+			for edge, edgeName := range dockEdgeNames {
+				if edgeName == name {
+					p.edge = edge
+					break
+				}
+			}
+		}
+	}
+	d.rebuild()
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (d *Dock) CreateRenderer() fyne.WidgetRenderer {
+	d.ExtendBaseWidget(d)
+	d.floats = New(&multiWinLayout{})
+	d.root = NewStack()
+	d.rebuild()
+	return widget.NewSimpleRenderer(d.root)
+}
+
+func (d *Dock) changed() {
+	if f := d.OnChanged; f != nil {
+		f()
+	}
+}
+
+// dropPanel docks a panel according to where its header drag finished,
+// using the quarter of the dock nearest each edge as the drop zone.
+func (d *Dock) dropPanel(p *DockPanel, pos fyne.Position) {
+	driver := fyne.CurrentApp().Driver()
+	if driver.CanvasForObject(d) == nil {
+		return
+	}
+
+	rel := pos.Subtract(driver.AbsolutePositionForObject(d))
+	size := d.Size()
+	switch {
+	case rel.X < size.Width/4:
+		d.MovePanel(p, DockEdgeLeading)
+	case rel.X > size.Width*3/4:
+		d.MovePanel(p, DockEdgeTrailing)
+	case rel.Y < size.Height/4:
+		d.MovePanel(p, DockEdgeTop)
+	case rel.Y > size.Height*3/4:
+		d.MovePanel(p, DockEdgeBottom)
+	default:
+		d.MovePanel(p, DockFloating)
+	}
+}
+
+func (d *Dock) rebuild() {
+	if d.root == nil {
+		return
+	}
+
+	docked := make(map[DockEdge][]*DockPanel)
+	var wins []fyne.CanvasObject
+	for _, p := range d.panels {
+		if p.edge == DockFloating {
+			wins = append(wins, d.floatWindow(p))
+			continue
+		}
+		docked[p.edge] = append(docked[p.edge], p)
+	}
+
+	border := NewBorder(d.edgeObject(docked[DockEdgeTop]), d.edgeObject(docked[DockEdgeBottom]),
+		d.edgeObject(docked[DockEdgeLeading]), d.edgeObject(docked[DockEdgeTrailing]), d.content)
+	d.floats.Objects = wins
+	d.root.Objects = []fyne.CanvasObject{border, d.floats}
+	d.root.Refresh()
+}
+
+// edgeObject combines the panels docked at one edge, stacking them as tabs
+// when the edge holds more than one.
+func (d *Dock) edgeObject(panels []*DockPanel) fyne.CanvasObject {
+	switch len(panels) {
+	case 0:
+		return nil
+	case 1:
+		return d.panelBox(panels[0])
+	}
+
+	items := make([]*TabItem, len(panels))
+	for i, p := range panels {
+		items[i] = NewTabItem(p.Title, d.panelBox(p))
+	}
+	return NewAppTabs(items...)
+}
+
+func (d *Dock) floatWindow(p *DockPanel) *InnerWindow {
+	if p.win != nil {
+		return p.win
+	}
+
+	w := NewInnerWindow(p.Title, p.Content)
+	w.CloseIntercept = func() {
+		d.MovePanel(p, p.lastEdge)
+	}
+	w.OnDragged = func(ev *fyne.DragEvent) {
+		w.Move(w.Position().Add(ev.Dragged))
+	}
+	w.OnResized = func(ev *fyne.DragEvent) {
+		size := w.Size().Add(ev.Dragged)
+		w.Resize(size.Max(w.MinSize()))
+	}
+	p.win = w
+	return w
+}
+
+func (d *Dock) panelBox(p *DockPanel) fyne.CanvasObject {
+	return NewBorder(newDockPanelHeader(d, p), nil, nil, nil, p.Content)
+}
+
+// Declare conformity with interfaces of the panel header.
+var _ fyne.Widget = (*dockPanelHeader)(nil)
+var _ fyne.Draggable = (*dockPanelHeader)(nil)
+
+// dockPanelHeader is the bar above a docked panel that names it and can be
+// dragged to move the panel to another edge.
+type dockPanelHeader struct {
+	widget.BaseWidget
+
+	dock    *Dock
+	panel   *DockPanel
+	dragPos fyne.Position
+}
+
+func newDockPanelHeader(d *Dock, p *DockPanel) *dockPanelHeader {
+	h := &dockPanelHeader{dock: d, panel: p}
+	h.ExtendBaseWidget(h)
+	return h
+}
+
+func (h *dockPanelHeader) CreateRenderer() fyne.WidgetRenderer {
+	title := widget.NewLabelWithStyle(h.panel.Title, fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+	float := widget.NewButtonWithIcon("", theme.ViewFullScreenIcon(), func() {
+		h.dock.MovePanel(h.panel, DockFloating)
+	})
+	float.Importance = widget.LowImportance
+	return widget.NewSimpleRenderer(NewBorder(nil, nil, nil, float, title))
+}
+
+func (h *dockPanelHeader) DragEnd() {
+	h.dock.dropPanel(h.panel, h.dragPos)
+}
+
+func (h *dockPanelHeader) Dragged(e *fyne.DragEvent) {
+	h.dragPos = e.AbsolutePosition
+}
@@ -0,0 +1,69 @@
+package container_test
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDock_AddPanel(t *testing.T) {
+	dock := container.NewDock(widget.NewLabel("Content"))
+	panel := container.NewDockPanel("Files", widget.NewLabel("Files"))
+
+	changed := 0
+	dock.OnChanged = func() { changed++ }
+	dock.AddPanel(panel, container.DockEdgeTrailing)
+
+	assert.Equal(t, 1, len(dock.Panels()))
+	assert.Equal(t, container.DockEdgeTrailing, panel.Edge())
+	assert.Equal(t, 1, changed)
+}
+
+func TestDock_MovePanel(t *testing.T) {
+	panel := container.NewDockPanel("Files", widget.NewLabel("Files"))
+	dock := container.NewDock(widget.NewLabel("Content"), panel)
+	w := test.NewTempWindow(t, dock)
+	w.Resize(fyne.NewSize(300, 200))
+
+	assert.Equal(t, container.DockEdgeLeading, panel.Edge())
+	dock.MovePanel(panel, container.DockEdgeBottom)
+	assert.Equal(t, container.DockEdgeBottom, panel.Edge())
+}
+
+func TestDock_FloatPanel(t *testing.T) {
+	panel := container.NewDockPanel("Files", widget.NewLabel("Files"))
+	dock := container.NewDock(widget.NewLabel("Content"), panel)
+	w := test.NewTempWindow(t, dock)
+	w.Resize(fyne.NewSize(300, 200))
+
+	dock.MovePanel(panel, container.DockFloating)
+	assert.Equal(t, container.DockFloating, panel.Edge())
+
+	dock.MovePanel(panel, container.DockEdgeLeading)
+	assert.Equal(t, container.DockEdgeLeading, panel.Edge())
+}
+
+func TestDock_Arrangement(t *testing.T) {
+	files := container.NewDockPanel("Files", widget.NewLabel("Files"))
+	log := container.NewDockPanel("Log", widget.NewLabel("Log"))
+	dock := container.NewDock(widget.NewLabel("Content"), files)
+	dock.AddPanel(log, container.DockEdgeBottom)
+
+	assert.Equal(t, "Files:leading;Log:bottom", dock.Arrangement())
+}
+
+func TestDock_SetArrangement(t *testing.T) {
+	files := container.NewDockPanel("Files", widget.NewLabel("Files"))
+	log := container.NewDockPanel("Log", widget.NewLabel("Log"))
+	dock := container.NewDock(widget.NewLabel("Content"), files, log)
+
+	dock.SetArrangement("Files:trailing;Log:floating;Unknown:top")
+
+	assert.Equal(t, container.DockEdgeTrailing, files.Edge())
+	assert.Equal(t, container.DockFloating, log.Edge())
+}
@@ -23,15 +23,28 @@ type DocTabs struct {
 
 	Items []*TabItem
 
+	// Reorderable allows the user to change the order of the items by dragging
+	// their tab buttons along the bar. Pinned items keep their position.
+	//
+	// Since: 2.6
+	Reorderable bool
+
 	CreateTab      func() *TabItem `json:"-"`
 	CloseIntercept func(*TabItem)  `json:"-"`
 	OnClosed       func(*TabItem)  `json:"-"`
 	OnSelected     func(*TabItem)  `json:"-"`
 	OnUnselected   func(*TabItem)  `json:"-"`
 
+	// TabItemMenu builds the context menu shown when the tab button of an item
+	// is secondary tapped. Return nil for items that have no menu.
+	//
+	// Since: 2.6
+	TabItemMenu func(*TabItem) *fyne.Menu `json:"-"`
+
 	current         int
 	location        TabLocation
 	isTransitioning bool
+	dragOffset      float32
 
 	popUpMenu *widget.PopUpMenu
 }
@@ -186,6 +199,10 @@ func (t *DocTabs) Show() {
 }
 
 func (t *DocTabs) close(item *TabItem) {
+	if item.Pinned {
+		return
+	}
+
 	if f := t.CloseIntercept; f != nil {
 		f(item)
 	} else {
@@ -196,6 +213,94 @@ func (t *DocTabs) close(item *TabItem) {
 	}
 }
 
+// draggedTab moves an item along the bar as its tab button is dragged,
+// swapping with a neighbour whenever the drag passes half of its span.
+func (t *DocTabs) draggedTab(item *TabItem, e *fyne.DragEvent) {
+	if !t.Reorderable || item.Pinned {
+		return
+	}
+
+	vertical := t.location == TabLocationLeading || t.location == TabLocationTrailing
+	if vertical {
+		t.dragOffset += e.Dragged.DY
+	} else {
+		t.dragOffset += e.Dragged.DX
+	}
+
+	from := -1
+	for i, it := range t.Items {
+		if it == item {
+			from = i
+			break
+		}
+	}
+	if from == -1 {
+		return
+	}
+
+	pad := t.Theme().Size(theme.SizeNamePadding)
+	span := func(it *TabItem) float32 {
+		if it.button == nil {
+			return 0
+		}
+		if vertical {
+			return it.button.Size().Height + pad
+		}
+		return it.button.Size().Width + pad
+	}
+
+	selected := t.Selected()
+	moved := false
+	for {
+		if t.dragOffset > 0 && from < len(t.Items)-1 && !t.Items[from+1].Pinned {
+			if step := span(t.Items[from+1]); step > 0 && t.dragOffset > step/2 {
+				t.Items[from], t.Items[from+1] = t.Items[from+1], t.Items[from]
+				t.dragOffset -= step
+				from++
+				moved = true
+				continue
+			}
+		} else if t.dragOffset < 0 && from > 0 && !t.Items[from-1].Pinned {
+			if step := span(t.Items[from-1]); step > 0 && -t.dragOffset > step/2 {
+				t.Items[from], t.Items[from-1] = t.Items[from-1], t.Items[from]
+				t.dragOffset += step
+				from--
+				moved = true
+				continue
+			}
+		}
+		break
+	}
+
+	if moved {
+		for i, it := range t.Items {
+			if it == selected {
+				t.current = i
+				break
+			}
+		}
+		t.Refresh()
+	}
+}
+
+// showTabItemMenu presents the context menu for an item built by TabItemMenu, if any.
+func (t *DocTabs) showTabItemMenu(item *TabItem, e *fyne.PointEvent) {
+	f := t.TabItemMenu
+	if f == nil || item.button == nil {
+		return
+	}
+
+	menu := f(item)
+	if menu == nil {
+		return
+	}
+	c := fyne.CurrentApp().Driver().CanvasForObject(item.button)
+	if c == nil {
+		return
+	}
+	widget.ShowPopUpMenuAtPosition(menu, c, e.AbsolutePosition)
+}
+
 func (t *DocTabs) onUnselected() func(*TabItem) {
 	return t.OnUnselected
 }
@@ -336,11 +441,18 @@ func (r *docTabsRenderer) buildTabButtons(count int, buttons *fyne.Container) {
 		item := r.docTabs.Items[i]
 		if item.button == nil {
 			item.button = &tabButton{
-				onTapped: func() { r.docTabs.Select(item) },
-				onClosed: func() { r.docTabs.close(item) },
+				onTapped:          func() { r.docTabs.Select(item) },
+				onTappedSecondary: func(e *fyne.PointEvent) { r.docTabs.showTabItemMenu(item, e) },
+				onDragged:         func(e *fyne.DragEvent) { r.docTabs.draggedTab(item, e) },
+				onDragEnd:         func() { r.docTabs.dragOffset = 0 },
 			}
 		}
 		button := item.button
+		if item.Pinned {
+			button.onClosed = nil
+		} else {
+			button.onClosed = func() { r.docTabs.close(item) }
+		}
 		button.icon = item.Icon
 		button.iconPosition = iconPos
 		if i == r.docTabs.current {
@@ -31,6 +31,58 @@ func TestDocTabs_tabButtonRenderer_SetText(t *testing.T) {
 	assert.Equal(t, "Replace", renderer.label.Text)
 }
 
+func TestDocTabs_Reorderable(t *testing.T) {
+	items := []*TabItem{{Text: "1", Content: widget.NewLabel("Content1")},
+		{Text: "2", Content: widget.NewLabel("Content2")},
+		{Text: "3", Content: widget.NewLabel("Content3")}}
+	tabs := NewDocTabs(items...)
+	tabs.Reorderable = true
+	tabs.Resize(fyne.NewSize(300, 160))
+	tabs.SelectIndex(0)
+
+	width := items[0].button.Size().Width
+	drag := &fyne.DragEvent{Dragged: fyne.Delta{DX: width}}
+	items[0].button.Dragged(drag)
+	items[0].button.DragEnd()
+
+	assert.Equal(t, "2", tabs.Items[0].Text)
+	assert.Equal(t, "1", tabs.Items[1].Text)
+	// the selection follows the dragged item
+	assert.Equal(t, 1, tabs.SelectedIndex())
+
+	tabs.Reorderable = false
+	items[1].button.Dragged(drag)
+	items[1].button.DragEnd()
+	assert.Equal(t, "1", tabs.Items[1].Text)
+}
+
+func TestDocTabs_Reorderable_Pinned(t *testing.T) {
+	items := []*TabItem{{Text: "1", Content: widget.NewLabel("Content1"), Pinned: true},
+		{Text: "2", Content: widget.NewLabel("Content2")}}
+	tabs := NewDocTabs(items...)
+	tabs.Reorderable = true
+	tabs.Resize(fyne.NewSize(300, 160))
+
+	drag := &fyne.DragEvent{Dragged: fyne.Delta{DX: -items[0].button.Size().Width}}
+	items[1].button.Dragged(drag)
+	items[1].button.DragEnd()
+
+	assert.Equal(t, "1", tabs.Items[0].Text)
+}
+
+func TestDocTabs_Pinned_Close(t *testing.T) {
+	items := []*TabItem{{Text: "1", Content: widget.NewLabel("Content1"), Pinned: true},
+		{Text: "2", Content: widget.NewLabel("Content2")}}
+	tabs := NewDocTabs(items...)
+	tabs.Resize(fyne.NewSize(300, 160))
+
+	tabs.close(items[0])
+	assert.Equal(t, 2, len(tabs.Items))
+
+	tabs.close(items[1])
+	assert.Equal(t, 1, len(tabs.Items))
+}
+
 func TestDocTabs_tabButtonRenderer_Remove(t *testing.T) {
 	items := []*TabItem{{Text: "1", Content: widget.NewLabel("Content1")},
 		{Text: "2", Content: widget.NewLabel("Content2")},
@@ -0,0 +1,144 @@
+package container
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/painter/software"
+	"fyne.io/fyne/v2/widget"
+)
+
+var _ fyne.Widget = (*Layer)(nil)
+
+// Layer is a container that marks its content as an independent render layer.
+// While live it draws the content normally, but it can be frozen into a cached
+// snapshot that is drawn as a single texture with adjustable opacity, offset and
+// scale. Freezing heavy content isolates it from repaints, making animated
+// transitions cheap however complex the content is.
+//
+// Since: 2.6
+type Layer struct {
+	widget.BaseWidget
+
+	Content fyne.CanvasObject
+
+	frozen   bool
+	snapshot *canvas.Image
+	opacity  float64
+	offset   fyne.Position
+	scale    float32
+}
+
+// NewLayer returns a new render layer drawing the passed content.
+//
+// Since: 2.6
+func NewLayer(content fyne.CanvasObject) *Layer {
+	l := &Layer{Content: content, scale: 1}
+	l.ExtendBaseWidget(l)
+	return l
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (l *Layer) CreateRenderer() fyne.WidgetRenderer {
+	return &layerRenderer{layer: l}
+}
+
+// Freeze captures the current appearance of the content into a cached snapshot and
+// draws that instead, so the content does not repaint until Unfreeze is called.
+// The snapshot is drawn with the opacity, offset and scale set on this layer.
+func (l *Layer) Freeze() {
+	c := fyne.CurrentApp().Driver().CanvasForObject(l.Content)
+	if c == nil {
+		return // not yet shown, nothing to capture
+	}
+
+	img := canvas.NewImageFromImage(software.PaintObject(l.Content, c, l.Content.Size()))
+	img.FillMode = canvas.ImageFillStretch
+	img.Translucency = 1 - l.opacity
+	l.snapshot = img
+	l.frozen = true
+	l.Refresh()
+}
+
+// Frozen returns true if this layer is currently drawing a cached snapshot of its content.
+func (l *Layer) Frozen() bool {
+	return l.frozen
+}
+
+// MinSize returns the size that this widget should not shrink below.
+func (l *Layer) MinSize() fyne.Size {
+	l.ExtendBaseWidget(l)
+	return l.BaseWidget.MinSize()
+}
+
+// SetOffset moves the frozen snapshot by the given amount from the layer origin,
+// to animate transitions. It has no effect on the content while the layer is live.
+func (l *Layer) SetOffset(offset fyne.Position) {
+	l.offset = offset
+	if l.frozen {
+		l.Refresh()
+	}
+}
+
+// SetOpacity changes how opaque the frozen snapshot is drawn, from 0 (invisible)
+// to 1 (fully opaque). It has no effect on the content while the layer is live.
+func (l *Layer) SetOpacity(opacity float64) {
+	l.opacity = opacity
+	if l.snapshot != nil {
+		l.snapshot.Translucency = 1 - opacity
+	}
+	if l.frozen {
+		l.Refresh()
+	}
+}
+
+// SetScale resizes the frozen snapshot around the layer centre, to animate zoom
+// transitions. It has no effect on the content while the layer is live.
+func (l *Layer) SetScale(scale float32) {
+	l.scale = scale
+	if l.frozen {
+		l.Refresh()
+	}
+}
+
+// Unfreeze discards the cached snapshot and returns to drawing the live content.
+func (l *Layer) Unfreeze() {
+	l.frozen = false
+	l.snapshot = nil
+	l.Refresh()
+}
+
+type layerRenderer struct {
+	layer *Layer
+}
+
+func (r *layerRenderer) Destroy() {
+}
+
+func (r *layerRenderer) Layout(size fyne.Size) {
+	l := r.layer
+	l.Content.Resize(size)
+	if l.snapshot == nil {
+		return
+	}
+
+	scaled := fyne.NewSize(size.Width*l.scale, size.Height*l.scale)
+	centre := fyne.NewPos((size.Width-scaled.Width)/2, (size.Height-scaled.Height)/2)
+	l.snapshot.Move(centre.Add(l.offset))
+	l.snapshot.Resize(scaled)
+}
+
+func (r *layerRenderer) MinSize() fyne.Size {
+	return r.layer.Content.MinSize()
+}
+
+func (r *layerRenderer) Objects() []fyne.CanvasObject {
+	if r.layer.frozen {
+		return []fyne.CanvasObject{r.layer.snapshot}
+	}
+	return []fyne.CanvasObject{r.layer.Content}
+}
+
+func (r *layerRenderer) Refresh() {
+	r.Layout(r.layer.Size())
+	canvas.Refresh(r.layer)
+}
@@ -0,0 +1,58 @@
+package container
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLayer_Freeze(t *testing.T) {
+	test.NewTempApp(t)
+
+	content := canvas.NewRectangle(color.NRGBA{R: 0xff, A: 0xff})
+	layer := NewLayer(content)
+	w := test.NewWindow(layer)
+	defer w.Close()
+	w.Resize(fyne.NewSize(100, 100))
+
+	assert.False(t, layer.Frozen())
+	assert.Equal(t, []fyne.CanvasObject{content}, test.TempWidgetRenderer(t, layer).Objects())
+
+	layer.Freeze()
+	require.True(t, layer.Frozen())
+	objs := test.TempWidgetRenderer(t, layer).Objects()
+	require.Len(t, objs, 1)
+	snapshot, ok := objs[0].(*canvas.Image)
+	require.True(t, ok)
+	assert.Equal(t, layer.Size(), snapshot.Size())
+
+	layer.Unfreeze()
+	assert.False(t, layer.Frozen())
+	assert.Equal(t, []fyne.CanvasObject{content}, test.TempWidgetRenderer(t, layer).Objects())
+}
+
+func TestLayer_Transform(t *testing.T) {
+	test.NewTempApp(t)
+
+	layer := NewLayer(canvas.NewRectangle(color.Black))
+	w := test.NewWindow(layer)
+	defer w.Close()
+	w.Resize(fyne.NewSize(120, 120))
+	size := layer.Size()
+
+	layer.Freeze()
+	layer.SetScale(0.5)
+	layer.SetOffset(fyne.NewPos(10, 0))
+	layer.SetOpacity(0.25)
+
+	snapshot := test.TempWidgetRenderer(t, layer).Objects()[0].(*canvas.Image)
+	assert.Equal(t, fyne.NewSize(size.Width/2, size.Height/2), snapshot.Size())
+	assert.Equal(t, fyne.NewPos(size.Width/4+10, size.Height/4), snapshot.Position())
+	assert.Equal(t, 0.75, snapshot.Translucency)
+}
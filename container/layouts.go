@@ -15,6 +15,14 @@ func NewAdaptiveGrid(rowcols int, objects ...fyne.CanvasObject) *fyne.Container
 	return New(layout.NewAdaptiveGridLayout(rowcols), objects...)
 }
 
+// NewAspectRatio creates a new container where the objects fill the largest
+// area of the given width to height ratio that fits, letterboxed in any spare space.
+//
+// Since: 2.6
+func NewAspectRatio(ratio float32, objects ...fyne.CanvasObject) *fyne.Container {
+	return New(layout.NewAspectRatioLayout(ratio), objects...)
+}
+
 // NewBorder creates a new container with the specified objects and using the border layout.
 // The top, bottom, left and right parameters specify the items that should be placed around edges.
 // Nil can be used to an edge if it should not be filled.
@@ -0,0 +1,224 @@
+package container
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// NavigationTransition selects the animation a Navigation container plays
+// when pages are pushed and popped.
+//
+// Since: 2.6
+type NavigationTransition int
+
+const (
+	// NavigationTransitionSlide slides pages in from the trailing edge.
+	//
+	// Since: 2.6
+	NavigationTransitionSlide NavigationTransition = iota
+	// NavigationTransitionFade fades new pages in through the background colour.
+	//
+	// Since: 2.6
+	NavigationTransitionFade
+	// NavigationTransitionNone swaps pages with no animation.
+	//
+	// Since: 2.6
+	NavigationTransitionNone
+)
+
+// Declare conformity with Widget interface.
+var _ fyne.Widget = (*Navigation)(nil)
+
+// Navigation is a container managing a stack of pages for multi-screen flows.
+// New pages are pushed on top with a transition and a navigation bar shows the
+// page title with a back button when there is a page to return to.
+// On mobile devices the hardware back button pops the top page
+// (see the driver/mobile BackHandler interface).
+//
+// Since: 2.6
+type Navigation struct {
+	widget.BaseWidget
+
+	// Transition selects the animation played when pages are pushed and popped.
+	Transition NavigationTransition
+
+	pages []navigationPage
+
+	back     *widget.Button
+	title    *widget.Label
+	pageArea *fyne.Container
+}
+
+type navigationPage struct {
+	title   string
+	content fyne.CanvasObject
+}
+
+// NewNavigation creates a navigation stack showing the given page as its root.
+//
+// Since: 2.6
+func NewNavigation(title string, content fyne.CanvasObject) *Navigation {
+	n := &Navigation{pages: []navigationPage{{title: title, content: content}}}
+	n.ExtendBaseWidget(n)
+	return n
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (n *Navigation) CreateRenderer() fyne.WidgetRenderer {
+	n.ExtendBaseWidget(n)
+	n.back = &widget.Button{Icon: theme.NavigateBackIcon(), Importance: widget.LowImportance,
+		OnTapped: func() { n.Pop() }}
+	n.title = widget.NewLabelWithStyle("", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+	n.pageArea = NewStack(n.top().content)
+	n.updateBar()
+
+	bar := NewBorder(nil, nil, n.back, nil, n.title)
+	return widget.NewSimpleRenderer(NewBorder(bar, nil, nil, nil, n.pageArea))
+}
+
+// Depth returns the number of pages on the navigation stack.
+//
+// Since: 2.6
+func (n *Navigation) Depth() int {
+	return len(n.pages)
+}
+
+// HandleBack pops the top page if there is one to return to, consuming the
+// hardware back button on mobile devices.
+//
+// Since: 2.6
+func (n *Navigation) HandleBack() bool {
+	if len(n.pages) < 2 {
+		return false
+	}
+
+	n.Pop()
+	return true
+}
+
+// Pop removes the top page from the stack and returns to the one below,
+// doing nothing when only the root page remains.
+//
+// Since: 2.6
+func (n *Navigation) Pop() {
+	if len(n.pages) < 2 {
+		return
+	}
+
+	old := n.top().content
+	n.pages = n.pages[:len(n.pages)-1]
+	n.showTop(old, false)
+}
+
+// Push adds a new page to the stack and shows it with the chosen transition.
+//
+// Since: 2.6
+func (n *Navigation) Push(title string, content fyne.CanvasObject) {
+	old := n.top().content
+	n.pages = append(n.pages, navigationPage{title: title, content: content})
+	n.showTop(old, true)
+}
+
+// Replace swaps the top page for a new one without growing the stack.
+//
+// Since: 2.6
+func (n *Navigation) Replace(title string, content fyne.CanvasObject) {
+	old := n.top().content
+	n.pages[len(n.pages)-1] = navigationPage{title: title, content: content}
+	n.showTop(old, true)
+}
+
+// Top returns the content of the page currently shown.
+//
+// Since: 2.6
+func (n *Navigation) Top() fyne.CanvasObject {
+	return n.top().content
+}
+
+func (n *Navigation) top() navigationPage {
+	return n.pages[len(n.pages)-1]
+}
+
+func (n *Navigation) updateBar() {
+	if n.title == nil {
+		return
+	}
+
+	if len(n.pages) > 1 {
+		n.back.Show()
+	} else {
+		n.back.Hide()
+	}
+	n.title.SetText(n.top().title)
+}
+
+// setPage leaves just the top page in the display area once a transition ends.
+func (n *Navigation) setPage(top fyne.CanvasObject) {
+	n.pageArea.Objects = []fyne.CanvasObject{top}
+	n.pageArea.Refresh()
+}
+
+func (n *Navigation) showTop(old fyne.CanvasObject, forward bool) {
+	if n.pageArea == nil {
+		return // not rendered yet
+	}
+	n.updateBar()
+
+	top := n.top().content
+	size := n.pageArea.Size()
+	if size.Width <= 0 || size.Height <= 0 || n.Transition == NavigationTransitionNone {
+		n.setPage(top)
+		return
+	}
+
+	offset := size.Width
+	if fyne.CurrentLayoutDirection() == fyne.LayoutDirectionRightToLeft {
+		offset = -offset
+	}
+
+	switch n.Transition {
+	case NavigationTransitionSlide:
+		if forward {
+			n.pageArea.Objects = []fyne.CanvasObject{old, top}
+			n.pageArea.Refresh()
+			start := fyne.NewPos(offset, 0)
+			top.Move(start)
+			canvas.NewPositionAnimation(start, fyne.NewPos(0, 0), canvas.DurationShort, func(p fyne.Position) {
+				top.Move(p)
+				if p.IsZero() {
+					n.setPage(top)
+				}
+			}).Start()
+		} else {
+			n.pageArea.Objects = []fyne.CanvasObject{top, old}
+			n.pageArea.Refresh()
+			end := fyne.NewPos(offset, 0)
+			canvas.NewPositionAnimation(old.Position(), end, canvas.DurationShort, func(p fyne.Position) {
+				old.Move(p)
+				if p == end {
+					n.setPage(top)
+				}
+			}).Start()
+		}
+	case NavigationTransitionFade:
+		th := n.Theme()
+		v := fyne.CurrentApp().Settings().ThemeVariant()
+		cover := canvas.NewRectangle(th.Color(theme.ColorNameBackground, v))
+		cover.Resize(size)
+		n.pageArea.Objects = []fyne.CanvasObject{top, cover}
+		n.pageArea.Refresh()
+		canvas.NewColorRGBAAnimation(cover.FillColor, color.Transparent, canvas.DurationShort, func(c color.Color) {
+			cover.FillColor = c
+			cover.Refresh()
+			if _, _, _, a := c.RGBA(); a == 0 {
+				n.setPage(top)
+			}
+		}).Start()
+	}
+}
@@ -0,0 +1,63 @@
+package container_test
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNavigation_Push(t *testing.T) {
+	root := widget.NewLabel("Root")
+	detail := widget.NewLabel("Detail")
+	nav := container.NewNavigation("Root", root)
+	nav.Transition = container.NavigationTransitionNone
+	w := test.NewTempWindow(t, nav)
+	w.Resize(fyne.NewSize(200, 200))
+
+	assert.Equal(t, 1, nav.Depth())
+	nav.Push("Detail", detail)
+	assert.Equal(t, 2, nav.Depth())
+	assert.Equal(t, detail, nav.Top())
+}
+
+func TestNavigation_Pop(t *testing.T) {
+	root := widget.NewLabel("Root")
+	nav := container.NewNavigation("Root", root)
+	nav.Transition = container.NavigationTransitionNone
+	w := test.NewTempWindow(t, nav)
+	w.Resize(fyne.NewSize(200, 200))
+
+	nav.Pop() // the root page cannot be popped
+	assert.Equal(t, 1, nav.Depth())
+
+	nav.Push("Detail", widget.NewLabel("Detail"))
+	nav.Pop()
+	assert.Equal(t, 1, nav.Depth())
+	assert.Equal(t, root, nav.Top())
+}
+
+func TestNavigation_Replace(t *testing.T) {
+	nav := container.NewNavigation("Root", widget.NewLabel("Root"))
+	nav.Transition = container.NavigationTransitionNone
+
+	edit := widget.NewLabel("Edit")
+	nav.Replace("Edit", edit)
+	assert.Equal(t, 1, nav.Depth())
+	assert.Equal(t, edit, nav.Top())
+}
+
+func TestNavigation_HandleBack(t *testing.T) {
+	nav := container.NewNavigation("Root", widget.NewLabel("Root"))
+	nav.Transition = container.NavigationTransitionNone
+
+	assert.False(t, nav.HandleBack())
+
+	nav.Push("Detail", widget.NewLabel("Detail"))
+	assert.True(t, nav.HandleBack())
+	assert.Equal(t, 1, nav.Depth())
+}
@@ -30,6 +30,27 @@ const (
 	ScrollNone = widget.ScrollNone
 )
 
+// ScrollBarVisibility describes when the bars of a Scroll container are shown.
+//
+// Since: 2.6
+type ScrollBarVisibility = widget.ScrollBarVisibility
+
+// Constants for valid values of ScrollBarVisibility.
+const (
+	// ScrollBarVisibilityAuto shows a bar only while its content overflows.
+	//
+	// Since: 2.6
+	ScrollBarVisibilityAuto ScrollBarVisibility = widget.ScrollBarVisibilityAuto
+	// ScrollBarVisibilityAlways keeps the bars visible even when nothing overflows.
+	//
+	// Since: 2.6
+	ScrollBarVisibilityAlways = widget.ScrollBarVisibilityAlways
+	// ScrollBarVisibilityNever hides the bars, leaving input as the only way to scroll.
+	//
+	// Since: 2.6
+	ScrollBarVisibilityNever = widget.ScrollBarVisibilityNever
+)
+
 // NewScroll creates a scrollable parent wrapping the specified content.
 // Note that this may cause the MinSize to be smaller than that of the passed object.
 //
@@ -53,3 +74,34 @@ func NewHScroll(content fyne.CanvasObject) *Scroll {
 func NewVScroll(content fyne.CanvasObject) *Scroll {
 	return widget.NewVScroll(content)
 }
+
+// StickyRegion pins its content to the leading edge of a Scroll once it would
+// otherwise move out of view, floating over the items that follow it.
+// It must be a direct child of the container set as the Scroll content.
+//
+// Since: 2.6
+type StickyRegion = widget.StickyRegion
+
+// NewStickyRegion creates a sticky region wrapping the given object, for use
+// directly inside the content of a Scroll.
+//
+// Since: 2.6
+func NewStickyRegion(content fyne.CanvasObject) *StickyRegion {
+	return widget.NewStickyRegion(content)
+}
+
+// ParallaxRegion moves its content at a fraction of the scrolling rate, giving
+// a depth effect for backgrounds and collapsing app bars.
+// It must be a direct child of the container set as the Scroll content.
+//
+// Since: 2.6
+type ParallaxRegion = widget.ParallaxRegion
+
+// NewParallaxRegion creates a parallax region wrapping the given object,
+// scrolling at the given fraction of the normal rate, for use directly inside
+// the content of a Scroll.
+//
+// Since: 2.6
+func NewParallaxRegion(content fyne.CanvasObject, rate float32) *ParallaxRegion {
+	return widget.NewParallaxRegion(content, rate)
+}
@@ -1,13 +1,20 @@
 package container
 
 import (
+	"math"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/data/binding"
 	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 )
 
+// splitSnapDistance is how close (as a fraction of the length) the divider has
+// to be released to one of the SnapOffsets for it to snap there.
+const splitSnapDistance = 0.05
+
 // Declare conformity with CanvasObject interface
 var _ fyne.CanvasObject = (*Split)(nil)
 
@@ -20,6 +27,37 @@ type Split struct {
 	Horizontal bool
 	Leading    fyne.CanvasObject
 	Trailing   fyne.CanvasObject
+
+	// MinLeadingSize is a minimum width (if horizontal) or height (if vertical)
+	// for the leading pane, on top of the minimum of its content.
+	//
+	// Since: 2.6
+	MinLeadingSize float32
+	// MinTrailingSize is a minimum width (if horizontal) or height (if vertical)
+	// for the trailing pane, on top of the minimum of its content.
+	//
+	// Since: 2.6
+	MinTrailingSize float32
+
+	// SnapOffsets lists offsets that the divider snaps to when released nearby.
+	//
+	// Since: 2.6
+	SnapOffsets []float64
+
+	// ShowCollapseButtons adds buttons to the divider that collapse one of the
+	// panes to its edge, and restore it when tapped again.
+	//
+	// Since: 2.6
+	ShowCollapseButtons bool
+
+	// OnChanged is called when the divider offset changes.
+	//
+	// Since: 2.6
+	OnChanged func(float64) `json:"-"`
+
+	uncollapsedOffset float64
+	offsetData        binding.Float
+	offsetListener    binding.DataListener
 }
 
 // NewHSplit creates a horizontally arranged container with the specified leading and trailing elements.
@@ -76,9 +114,96 @@ func (s *Split) SetOffset(offset float64) {
 		return
 	}
 	s.Offset = offset
+	if f := s.OnChanged; f != nil {
+		f(offset)
+	}
+	s.Refresh()
+}
+
+// BindOffset connects the specified data source to this Split's divider offset.
+// The current value will be applied and any changes in the data will move the
+// divider. Dragging the divider will set the value into the data source, so the
+// layout can be persisted, for example through binding.BindPreferenceFloat.
+//
+// Since: 2.6
+func (s *Split) BindOffset(data binding.Float) {
+	s.UnbindOffset()
+	s.offsetData = data
+	s.offsetListener = binding.NewDataListener(func() {
+		val, err := data.Get()
+		if err != nil {
+			fyne.LogError("Error getting current data value", err)
+			return
+		}
+		s.SetOffset(val)
+	})
+	data.AddListener(s.offsetListener)
+
+	s.OnChanged = func(f float64) {
+		if val, err := data.Get(); err == nil && val == f {
+			return
+		}
+		if err := data.Set(f); err != nil {
+			fyne.LogError("Failed to set binding value", err)
+		}
+	}
+}
+
+// UnbindOffset disconnects any configured data source from this Split.
+// The divider will remain at the last value of the data source.
+//
+// Since: 2.6
+func (s *Split) UnbindOffset() {
+	if s.offsetData == nil {
+		return
+	}
+	s.offsetData.RemoveListener(s.offsetListener)
+	s.offsetData = nil
+	s.offsetListener = nil
+	s.OnChanged = nil
+}
+
+// collapseLeading hides the leading pane, remembering the current offset,
+// or restores it if it is already collapsed.
+func (s *Split) collapseLeading() {
+	if !s.Leading.Visible() {
+		s.Leading.Show()
+		s.SetOffset(s.uncollapsedOffset)
+		s.Refresh()
+		return
+	}
+	s.uncollapsedOffset = s.Offset
+	s.Leading.Hide()
+	s.SetOffset(0)
+	s.Refresh()
+}
+
+// collapseTrailing hides the trailing pane, remembering the current offset,
+// or restores it if it is already collapsed.
+func (s *Split) collapseTrailing() {
+	if !s.Trailing.Visible() {
+		s.Trailing.Show()
+		s.SetOffset(s.uncollapsedOffset)
+		s.Refresh()
+		return
+	}
+	s.uncollapsedOffset = s.Offset
+	s.Trailing.Hide()
+	s.SetOffset(1)
 	s.Refresh()
 }
 
+// snapOffset moves the divider to the closest of the SnapOffsets, if one is
+// within snapping distance of the current offset.
+func (s *Split) snapOffset() {
+	for _, snap := range s.SnapOffsets {
+		if math.Abs(snap-s.Offset) <= splitSnapDistance {
+			s.SetOffset(snap)
+			return
+		}
+	}
+}
+
 var _ fyne.WidgetRenderer = (*splitContainerRenderer)(nil)
 
 type splitContainerRenderer struct {
@@ -131,12 +256,19 @@ func (r *splitContainerRenderer) MinSize() fyne.Size {
 	s := fyne.NewSize(0, 0)
 	for _, o := range r.objects {
 		min := o.MinSize()
+		extra := float32(0)
+		switch o {
+		case r.split.Leading:
+			extra = r.split.MinLeadingSize
+		case r.split.Trailing:
+			extra = r.split.MinTrailingSize
+		}
 		if r.split.Horizontal {
-			s.Width += min.Width
+			s.Width += fyne.Max(min.Width, extra)
 			s.Height = fyne.Max(s.Height, min.Height)
 		} else {
 			s.Width = fyne.Max(s.Width, min.Width)
-			s.Height += min.Height
+			s.Height += fyne.Max(min.Height, extra)
 		}
 	}
 	return s
@@ -185,28 +317,28 @@ func (r *splitContainerRenderer) computeSplitLengths(total, lMin, tMin float32)
 
 func (r *splitContainerRenderer) minLeadingWidth() float32 {
 	if r.split.Leading.Visible() {
-		return r.split.Leading.MinSize().Width
+		return fyne.Max(r.split.Leading.MinSize().Width, r.split.MinLeadingSize)
 	}
 	return 0
 }
 
 func (r *splitContainerRenderer) minLeadingHeight() float32 {
 	if r.split.Leading.Visible() {
-		return r.split.Leading.MinSize().Height
+		return fyne.Max(r.split.Leading.MinSize().Height, r.split.MinLeadingSize)
 	}
 	return 0
 }
 
 func (r *splitContainerRenderer) minTrailingWidth() float32 {
 	if r.split.Trailing.Visible() {
-		return r.split.Trailing.MinSize().Width
+		return fyne.Max(r.split.Trailing.MinSize().Width, r.split.MinTrailingSize)
 	}
 	return 0
 }
 
 func (r *splitContainerRenderer) minTrailingHeight() float32 {
 	if r.split.Trailing.Visible() {
-		return r.split.Trailing.MinSize().Height
+		return fyne.Max(r.split.Trailing.MinSize().Height, r.split.MinTrailingSize)
 	}
 	return 0
 }
@@ -214,6 +346,7 @@ func (r *splitContainerRenderer) minTrailingHeight() float32 {
 // Declare conformity with interfaces
 var _ fyne.CanvasObject = (*divider)(nil)
 var _ fyne.Draggable = (*divider)(nil)
+var _ fyne.DoubleTappable = (*divider)(nil)
 var _ desktop.Cursorable = (*divider)(nil)
 var _ desktop.Hoverable = (*divider)(nil)
 
@@ -241,11 +374,27 @@ func (d *divider) CreateRenderer() fyne.WidgetRenderer {
 
 	background := canvas.NewRectangle(th.Color(theme.ColorNameShadow, v))
 	foreground := canvas.NewRectangle(th.Color(theme.ColorNameForeground, v))
+
+	leadIcon, trailIcon := th.Icon(theme.IconNameNavigateBack), th.Icon(theme.IconNameNavigateNext)
+	if !d.split.Horizontal {
+		leadIcon, trailIcon = th.Icon(theme.IconNameMoveUp), th.Icon(theme.IconNameMoveDown)
+	}
+	collapseLeading := widget.NewButtonWithIcon("", leadIcon, d.split.collapseLeading)
+	collapseLeading.Importance = widget.LowImportance
+	collapseTrailing := widget.NewButtonWithIcon("", trailIcon, d.split.collapseTrailing)
+	collapseTrailing.Importance = widget.LowImportance
+	if !d.split.ShowCollapseButtons {
+		collapseLeading.Hide()
+		collapseTrailing.Hide()
+	}
+
 	return &dividerRenderer{
-		divider:    d,
-		background: background,
-		foreground: foreground,
-		objects:    []fyne.CanvasObject{background, foreground},
+		divider:          d,
+		background:       background,
+		foreground:       foreground,
+		collapseLeading:  collapseLeading,
+		collapseTrailing: collapseTrailing,
+		objects:          []fyne.CanvasObject{background, foreground, collapseLeading, collapseTrailing},
 	}
 }
 
@@ -256,8 +405,14 @@ func (d *divider) Cursor() desktop.Cursor {
 	return desktop.VResizeCursor
 }
 
+// DoubleTapped resets the divider to share the space equally between the panes.
+func (d *divider) DoubleTapped(*fyne.PointEvent) {
+	d.split.SetOffset(0.5)
+}
+
 func (d *divider) DragEnd() {
 	d.startDragOff = nil
+	d.split.snapOffset()
 }
 
 func (d *divider) Dragged(e *fyne.DragEvent) {
@@ -273,13 +428,13 @@ func (d *divider) Dragged(e *fyne.DragEvent) {
 	var offset, leadingRatio, trailingRatio float64
 	if d.split.Horizontal {
 		widthFree := float64(d.split.Size().Width - dividerThickness(d))
-		leadingRatio = float64(d.split.Leading.MinSize().Width) / widthFree
-		trailingRatio = 1. - (float64(d.split.Trailing.MinSize().Width) / widthFree)
+		leadingRatio = float64(fyne.Max(d.split.Leading.MinSize().Width, d.split.MinLeadingSize)) / widthFree
+		trailingRatio = 1. - (float64(fyne.Max(d.split.Trailing.MinSize().Width, d.split.MinTrailingSize)) / widthFree)
 		offset = float64(x-d.startDragOff.X) / widthFree
 	} else {
 		heightFree := float64(d.split.Size().Height - dividerThickness(d))
-		leadingRatio = float64(d.split.Leading.MinSize().Height) / heightFree
-		trailingRatio = 1. - (float64(d.split.Trailing.MinSize().Height) / heightFree)
+		leadingRatio = float64(fyne.Max(d.split.Leading.MinSize().Height, d.split.MinLeadingSize)) / heightFree
+		trailingRatio = 1. - (float64(fyne.Max(d.split.Trailing.MinSize().Height, d.split.MinTrailingSize)) / heightFree)
 		offset = float64(y-d.startDragOff.Y) / heightFree
 	}
 
@@ -307,10 +462,12 @@ func (d *divider) MouseOut() {
 var _ fyne.WidgetRenderer = (*dividerRenderer)(nil)
 
 type dividerRenderer struct {
-	divider    *divider
-	background *canvas.Rectangle
-	foreground *canvas.Rectangle
-	objects    []fyne.CanvasObject
+	divider          *divider
+	background       *canvas.Rectangle
+	foreground       *canvas.Rectangle
+	collapseLeading  *widget.Button
+	collapseTrailing *widget.Button
+	objects          []fyne.CanvasObject
 }
 
 func (r *dividerRenderer) Destroy() {
@@ -332,6 +489,22 @@ func (r *dividerRenderer) Layout(size fyne.Size) {
 	}
 	r.foreground.Move(fyne.NewPos(x, y))
 	r.foreground.Resize(fyne.NewSize(w, h))
+
+	// the collapse buttons sit across the divider near its start, overhanging the panes
+	btn := r.divider.Theme().Size(theme.SizeNameInlineIcon)
+	pad := r.divider.Theme().Size(theme.SizeNamePadding)
+	btnSize := fyne.NewSize(btn, btn)
+	r.collapseLeading.Resize(btnSize)
+	r.collapseTrailing.Resize(btnSize)
+	if r.divider.split.Horizontal {
+		center := (dividerThickness(r.divider) - btn) / 2
+		r.collapseLeading.Move(fyne.NewPos(center, pad))
+		r.collapseTrailing.Move(fyne.NewPos(center, pad*2+btn))
+	} else {
+		center := (dividerThickness(r.divider) - btn) / 2
+		r.collapseLeading.Move(fyne.NewPos(pad, center))
+		r.collapseTrailing.Move(fyne.NewPos(pad*2+btn, center))
+	}
 }
 
 func (r *dividerRenderer) MinSize() fyne.Size {
@@ -357,6 +530,13 @@ func (r *dividerRenderer) Refresh() {
 	r.background.Refresh()
 	r.foreground.FillColor = th.Color(theme.ColorNameForeground, v)
 	r.foreground.Refresh()
+	if r.divider.split.ShowCollapseButtons {
+		r.collapseLeading.Show()
+		r.collapseTrailing.Show()
+	} else {
+		r.collapseLeading.Hide()
+		r.collapseTrailing.Hide()
+	}
 	r.Layout(r.divider.Size())
 }
 
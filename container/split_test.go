@@ -3,9 +3,11 @@ package container
 import (
 	"image/color"
 	"testing"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/data/binding"
 	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/test"
 	"fyne.io/fyne/v2/theme"
@@ -521,3 +523,101 @@ func TestSplitContainer_Hidden(t *testing.T) {
 		assert.Equal(t, float32(0), sc.Trailing.Size().Height)
 	})
 }
+
+func TestSplitContainer_SnapOffsets(t *testing.T) {
+	size := fyne.NewSize(10, 10)
+	objA := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	objA.SetMinSize(size)
+	objB := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	objB.SetMinSize(size)
+
+	split := NewHSplit(objA, objB)
+	split.SnapOffsets = []float64{0.25, 0.75}
+	split.Resize(fyne.NewSize(108, 108))
+	divider := test.TempWidgetRenderer(t, split).(*splitContainerRenderer).divider
+
+	divider.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(13, 9)},
+		Dragged:    fyne.NewDelta(22, 0),
+	})
+	assert.Equal(t, 0.72, split.Offset)
+
+	divider.DragEnd()
+	assert.Equal(t, 0.75, split.Offset)
+}
+
+func TestSplitContainer_DoubleTapReset(t *testing.T) {
+	objA := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	objB := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+
+	split := NewHSplit(objA, objB)
+	split.Resize(fyne.NewSize(100, 100))
+	divider := test.TempWidgetRenderer(t, split).(*splitContainerRenderer).divider
+
+	split.SetOffset(0.8)
+	divider.DoubleTapped(&fyne.PointEvent{})
+	assert.Equal(t, 0.5, split.Offset)
+}
+
+func TestSplitContainer_MinPaneSize(t *testing.T) {
+	objA := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	objB := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+
+	split := NewHSplit(objA, objB)
+	split.MinLeadingSize = 40
+	split.Resize(fyne.NewSize(100+dividerThickness(nil), 100))
+
+	split.SetOffset(0)
+	assert.Equal(t, float32(40), split.Leading.Size().Width)
+	assert.GreaterOrEqual(t, split.MinSize().Width, float32(40))
+}
+
+func TestSplitContainer_BindOffset(t *testing.T) {
+	objA := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	objB := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+
+	split := NewHSplit(objA, objB)
+	split.Resize(fyne.NewSize(100, 100))
+
+	data := binding.NewFloat()
+	err := data.Set(0.25)
+	assert.NoError(t, err)
+	split.BindOffset(data)
+	waitForBinding()
+	assert.Equal(t, 0.25, split.Offset)
+
+	split.SetOffset(0.75)
+	waitForBinding()
+	val, err := data.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, 0.75, val)
+
+	split.UnbindOffset()
+	split.SetOffset(0.5)
+	waitForBinding()
+	val, err = data.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, 0.75, val)
+}
+
+func waitForBinding() {
+	time.Sleep(time.Millisecond * 100) // data resolves on background thread
+}
+
+func TestSplitContainer_CollapseButtons(t *testing.T) {
+	objA := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	objB := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+
+	split := NewHSplit(objA, objB)
+	split.ShowCollapseButtons = true
+	split.Resize(fyne.NewSize(100, 100))
+	split.SetOffset(0.3)
+
+	split.collapseLeading()
+	assert.False(t, split.Leading.Visible())
+	assert.Equal(t, 0.0, split.Offset)
+
+	split.collapseLeading()
+	assert.True(t, split.Leading.Visible())
+	assert.Equal(t, 0.3, split.Offset)
+}
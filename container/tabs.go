@@ -21,6 +21,12 @@ type TabItem struct {
 	Icon    fyne.Resource
 	Content fyne.CanvasObject
 
+	// Pinned excludes this item from closing and drag reordering when it is
+	// shown in a DocTabs container.
+	//
+	// Since: 2.6
+	Pinned bool
+
 	button *tabButton
 }
 
@@ -499,18 +505,24 @@ const (
 
 var _ fyne.Widget = (*tabButton)(nil)
 var _ fyne.Tappable = (*tabButton)(nil)
+var _ fyne.SecondaryTappable = (*tabButton)(nil)
+var _ fyne.Draggable = (*tabButton)(nil)
 var _ desktop.Hoverable = (*tabButton)(nil)
+var _ desktop.Mouseable = (*tabButton)(nil)
 
 type tabButton struct {
 	widget.DisableableWidget
-	hovered       bool
-	icon          fyne.Resource
-	iconPosition  buttonIconPosition
-	importance    widget.Importance
-	onTapped      func()
-	onClosed      func()
-	text          string
-	textAlignment fyne.TextAlign
+	hovered           bool
+	icon              fyne.Resource
+	iconPosition      buttonIconPosition
+	importance        widget.Importance
+	onTapped          func()
+	onTappedSecondary func(*fyne.PointEvent)
+	onClosed          func()
+	onDragged         func(*fyne.DragEvent)
+	onDragEnd         func()
+	text              string
+	textAlignment     fyne.TextAlign
 }
 
 func (b *tabButton) CreateRenderer() fyne.WidgetRenderer {
@@ -558,6 +570,29 @@ func (b *tabButton) MinSize() fyne.Size {
 	return b.BaseWidget.MinSize()
 }
 
+func (b *tabButton) DragEnd() {
+	if f := b.onDragEnd; f != nil {
+		f()
+	}
+}
+
+func (b *tabButton) Dragged(e *fyne.DragEvent) {
+	if f := b.onDragged; f != nil {
+		f(e)
+	}
+}
+
+// MouseDown closes the tab on a middle click, matching browser and editor tab bars.
+func (b *tabButton) MouseDown(e *desktop.MouseEvent) {
+	if b.Disabled() || e.Button != desktop.MouseButtonTertiary {
+		return
+	}
+
+	if f := b.onClosed; f != nil {
+		f()
+	}
+}
+
 func (b *tabButton) MouseIn(*desktop.MouseEvent) {
 	b.hovered = true
 	b.Refresh()
@@ -571,6 +606,9 @@ func (b *tabButton) MouseOut() {
 	b.Refresh()
 }
 
+func (b *tabButton) MouseUp(*desktop.MouseEvent) {
+}
+
 func (b *tabButton) Tapped(*fyne.PointEvent) {
 	if b.Disabled() {
 		return
@@ -579,6 +617,16 @@ func (b *tabButton) Tapped(*fyne.PointEvent) {
 	b.onTapped()
 }
 
+func (b *tabButton) TappedSecondary(e *fyne.PointEvent) {
+	if b.Disabled() {
+		return
+	}
+
+	if f := b.onTappedSecondary; f != nil {
+		f(e)
+	}
+}
+
 type tabButtonRenderer struct {
 	button     *tabButton
 	background *canvas.Rectangle
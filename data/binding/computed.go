@@ -0,0 +1,72 @@
+package binding
+
+import "reflect"
+
+// Computed is a data item whose value is calculated from other data items.
+// See NewComputed to derive state such as a total from price and quantity.
+//
+// Since: 2.6
+type Computed[T any] interface {
+	DataItem
+	Get() (T, error)
+}
+
+// NewComputed returns a binding whose value is recalculated by the passed
+// function whenever any of the dependencies it reads from change. Changes to
+// several dependencies in quick succession are batched into a single
+// recalculation, so listeners never observe partially updated state and are
+// only notified when the calculated value actually changed.
+//
+// Since: 2.6
+func NewComputed[T any](calculate func() (T, error), depends ...DataItem) Computed[T] {
+	c := &computed[T]{calculate: calculate}
+	c.val, c.err = calculate()
+
+	listen := NewDataListener(c.invalidate)
+	for _, d := range depends {
+		d.AddListener(listen)
+	}
+	return c
+}
+
+type computed[T any] struct {
+	base
+
+	calculate func() (T, error)
+	val       T
+	err       error
+	pending   bool
+}
+
+func (c *computed[T]) Get() (T, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.val, c.err
+}
+
+// invalidate marks the value stale and schedules a single recalculation,
+// no matter how many dependencies changed in this batch of events.
+func (c *computed[T]) invalidate() {
+	c.lock.Lock()
+	stale := c.pending
+	c.pending = true
+	c.lock.Unlock()
+
+	if !stale {
+		queueItem(c.recalculate)
+	}
+}
+
+func (c *computed[T]) recalculate() {
+	c.lock.Lock()
+	c.pending = false
+	val, err := c.calculate()
+	changed := err != c.err || !reflect.DeepEqual(val, c.val)
+	c.val, c.err = val, err
+	c.lock.Unlock()
+
+	if changed {
+		c.trigger()
+	}
+}
@@ -0,0 +1,99 @@
+package binding
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewComputed(t *testing.T) {
+	price := NewFloat()
+	qty := NewInt()
+	total := NewComputed(func() (float64, error) {
+		p, err := price.Get()
+		if err != nil {
+			return 0, err
+		}
+		q, err := qty.Get()
+		if err != nil {
+			return 0, err
+		}
+		return p * float64(q), nil
+	}, price, qty)
+
+	v, err := total.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, v)
+
+	assert.NoError(t, price.Set(2.5))
+	assert.NoError(t, qty.Set(4))
+	waitForItems()
+	waitForItems() // the recalculation is queued behind the change events
+
+	v, err = total.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, v)
+}
+
+func TestComputed_Batching(t *testing.T) {
+	a := NewInt()
+	b := NewInt()
+	calculations := 0
+	sum := NewComputed(func() (int, error) {
+		calculations++
+		av, _ := a.Get()
+		bv, _ := b.Get()
+		return av + bv, nil
+	}, a, b)
+
+	notified := 0
+	sum.AddListener(NewDataListener(func() {
+		notified++
+	}))
+	waitForItems()
+	waitForItems()
+	assert.Equal(t, 1, notified) // initial connection only
+
+	calculations = 0
+	assert.NoError(t, a.Set(1))
+	assert.NoError(t, b.Set(2))
+	waitForItems()
+	waitForItems()
+	waitForItems()
+
+	v, err := sum.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, v)
+	assert.Equal(t, 1, calculations) // both changes batched into one pass
+	assert.Equal(t, 2, notified)
+
+	assert.NoError(t, a.Set(1)) // no change in the calculated value
+	waitForItems()
+	waitForItems()
+	waitForItems()
+	assert.Equal(t, 2, notified)
+}
+
+func TestComputed_Error(t *testing.T) {
+	flag := NewBool()
+	failed := errors.New("failed")
+	item := NewComputed(func() (string, error) {
+		on, _ := flag.Get()
+		if on {
+			return "", failed
+		}
+		return "ok", nil
+	}, flag)
+
+	v, err := item.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", v)
+
+	assert.NoError(t, flag.Set(true))
+	waitForItems()
+	waitForItems()
+
+	_, err = item.Get()
+	assert.Equal(t, failed, err)
+}
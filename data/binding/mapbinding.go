@@ -73,6 +73,7 @@ type Struct interface {
 // BindStruct creates a new map binding of string to any using the struct passed as data.
 // The key for each item is a string representation of each exported field with the value set as an any.
 // Only exported fields are included.
+// Since 2.6 a `binding` struct tag may rename the key of a field, or skip the field entirely using "-".
 //
 // Since: 2.0
 func BindStruct(i any) Struct {
@@ -99,7 +100,10 @@ func BindStruct(i any) Struct {
 			continue
 		}
 
-		key := t.Field(j).Name
+		key, ok := structFieldKey(t.Field(j))
+		if !ok {
+			continue
+		}
 		s.items[key] = bindReflect(f)
 		(*s.val)[key] = f.Interface()
 	}
@@ -107,6 +111,19 @@ func BindStruct(i any) Struct {
 	return s
 }
 
+// structFieldKey returns the binding key for a struct field, honouring a
+// `binding` tag that can rename the field or skip it entirely with "-".
+func structFieldKey(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("binding")
+	if tag == "-" {
+		return "", false
+	}
+	if tag != "" {
+		return tag, true
+	}
+	return f.Name, true
+}
+
 type reflectUntyped interface {
 	DataItem
 	get() (any, error)
@@ -280,7 +297,10 @@ func (b *boundStruct) Reload() (retErr error) {
 			continue
 		}
 
-		key := t.Field(j).Name
+		key, ok := structFieldKey(t.Field(j))
+		if !ok {
+			continue
+		}
 		old := (*b.val)[key]
 		if f.Interface() == old {
 			continue
@@ -12,6 +12,16 @@ type DataTree interface {
 	ChildIDs(string) []string
 }
 
+// DataTreeBranchChecker is an optional interface for data trees that know which
+// of their nodes are branches without resolving the children. Data backed by a
+// slow source, such as a filesystem or remote API, can implement it so widgets
+// only call ChildIDs once a branch is expanded.
+//
+// Since: 2.6
+type DataTreeBranchChecker interface {
+	IsBranch(id string) bool
+}
+
 type treeBase struct {
 	base
 
@@ -0,0 +1,255 @@
+// Package richtext provides a data model for rich text documents that is decoupled from
+// any widget rendering. A Document holds styled text segments and supports edit operations
+// over rune ranges, notifying listeners of every change. Multiple views can observe one
+// document, and the change feed provides the information needed to implement undo or
+// collaborative editing on top.
+package richtext // import "fyne.io/fyne/v2/data/richtext"
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode/utf8"
+
+	"fyne.io/fyne/v2"
+)
+
+// Style describes the appearance of a run of text within a document.
+// The zero value represents regular text using theme defaults.
+type Style struct {
+	Bold      bool
+	Italic    bool
+	Monospace bool
+	Underline bool
+
+	// ColorName and SizeName refer to theme lookups, empty values use the defaults.
+	ColorName fyne.ThemeColorName
+	SizeName  fyne.ThemeSizeName
+}
+
+// Segment is a run of text that shares a single style.
+type Segment struct {
+	Text  string
+	Style Style
+}
+
+// Range identifies a region of a document between two rune offsets.
+// Start is inclusive and End is exclusive.
+type Range struct {
+	Start, End int
+}
+
+// Change describes a single edit applied to a document.
+type Change struct {
+	// Range covers the affected region, in rune offsets after the edit was applied.
+	Range Range
+	// Inserted holds any text added by the edit.
+	Inserted string
+	// Deleted holds any text removed by the edit.
+	Deleted string
+	// Restyled indicates the edit only adjusted styling and left the text unchanged.
+	Restyled bool
+}
+
+// Document is an ordered sequence of styled text segments with edit operations.
+// It is safe for concurrent use.
+type Document struct {
+	segments  []Segment
+	lock      sync.RWMutex
+	listeners []func(Change)
+
+	opListeners []func(Operation)
+	suppressOps atomic.Bool
+}
+
+// NewDocument returns an empty rich text document.
+func NewDocument() *Document {
+	return &Document{}
+}
+
+// AddChangeListener registers a callback that is invoked after each edit to this document.
+func (d *Document) AddChangeListener(listener func(Change)) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.listeners = append(d.listeners, listener)
+}
+
+// Len returns the length of the document text in runes.
+func (d *Document) Len() int {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	length := 0
+	for _, seg := range d.segments {
+		length += utf8.RuneCountInString(seg.Text)
+	}
+	return length
+}
+
+// Segments returns a copy of the styled text runs that make up this document.
+func (d *Document) Segments() []Segment {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	out := make([]Segment, len(d.segments))
+	copy(out, d.segments)
+	return out
+}
+
+// String returns the plain text of the whole document.
+func (d *Document) String() string {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	var text strings.Builder
+	for _, seg := range d.segments {
+		text.WriteString(seg.Text)
+	}
+	return text.String()
+}
+
+// Text returns the plain text within the given range.
+func (d *Document) Text(r Range) string {
+	text := []rune(d.String())
+	r = d.clampRange(r, len(text))
+	return string(text[r.Start:r.End])
+}
+
+// Insert adds styled text at the given rune offset.
+func (d *Document) Insert(pos int, text string, style Style) {
+	if text == "" {
+		return
+	}
+	d.lock.Lock()
+	pos = clampOffset(pos, d.length())
+	i := d.splitAt(pos)
+	d.segments = append(d.segments[:i], append([]Segment{{Text: text, Style: style}}, d.segments[i:]...)...)
+	d.merge()
+	d.lock.Unlock()
+
+	d.fireChange(Change{
+		Range:    Range{Start: pos, End: pos + utf8.RuneCountInString(text)},
+		Inserted: text,
+	})
+	d.fireOperation(Operation{Pos: pos, Insert: text})
+}
+
+// Delete removes the text within the given range.
+func (d *Document) Delete(r Range) {
+	d.lock.Lock()
+	r = d.clampRange(r, d.length())
+	if r.Start == r.End {
+		d.lock.Unlock()
+		return
+	}
+	i := d.splitAt(r.Start)
+	j := d.splitAt(r.End)
+	var deleted strings.Builder
+	for _, seg := range d.segments[i:j] {
+		deleted.WriteString(seg.Text)
+	}
+	d.segments = append(d.segments[:i], d.segments[j:]...)
+	d.merge()
+	d.lock.Unlock()
+
+	d.fireChange(Change{
+		Range:   Range{Start: r.Start, End: r.Start},
+		Deleted: deleted.String(),
+	})
+	d.fireOperation(Operation{Pos: r.Start, Delete: r.End - r.Start})
+}
+
+// SetStyle applies the given style to the text within the range.
+func (d *Document) SetStyle(r Range, style Style) {
+	d.lock.Lock()
+	r = d.clampRange(r, d.length())
+	if r.Start == r.End {
+		d.lock.Unlock()
+		return
+	}
+	i := d.splitAt(r.Start)
+	j := d.splitAt(r.End)
+	for k := i; k < j; k++ {
+		d.segments[k].Style = style
+	}
+	d.merge()
+	d.lock.Unlock()
+
+	d.fireChange(Change{Range: r, Restyled: true})
+}
+
+// length returns the rune length without locking, for use by edit operations.
+func (d *Document) length() int {
+	length := 0
+	for _, seg := range d.segments {
+		length += utf8.RuneCountInString(seg.Text)
+	}
+	return length
+}
+
+// splitAt ensures a segment boundary exists at the given rune offset,
+// returning the index of the segment that starts there.
+func (d *Document) splitAt(pos int) int {
+	off := 0
+	for i, seg := range d.segments {
+		if off == pos {
+			return i
+		}
+		length := utf8.RuneCountInString(seg.Text)
+		if pos < off+length {
+			runes := []rune(seg.Text)
+			head := Segment{Text: string(runes[:pos-off]), Style: seg.Style}
+			tail := Segment{Text: string(runes[pos-off:]), Style: seg.Style}
+			d.segments = append(d.segments[:i], append([]Segment{head, tail}, d.segments[i+1:]...)...)
+			return i + 1
+		}
+		off += length
+	}
+	return len(d.segments)
+}
+
+// merge drops empty segments and joins neighbouring segments that share a style.
+func (d *Document) merge() {
+	out := d.segments[:0]
+	for _, seg := range d.segments {
+		if seg.Text == "" {
+			continue
+		}
+		if len(out) > 0 && out[len(out)-1].Style == seg.Style {
+			out[len(out)-1].Text += seg.Text
+			continue
+		}
+		out = append(out, seg)
+	}
+	d.segments = out
+}
+
+func (d *Document) clampRange(r Range, length int) Range {
+	r.Start = clampOffset(r.Start, length)
+	r.End = clampOffset(r.End, length)
+	if r.End < r.Start {
+		r.Start, r.End = r.End, r.Start
+	}
+	return r
+}
+
+func (d *Document) fireChange(change Change) {
+	d.lock.RLock()
+	listeners := d.listeners
+	d.lock.RUnlock()
+
+	for _, listener := range listeners {
+		listener(change)
+	}
+}
+
+func clampOffset(pos, length int) int {
+	if pos < 0 {
+		return 0
+	}
+	if pos > length {
+		return length
+	}
+	return pos
+}
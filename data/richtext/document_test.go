@@ -0,0 +1,82 @@
+package richtext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocument_Insert(t *testing.T) {
+	doc := NewDocument()
+	doc.Insert(0, "hello world", Style{})
+
+	assert.Equal(t, "hello world", doc.String())
+	assert.Equal(t, 11, doc.Len())
+
+	doc.Insert(5, " there", Style{})
+	assert.Equal(t, "hello there world", doc.String())
+	assert.Equal(t, 1, len(doc.Segments()))
+}
+
+func TestDocument_Insert_Styled(t *testing.T) {
+	doc := NewDocument()
+	doc.Insert(0, "ab", Style{})
+	doc.Insert(1, "X", Style{Bold: true})
+
+	segs := doc.Segments()
+	assert.Equal(t, 3, len(segs))
+	assert.Equal(t, "X", segs[1].Text)
+	assert.True(t, segs[1].Style.Bold)
+	assert.Equal(t, "aXb", doc.String())
+}
+
+func TestDocument_Delete(t *testing.T) {
+	doc := NewDocument()
+	doc.Insert(0, "hello there world", Style{})
+	doc.Delete(Range{Start: 5, End: 11})
+
+	assert.Equal(t, "hello world", doc.String())
+
+	doc.Delete(Range{Start: 5, End: 100}) // out of bounds is clamped
+	assert.Equal(t, "hello", doc.String())
+}
+
+func TestDocument_SetStyle(t *testing.T) {
+	doc := NewDocument()
+	doc.Insert(0, "hello world", Style{})
+	doc.SetStyle(Range{Start: 6, End: 11}, Style{Italic: true})
+
+	segs := doc.Segments()
+	assert.Equal(t, 2, len(segs))
+	assert.Equal(t, "world", segs[1].Text)
+	assert.True(t, segs[1].Style.Italic)
+
+	doc.SetStyle(Range{Start: 0, End: 11}, Style{}) // segments merge again
+	assert.Equal(t, 1, len(doc.Segments()))
+}
+
+func TestDocument_Text(t *testing.T) {
+	doc := NewDocument()
+	doc.Insert(0, "héllo", Style{})
+
+	assert.Equal(t, "éll", doc.Text(Range{Start: 1, End: 4}))
+	assert.Equal(t, 5, doc.Len())
+}
+
+func TestDocument_ChangeListener(t *testing.T) {
+	doc := NewDocument()
+	changes := []Change{}
+	doc.AddChangeListener(func(c Change) {
+		changes = append(changes, c)
+	})
+
+	doc.Insert(0, "abc", Style{})
+	doc.Delete(Range{Start: 0, End: 1})
+	doc.SetStyle(Range{Start: 0, End: 2}, Style{Bold: true})
+
+	assert.Equal(t, 3, len(changes))
+	assert.Equal(t, "abc", changes[0].Inserted)
+	assert.Equal(t, Range{Start: 0, End: 3}, changes[0].Range)
+	assert.Equal(t, "a", changes[1].Deleted)
+	assert.True(t, changes[2].Restyled)
+}
@@ -0,0 +1,101 @@
+package richtext
+
+import "unicode/utf8"
+
+// Operation describes a text edit in a form that can be exchanged with remote collaborators.
+// An operation inserts text, deletes a run of text, or both at a single rune offset
+// (a replacement deletes then inserts).
+type Operation struct {
+	// Pos is the rune offset where the edit applies.
+	Pos int
+	// Insert holds text added at Pos.
+	Insert string
+	// Delete is the number of runes removed at Pos, before any insertion.
+	Delete int
+}
+
+// AddOperationListener registers a callback that receives an Operation for each local edit,
+// suitable for broadcasting to collaborators. Edits applied through ApplyRemote are not
+// reported, preventing echo when wiring a document to an OT or CRDT backend.
+// Style-only changes do not generate operations.
+func (d *Document) AddOperationListener(listener func(Operation)) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.opListeners = append(d.opListeners, listener)
+}
+
+// ApplyRemote applies an operation received from a collaborator to this document.
+// Change listeners are notified so any views update, but operation listeners are not.
+// Concurrent local edits should be transformed against with Transform before applying.
+func (d *Document) ApplyRemote(op Operation) {
+	d.suppressOps.Store(true)
+	defer d.suppressOps.Store(false)
+
+	if op.Delete > 0 {
+		d.Delete(Range{Start: op.Pos, End: op.Pos + op.Delete})
+	}
+	if op.Insert != "" {
+		d.Insert(op.Pos, op.Insert, Style{})
+	}
+}
+
+// Transform adjusts op, produced against some document state, so that it can be applied
+// after other has already been applied to that same state. When both operations insert
+// at the same offset the other operation is ordered first.
+func Transform(op, other Operation) Operation {
+	if other.Delete > 0 {
+		otherEnd := other.Pos + other.Delete
+		if op.Delete > 0 {
+			opEnd := op.Pos + op.Delete
+			overlap := minInt(opEnd, otherEnd) - maxInt(op.Pos, other.Pos)
+			if overlap > 0 {
+				op.Delete -= overlap
+			}
+		}
+		if op.Pos >= otherEnd {
+			op.Pos -= other.Delete
+		} else if op.Pos > other.Pos {
+			op.Pos = other.Pos
+		}
+	}
+
+	if other.Insert != "" {
+		length := utf8.RuneCountInString(other.Insert)
+		if op.Pos >= other.Pos {
+			op.Pos += length
+		} else if op.Delete > 0 && op.Pos+op.Delete > other.Pos {
+			// the insertion landed inside the deleted run, remove it too
+			op.Delete += length
+		}
+	}
+	return op
+}
+
+func (d *Document) fireOperation(op Operation) {
+	if d.suppressOps.Load() {
+		return
+	}
+
+	d.lock.RLock()
+	listeners := d.opListeners
+	d.lock.RUnlock()
+
+	for _, listener := range listeners {
+		listener(op)
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,86 @@
+package richtext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocument_OperationListener(t *testing.T) {
+	doc := NewDocument()
+	ops := []Operation{}
+	doc.AddOperationListener(func(op Operation) {
+		ops = append(ops, op)
+	})
+
+	doc.Insert(0, "hello", Style{})
+	doc.Delete(Range{Start: 1, End: 3})
+	doc.SetStyle(Range{Start: 0, End: 3}, Style{Bold: true}) // style only, no operation
+
+	assert.Equal(t, 2, len(ops))
+	assert.Equal(t, Operation{Pos: 0, Insert: "hello"}, ops[0])
+	assert.Equal(t, Operation{Pos: 1, Delete: 2}, ops[1])
+}
+
+func TestDocument_ApplyRemote(t *testing.T) {
+	doc := NewDocument()
+	doc.Insert(0, "hello world", Style{})
+
+	ops := []Operation{}
+	doc.AddOperationListener(func(op Operation) {
+		ops = append(ops, op)
+	})
+	changes := 0
+	doc.AddChangeListener(func(Change) {
+		changes++
+	})
+
+	doc.ApplyRemote(Operation{Pos: 5, Delete: 6, Insert: " there"})
+
+	assert.Equal(t, "hello there", doc.String())
+	assert.Equal(t, 0, len(ops)) // remote edits are not echoed
+	assert.Equal(t, 2, changes)  // but views still hear about them
+}
+
+func TestTransform_AgainstInsert(t *testing.T) {
+	op := Transform(Operation{Pos: 4, Insert: "x"}, Operation{Pos: 2, Insert: "ab"})
+	assert.Equal(t, Operation{Pos: 6, Insert: "x"}, op)
+
+	op = Transform(Operation{Pos: 1, Insert: "x"}, Operation{Pos: 2, Insert: "ab"})
+	assert.Equal(t, Operation{Pos: 1, Insert: "x"}, op)
+
+	// the other insertion landed inside our deleted run
+	op = Transform(Operation{Pos: 1, Delete: 3}, Operation{Pos: 2, Insert: "ab"})
+	assert.Equal(t, Operation{Pos: 1, Delete: 5}, op)
+}
+
+func TestTransform_AgainstDelete(t *testing.T) {
+	op := Transform(Operation{Pos: 6, Insert: "x"}, Operation{Pos: 1, Delete: 2})
+	assert.Equal(t, Operation{Pos: 4, Insert: "x"}, op)
+
+	// insertion point inside the deleted run collapses to its start
+	op = Transform(Operation{Pos: 2, Insert: "x"}, Operation{Pos: 1, Delete: 2})
+	assert.Equal(t, Operation{Pos: 1, Insert: "x"}, op)
+
+	// overlapping deletes shrink by the shared region
+	op = Transform(Operation{Pos: 2, Delete: 4}, Operation{Pos: 4, Delete: 4})
+	assert.Equal(t, Operation{Pos: 2, Delete: 2}, op)
+}
+
+func TestTransform_Converges(t *testing.T) {
+	a := Operation{Pos: 2, Insert: "AA"}
+	b := Operation{Pos: 5, Delete: 3}
+
+	one := NewDocument()
+	one.Insert(0, "0123456789", Style{})
+	one.ApplyRemote(a)
+	one.ApplyRemote(Transform(b, a))
+
+	two := NewDocument()
+	two.Insert(0, "0123456789", Style{})
+	two.ApplyRemote(b)
+	two.ApplyRemote(Transform(a, b))
+
+	assert.Equal(t, one.String(), two.String())
+	assert.Equal(t, "01AA23489", one.String())
+}
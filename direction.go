@@ -0,0 +1,54 @@
+package fyne
+
+// LayoutDirection defines the horizontal direction that containers, toolbars
+// and directional icons flow in.
+//
+// Since: 2.6
+type LayoutDirection int
+
+const (
+	// LayoutDirectionAuto selects the direction matching the device locale,
+	// right-to-left for languages such as Arabic and Hebrew.
+	//
+	// Since: 2.6
+	LayoutDirectionAuto LayoutDirection = iota
+	// LayoutDirectionLeftToRight lays content out left to right regardless of locale.
+	//
+	// Since: 2.6
+	LayoutDirectionLeftToRight
+	// LayoutDirectionRightToLeft lays content out right to left regardless of locale.
+	//
+	// Since: 2.6
+	LayoutDirectionRightToLeft
+)
+
+var layoutDirection = LayoutDirectionAuto
+
+// CurrentLayoutDirection returns the direction the user interface should flow in,
+// resolving LayoutDirectionAuto against the device locale.
+//
+// Since: 2.6
+func CurrentLayoutDirection() LayoutDirection {
+	if layoutDirection != LayoutDirectionAuto {
+		return layoutDirection
+	}
+
+	// no locale to look up before an app and driver have started
+	if current := app.Load(); current == nil || (*current).Driver() == nil {
+		return LayoutDirectionLeftToRight
+	}
+
+	if CurrentDevice().Locale().IsRightToLeft() {
+		return LayoutDirectionRightToLeft
+	}
+	return LayoutDirectionLeftToRight
+}
+
+// SetLayoutDirection overrides the locale based direction of the user interface.
+// Pass LayoutDirectionAuto to return to matching the device locale.
+// Content already on screen will use the new direction after its next refresh.
+//
+// Since: 2.6
+func SetLayoutDirection(dir LayoutDirection) {
+	layoutDirection = dir
+}
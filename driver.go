@@ -15,6 +15,13 @@ type Driver interface {
 	// If the source is specified it will be used, otherwise the current theme will be asked for the font.
 	RenderedTextSize(text string, fontSize float32, style TextStyle, source Resource) (size Size, baseline float32)
 
+	// RenderedTextMetrics returns the vertical font metrics (ascent, descent and line gap)
+	// and the advance of each glyph cluster for the given string.
+	// If the source is specified it will be used, otherwise the current theme will be asked for the font.
+	//
+	// Since: 2.6
+	RenderedTextMetrics(text string, fontSize float32, style TextStyle, source Resource) TextMetrics
+
 	// CanvasForObject returns the canvas that is associated with a given CanvasObject.
 	CanvasForObject(CanvasObject) Canvas
 	// AbsolutePositionForObject returns the position of a given CanvasObject relative to the top/left of a canvas.
@@ -0,0 +1,13 @@
+package mobile
+
+// BackHandler is implemented by canvas content that wants to consume the
+// hardware or software back button on mobile devices.
+// When the back key is pressed, and no onTypedKey handler is set on the
+// canvas, the driver offers the event to visible content implementing this
+// interface before asking the OS to leave the app.
+// Return true to consume the event.
+//
+// Since: 2.6
+type BackHandler interface {
+	HandleBack() bool
+}
@@ -16,6 +16,25 @@ func RenderCanvas(c fyne.Canvas, t fyne.Theme) image.Image {
 	return c.Capture()
 }
 
+// RenderSized takes a canvas object and renders it to a regular Go image at the requested
+// size and scale, using the provided Theme. This can be used outside of tests to produce
+// thumbnails, drag images or content for print and export pipelines.
+// Use the theme.LightTheme() or theme.DarkTheme() to access the builtin themes.
+//
+// Since: 2.6
+func RenderSized(obj fyne.CanvasObject, t fyne.Theme, size fyne.Size, scale float32) image.Image {
+	fyne.CurrentApp().Settings().SetTheme(t)
+
+	c := NewCanvas()
+	c.SetPadded(false)
+	c.SetScale(scale)
+	c.SetContent(obj)
+	c.Resize(size)
+
+	app.ApplyThemeTo(obj, c)
+	return c.Capture()
+}
+
 // Render takes a canvas object and renders it to a regular Go image using the provided Theme.
 // The returned image will be set to the object's minimum size.
 // Use the theme.LightTheme() or theme.DarkTheme() to access the builtin themes.
@@ -4,6 +4,8 @@ import (
 	"image/color"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
@@ -39,6 +41,16 @@ func TestRender_Focus(t *testing.T) {
 	test.AssertImageMatches(t, "entry_focus.png", Render(obj, test.Theme()))
 }
 
+func TestRenderSized(t *testing.T) {
+	obj := widget.NewLabel("Hi")
+
+	img := RenderSized(obj, test.Theme(), fyne.NewSize(60, 30), 2)
+	assert.Equal(t, 120, img.Bounds().Dx())
+	assert.Equal(t, 60, img.Bounds().Dy())
+	test.AssertImageMatches(t, "label_sized.png", img)
+	painter.ClearFontCache() // avoid side effects of the cause of #4937
+}
+
 func TestRenderCanvas(t *testing.T) {
 	obj := container.NewAppTabs(
 		container.NewTabItem("Tab 1", container.NewVBox(
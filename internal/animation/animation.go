@@ -18,7 +18,11 @@ type anim struct {
 }
 
 func newAnim(a *fyne.Animation) *anim {
-	animate := &anim{a: a, start: time.Now(), end: time.Now().Add(a.Duration)}
+	duration := a.Duration
+	if app := fyne.CurrentApp(); app != nil { // animations can run before an app starts, in tests
+		duration = time.Duration(float64(duration) * float64(app.Settings().AnimationScale()))
+	}
+	animate := &anim{a: a, start: time.Now(), end: time.Now().Add(duration)}
 	animate.total = animate.end.Sub(animate.start).Milliseconds()
 	animate.repeatsLeft = a.RepeatCount
 	return animate
@@ -13,10 +13,11 @@ var _ fyne.Lifecycle = (*Lifecycle)(nil)
 //
 // Since: 2.1
 type Lifecycle struct {
-	onForeground atomic.Pointer[func()]
-	onBackground atomic.Pointer[func()]
-	onStarted    atomic.Pointer[func()]
-	onStopped    atomic.Pointer[func()]
+	onForeground    atomic.Pointer[func()]
+	onBackground    atomic.Pointer[func()]
+	onStarted       atomic.Pointer[func()]
+	onStopped       atomic.Pointer[func()]
+	onMemoryWarning atomic.Pointer[func(fyne.MemoryWarningLevel)]
 
 	onStoppedHookExecuted func()
 
@@ -51,6 +52,32 @@ func (l *Lifecycle) SetOnStopped(f func()) {
 	l.onStopped.Store(&f)
 }
 
+// SetOnMemoryWarning hooks into the system reporting that memory is running low.
+func (l *Lifecycle) SetOnMemoryWarning(f func(level fyne.MemoryWarningLevel)) {
+	l.onMemoryWarning.Store(&f)
+}
+
+// OnMemoryWarning returns the memory warning hook, if one is registered.
+func (l *Lifecycle) OnMemoryWarning() func(level fyne.MemoryWarningLevel) {
+	f := l.onMemoryWarning.Load()
+	if f == nil {
+		return nil
+	}
+
+	return *f
+}
+
+// TriggerMemoryWarning shrinks the internal caches and notifies any registered hook
+// that the system is running low on memory. Drivers call this in response to an OS
+// warning on mobile, or a heuristic on desktop.
+func (l *Lifecycle) TriggerMemoryWarning(level fyne.MemoryWarningLevel) {
+	fyne.FreeCaches()
+
+	if f := l.OnMemoryWarning(); f != nil {
+		f(level)
+	}
+}
+
 // OnEnteredForeground returns the focus gained hook, if one is registered.
 func (l *Lifecycle) OnEnteredForeground() func() {
 	f := l.onForeground.Load()
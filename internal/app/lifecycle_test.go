@@ -3,6 +3,8 @@ package app
 import (
 	"testing"
 
+	"fyne.io/fyne/v2"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -56,3 +58,15 @@ func TestLifecycle(t *testing.T) {
 	life.DestroyEventQueue()
 	assert.True(t, called)
 }
+
+func TestLifecycle_MemoryWarning(t *testing.T) {
+	life := &Lifecycle{}
+
+	assert.Nil(t, life.OnMemoryWarning())
+	life.TriggerMemoryWarning(fyne.MemoryWarningLow) // no hook should not panic
+
+	level := fyne.MemoryWarningLevel(-1)
+	life.SetOnMemoryWarning(func(l fyne.MemoryWarningLevel) { level = l })
+	life.TriggerMemoryWarning(fyne.MemoryWarningCritical)
+	assert.Equal(t, fyne.MemoryWarningCritical, level)
+}
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"math"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+const memoryWatchInterval = 30 * time.Second
+
+// WatchMemory starts a background task polling heap usage as a low memory heuristic for
+// platforms where the OS does not send explicit warnings. A warning is triggered as
+// usage approaches the soft memory limit, so it is only active when one was configured
+// through debug.SetMemoryLimit or the GOMEMLIMIT environment variable.
+// The returned function stops the watch.
+func (l *Lifecycle) WatchMemory() (stop func()) {
+	limit := uint64(debug.SetMemoryLimit(-1))
+	if limit == math.MaxInt64 {
+		return func() {} // no limit configured, nothing to watch
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(memoryWatchInterval)
+		defer ticker.Stop()
+
+		warned := false
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				stats := runtime.MemStats{}
+				runtime.ReadMemStats(&stats)
+
+				switch used := stats.HeapAlloc; {
+				case used > limit/20*19:
+					l.TriggerMemoryWarning(fyne.MemoryWarningCritical)
+					warned = true
+				case used > limit/5*4:
+					if !warned {
+						l.TriggerMemoryWarning(fyne.MemoryWarningLow)
+						warned = true
+					}
+				case used < limit/10*7:
+					warned = false
+				}
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
@@ -1,26 +1,42 @@
 package app
 
 import (
+	"image/color"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/internal/cache"
+	col "fyne.io/fyne/v2/internal/color"
+	internaltheme "fyne.io/fyne/v2/internal/theme"
+)
+
+var (
+	previousTheme   fyne.Theme
+	previousVariant fyne.ThemeVariant
 )
 
 // ApplyThemeTo ensures that the specified canvasobject and all widgets and themeable objects will
 // be updated for the current theme.
 func ApplyThemeTo(content fyne.CanvasObject, canv fyne.Canvas) {
+	applyThemeTo(content, canv, nil, nil)
+}
+
+func applyThemeTo(content fyne.CanvasObject, canv fyne.Canvas, old, current fyne.Theme) {
 	if content == nil {
 		return
 	}
 
+	if aware, ok := content.(fyne.ThemeAware); ok && old != nil {
+		aware.ThemeChanged(old, current)
+	}
 	switch o := content.(type) {
 	case fyne.Widget:
 		for _, co := range cache.Renderer(o).Objects() {
-			ApplyThemeTo(co, canv)
+			applyThemeTo(co, canv, old, current)
 		}
 		cache.Renderer(o).Layout(content.Size()) // theme can cause sizing changes
 	case *fyne.Container:
 		for _, co := range o.Objects {
-			ApplyThemeTo(co, canv)
+			applyThemeTo(co, canv, old, current)
 		}
 		if l := o.Layout; l != nil {
 			l.Layout(o.Objects, o.Size()) // theme can cause sizing changes
@@ -39,13 +55,71 @@ func ApplySettings(set fyne.Settings, app fyne.App) {
 // It also checks that scale changes are reflected if required. Also it will call `onEveryWindow` on every window
 // interaction
 func ApplySettingsWithCallback(set fyne.Settings, app fyne.App, onEveryWindow func(w fyne.Window)) {
-	for _, window := range app.Driver().AllWindows() {
-		ApplyThemeTo(window.Content(), window.Canvas())
-		for _, overlay := range window.Canvas().Overlays().List() {
-			ApplyThemeTo(overlay, window.Canvas())
-		}
-		if onEveryWindow != nil {
-			onEveryWindow(window)
+	current, variant := set.Theme(), set.ThemeVariant()
+	old := previousTheme
+	if old == current && variant == previousVariant {
+		old = nil // unchanged, no need to notify fyne.ThemeAware objects
+	}
+	previousTheme, previousVariant = current, variant
+
+	apply := func(th, old fyne.Theme) {
+		for _, window := range app.Driver().AllWindows() {
+			applyThemeTo(window.Content(), window.Canvas(), old, th)
+			for _, overlay := range window.Canvas().Overlays().List() {
+				applyThemeTo(overlay, window.Canvas(), old, th)
+			}
+			if onEveryWindow != nil {
+				onEveryWindow(window)
+			}
 		}
 	}
+
+	if d := internaltheme.TransitionDuration(); d > 0 && old != nil && set.ShowAnimations() {
+		trans := &transitionTheme{from: old, to: current}
+		fyne.NewAnimation(d, func(f float32) {
+			if f >= 1 { // settle on the real theme so later lookups match it exactly
+				apply(current, old)
+				return
+			}
+
+			trans.progress = f
+			apply(trans, nil)
+		}).Start()
+		return
+	}
+
+	apply(current, old)
+}
+
+// transitionTheme blends the colors of two themes so a theme change can be animated.
+// Fonts, icons and sizes come from the target theme for the whole transition.
+type transitionTheme struct {
+	from, to fyne.Theme
+	progress float32
+}
+
+func (t *transitionTheme) Color(n fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	return blendColor(t.from.Color(n, v), t.to.Color(n, v), t.progress)
+}
+
+func (t *transitionTheme) Font(style fyne.TextStyle) fyne.Resource {
+	return t.to.Font(style)
+}
+
+func (t *transitionTheme) Icon(n fyne.ThemeIconName) fyne.Resource {
+	return t.to.Icon(n)
+}
+
+func (t *transitionTheme) Size(s fyne.ThemeSizeName) float32 {
+	return t.to.Size(s)
+}
+
+func blendColor(from, to color.Color, progress float32) color.Color {
+	r1, g1, b1, a1 := col.ToNRGBA(from)
+	r2, g2, b2, a2 := col.ToNRGBA(to)
+
+	mix := func(a, b int) uint8 {
+		return uint8(float32(a) + (float32(b)-float32(a))*progress)
+	}
+	return color.NRGBA{R: mix(r1, r2), G: mix(g1, g2), B: mix(b1, b2), A: mix(a1, a2)}
 }
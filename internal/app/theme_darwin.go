@@ -4,14 +4,18 @@ package app
 
 /*
 #cgo CFLAGS: -x objective-c
-#cgo LDFLAGS: -framework Foundation
+#cgo LDFLAGS: -framework Foundation -framework AppKit
 
 #include <AppKit/AppKit.h>
 
 bool isDarkMode();
+bool isReduceMotion();
+void systemAccentRGBA(unsigned int *r, unsigned int *g, unsigned int *b, unsigned int *a);
 */
 import "C"
 import (
+	"image/color"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/theme"
 )
@@ -25,3 +29,19 @@ func DefaultVariant() fyne.ThemeVariant {
 	}
 	return theme.VariantLight
 }
+
+// SystemAccentColor returns the macOS control accent color and whether one
+// was found.
+func SystemAccentColor() (color.Color, bool) {
+	var r, g, b, a C.uint
+	C.systemAccentRGBA(&r, &g, &b, &a)
+	if a == 0 { // not available before macOS 10.14
+		return nil, false
+	}
+	return color.NRGBA{R: uint8(r), G: uint8(g), B: uint8(b), A: uint8(a)}, true
+}
+
+// ReducedMotion returns whether the macOS "Reduce motion" accessibility setting is on.
+func ReducedMotion() bool {
+	return bool(C.isReduceMotion())
+}
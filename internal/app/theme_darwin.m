@@ -1,8 +1,32 @@
 //go:build !ios && !wasm && !test_web_driver && !mobile
 
+#import <AppKit/AppKit.h>
 #import <Foundation/Foundation.h>
 
 bool isDarkMode() {
     NSString *style = [[NSUserDefaults standardUserDefaults] stringForKey:@"AppleInterfaceStyle"];
     return [@"Dark" isEqualToString:style];
 }
+
+void systemAccentRGBA(unsigned int *r, unsigned int *g, unsigned int *b, unsigned int *a) {
+    *r = *g = *b = *a = 0;
+    if (@available(macOS 10.14, *)) {
+        NSColor *accent = [[NSColor controlAccentColor] colorUsingColorSpace:[NSColorSpace sRGBColorSpace]];
+        if (accent == nil) {
+            return;
+        }
+        CGFloat red, green, blue, alpha;
+        [accent getRed:&red green:&green blue:&blue alpha:&alpha];
+        *r = (unsigned int)(red * 255);
+        *g = (unsigned int)(green * 255);
+        *b = (unsigned int)(blue * 255);
+        *a = (unsigned int)(alpha * 255);
+    }
+}
+
+bool isReduceMotion() {
+    if (@available(macOS 10.12, *)) {
+        return [[NSWorkspace sharedWorkspace] accessibilityDisplayShouldReduceMotion];
+    }
+    return false;
+}
@@ -3,6 +3,8 @@
 package app
 
 import (
+	"image/color"
+
 	"fyne.io/fyne/v2"
 )
 
@@ -16,3 +18,15 @@ var SystemTheme fyne.ThemeVariant
 func DefaultVariant() fyne.ThemeVariant {
 	return SystemTheme
 }
+
+// SystemAccentColor returns the OS accent color, which is not currently
+// detected on this platform.
+func SystemAccentColor() (color.Color, bool) {
+	return nil, false
+}
+
+// ReducedMotion returns whether the OS asks for minimal animation, which is not
+// currently detected on this platform.
+func ReducedMotion() bool {
+	return false
+}
@@ -2,9 +2,15 @@ package app_test
 
 import (
 	"testing"
+	"time"
 
+	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/internal/app"
+	internaltheme "fyne.io/fyne/v2/internal/theme"
 	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestApplySettings_BeforeContentSet(t *testing.T) {
@@ -14,3 +20,86 @@ func TestApplySettings_BeforeContentSet(t *testing.T) {
 
 	app.ApplySettings(a.Settings(), a)
 }
+
+func TestApplySettings_ThemeAware(t *testing.T) {
+	a := test.NewApp()
+	w := a.NewWindow("ThemeAware")
+	defer w.Close()
+
+	aware := &themeAwareWidget{}
+	aware.ExtendBaseWidget(aware)
+	w.SetContent(aware)
+
+	first, second := test.Theme(), test.NewTheme()
+	set := &fakeSettings{theme: first}
+	app.ApplySettings(set, a) // prime the known theme
+	aware.changes = 0
+
+	set.theme = second
+	app.ApplySettings(set, a)
+	assert.Equal(t, 1, aware.changes)
+	assert.Equal(t, first, aware.old)
+	assert.Equal(t, second, aware.current)
+
+	app.ApplySettings(set, a) // no change, no notification
+	assert.Equal(t, 1, aware.changes)
+}
+
+func TestApplySettings_Transition(t *testing.T) {
+	a := test.NewApp()
+	w := a.NewWindow("Transition")
+	defer w.Close()
+
+	aware := &themeAwareWidget{}
+	aware.ExtendBaseWidget(aware)
+	w.SetContent(aware)
+
+	internaltheme.SetTransitionDuration(100 * time.Millisecond)
+	defer internaltheme.SetTransitionDuration(0)
+
+	first, second := test.Theme(), test.NewTheme()
+	set := &fakeSettings{theme: first, animations: true}
+	app.ApplySettings(set, a) // prime the known theme
+	aware.changes = 0
+
+	set.theme = second
+	app.ApplySettings(set, a) // the test driver completes animations immediately
+	assert.Equal(t, 1, aware.changes)
+	assert.Equal(t, first, aware.old)
+	assert.Equal(t, second, aware.current)
+}
+
+var _ fyne.ThemeAware = (*themeAwareWidget)(nil)
+
+type themeAwareWidget struct {
+	widget.BaseWidget
+
+	changes      int
+	old, current fyne.Theme
+}
+
+func (w *themeAwareWidget) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(widget.NewLabel("aware"))
+}
+
+func (w *themeAwareWidget) ThemeChanged(old, new fyne.Theme) {
+	w.changes++
+	w.old, w.current = old, new
+}
+
+type fakeSettings struct {
+	theme      fyne.Theme
+	variant    fyne.ThemeVariant
+	animations bool
+}
+
+func (s *fakeSettings) Theme() fyne.Theme                    { return s.theme }
+func (s *fakeSettings) SetTheme(t fyne.Theme)                { s.theme = t }
+func (s *fakeSettings) ThemeVariant() fyne.ThemeVariant      { return s.variant }
+func (s *fakeSettings) Scale() float32                       { return 1 }
+func (s *fakeSettings) PrimaryColor() string                 { return "" }
+func (s *fakeSettings) AddChangeListener(chan fyne.Settings) {}
+func (s *fakeSettings) BuildType() fyne.BuildType            { return fyne.BuildStandard }
+func (s *fakeSettings) ShowAnimations() bool                 { return s.animations }
+func (s *fakeSettings) ReducedMotion() bool                  { return !s.animations }
+func (s *fakeSettings) AnimationScale() float32              { return 1 }
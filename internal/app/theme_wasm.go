@@ -3,6 +3,7 @@
 package app
 
 import (
+	"image/color"
 	"syscall/js"
 
 	"fyne.io/fyne/v2"
@@ -22,3 +23,15 @@ func DefaultVariant() fyne.ThemeVariant {
 	}
 	return theme.VariantDark
 }
+
+// SystemAccentColor returns the OS accent color, which is not currently
+// detected on this platform.
+func SystemAccentColor() (color.Color, bool) {
+	return nil, false
+}
+
+// ReducedMotion returns whether the browser reports a reduced motion preference.
+func ReducedMotion() bool {
+	matches := js.Global().Call("matchMedia", "(prefers-reduced-motion: reduce)")
+	return matches.Truthy() && matches.Get("matches").Bool()
+}
@@ -3,6 +3,8 @@
 package app
 
 import (
+	"image/color"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/theme"
 )
@@ -13,3 +15,15 @@ import (
 func DefaultVariant() fyne.ThemeVariant {
 	return theme.VariantDark
 }
+
+// SystemAccentColor returns the OS accent color, which is not currently
+// detected on this platform.
+func SystemAccentColor() (color.Color, bool) {
+	return nil, false
+}
+
+// ReducedMotion returns whether the OS asks for minimal animation, which is not
+// currently detected on this platform.
+func ReducedMotion() bool {
+	return false
+}
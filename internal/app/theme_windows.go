@@ -3,21 +3,39 @@
 package app
 
 import (
+	"context"
+	"image/color"
+	"strconv"
 	"syscall"
+	"time"
 
+	"golang.org/x/sys/windows"
 	"golang.org/x/sys/windows/registry"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/theme"
 )
 
-const themeRegKey = `SOFTWARE\Microsoft\Windows\CurrentVersion\Themes\Personalize`
+const (
+	themeRegKey        = `SOFTWARE\Microsoft\Windows\CurrentVersion\Themes\Personalize`
+	highContrastRegKey = `Control Panel\Accessibility\HighContrast`
+	dwmRegKey          = `SOFTWARE\Microsoft\Windows\DWM`
+
+	highContrastOnFlag = 0x1 // HCF_HIGHCONTRASTON from the ease-of-access HIGHCONTRAST flags
+)
 
 // DefaultVariant returns the systems default fyne.ThemeVariant.
 // Normally, you should not need this. It is extracted out of the root app package to give the
 // settings app access to it.
 func DefaultVariant() fyne.ThemeVariant {
-	if isDark() {
+	dark := isDark()
+	if isHighContrast() {
+		if dark {
+			return theme.VariantHighContrastDark
+		}
+		return theme.VariantHighContrastLight
+	}
+	if dark {
 		return theme.VariantDark
 	}
 	return theme.VariantLight
@@ -38,26 +56,161 @@ func isDark() bool {
 	return useLight == 0
 }
 
-// WatchTheme calls the supplied function when the Windows dark/light theme changes.
-func WatchTheme(onChanged func()) {
-	// implementation based on an MIT-licensed Github Gist by Jeremy Black (c) 2022
-	// https://gist.github.com/jerblack/1d05bbcebb50ad55c312e4d7cf1bc909
-	var regNotifyChangeKeyValue *syscall.Proc
-	if advapi32, err := syscall.LoadDLL("Advapi32.dll"); err == nil {
-		if p, err := advapi32.FindProc("RegNotifyChangeKeyValue"); err == nil {
-			regNotifyChangeKeyValue = p
+// SystemAccentColor returns the Windows colorization (accent) color and
+// whether one was found.
+func SystemAccentColor() (color.Color, bool) {
+	k, err := registry.OpenKey(registry.CURRENT_USER, dwmRegKey, registry.QUERY_VALUE)
+	if err != nil {
+		return nil, false
+	}
+	defer k.Close()
+
+	value, _, err := k.GetIntegerValue("ColorizationColor") // stored as 0xAARRGGBB
+	if err != nil {
+		return nil, false
+	}
+
+	return color.NRGBA{R: uint8(value >> 16), G: uint8(value >> 8), B: uint8(value), A: 0xff}, true
+}
+
+// ReducedMotion returns whether the Windows "Show animations" accessibility
+// setting has been turned off.
+func ReducedMotion() bool {
+	k, err := registry.OpenKey(registry.CURRENT_USER, `Control Panel\Desktop\WindowMetrics`, registry.QUERY_VALUE)
+	if err != nil {
+		return false
+	}
+	defer k.Close()
+
+	animate, _, err := k.GetStringValue("MinAnimate") // stored as a string, "0" when animations are off
+	return err == nil && animate == "0"
+}
+
+func isHighContrast() bool {
+	k, err := registry.OpenKey(registry.CURRENT_USER, highContrastRegKey, registry.QUERY_VALUE)
+	if err != nil {
+		return false
+	}
+	defer k.Close()
+
+	flags, _, err := k.GetStringValue("Flags") // stored as a string of the HIGHCONTRAST flags
+	if err != nil {
+		return false
+	}
+
+	value, err := strconv.Atoi(flags)
+	return err == nil && value&highContrastOnFlag != 0
+}
+
+// ThemeSetting identifies which Windows appearance setting changed.
+type ThemeSetting int
+
+const (
+	// SettingAppsTheme is the light/dark preference for applications.
+	SettingAppsTheme ThemeSetting = iota
+	// SettingSystemTheme is the light/dark preference for the system shell.
+	SettingSystemTheme
+	// SettingAccentColor is the DWM colorization (accent) color.
+	SettingAccentColor
+	// SettingTransparency is the transparency effects toggle.
+	SettingTransparency
+)
+
+// watchDebounce is how long registry watches wait after a notification so that a
+// burst of writes results in a single callback.
+const watchDebounce = 100 * time.Millisecond
+
+// WatchTheme watches the Windows personalization and DWM registry keys, calling the
+// supplied function with each setting that changed. The watches run in background
+// goroutines and stop when the passed context is cancelled.
+func WatchTheme(ctx context.Context, onChanged func(ThemeSetting)) {
+	go watchRegistryKey(ctx, themeRegKey, personalizeNotifier(onChanged))
+	go watchRegistryKey(ctx, dwmRegKey, accentNotifier(onChanged))
+}
+
+// personalizeNotifier reports which value under the Personalize key changed, comparing
+// against the values seen last time.
+func personalizeNotifier(onChanged func(ThemeSetting)) func() {
+	appsLight, systemLight, transparency := readPersonalize()
+
+	return func() {
+		apps, system, trans := readPersonalize()
+		if apps != appsLight {
+			appsLight = apps
+			onChanged(SettingAppsTheme)
+		}
+		if system != systemLight {
+			systemLight = system
+			onChanged(SettingSystemTheme)
+		}
+		if trans != transparency {
+			transparency = trans
+			onChanged(SettingTransparency)
 		}
 	}
-	if regNotifyChangeKeyValue == nil {
+}
+
+func readPersonalize() (appsLight, systemLight, transparency uint64) {
+	k, err := registry.OpenKey(registry.CURRENT_USER, themeRegKey, registry.QUERY_VALUE)
+	if err != nil {
 		return
 	}
-	k, err := registry.OpenKey(registry.CURRENT_USER, themeRegKey, syscall.KEY_NOTIFY|registry.QUERY_VALUE)
+	defer k.Close()
+
+	appsLight, _, _ = k.GetIntegerValue("AppsUseLightTheme")
+	systemLight, _, _ = k.GetIntegerValue("SystemUsesLightTheme")
+	transparency, _, _ = k.GetIntegerValue("EnableTransparency")
+	return
+}
+
+// accentNotifier reports changes to the DWM colorization color.
+func accentNotifier(onChanged func(ThemeSetting)) func() {
+	last, _ := SystemAccentColor()
+
+	return func() {
+		current, _ := SystemAccentColor()
+		if current != last {
+			last = current
+			onChanged(SettingAccentColor)
+		}
+	}
+}
+
+// watchRegistryKey calls notify, debounced, every time a value under the given
+// registry key changes, until the context is cancelled.
+func watchRegistryKey(ctx context.Context, path string, notify func()) {
+	k, err := registry.OpenKey(registry.CURRENT_USER, path, syscall.KEY_NOTIFY|registry.QUERY_VALUE)
 	if err != nil {
 		return // on older versions of windows the key may not exist
 	}
+	defer k.Close()
+
+	event, err := windows.CreateEvent(nil, 0, 0, nil)
+	if err != nil {
+		return
+	}
+	defer windows.CloseHandle(event)
+
 	for {
-		// blocks until the reigstry key has been changed
-		regNotifyChangeKeyValue.Call(uintptr(k), 0, 0x00000001|0x00000004, 0, 0)
-		onChanged()
+		err = windows.RegNotifyChangeKeyValue(windows.Handle(k), false,
+			windows.REG_NOTIFY_CHANGE_NAME|windows.REG_NOTIFY_CHANGE_LAST_SET, event, true)
+		if err != nil {
+			return
+		}
+
+		signalled := false
+		for !signalled {
+			if ctx.Err() != nil {
+				return
+			}
+			state, err := windows.WaitForSingleObject(event, 500)
+			if err != nil {
+				return
+			}
+			signalled = state == windows.WAIT_OBJECT_0
+		}
+
+		time.Sleep(watchDebounce) // batch bursts of registry writes into one callback
+		notify()
 	}
 }
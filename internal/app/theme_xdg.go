@@ -3,9 +3,16 @@
 package app
 
 import (
+	"image/color"
+	"os/exec"
+	"strings"
 	"sync/atomic"
 
+	portalSettings "github.com/rymdport/portal/settings"
+	"github.com/rymdport/portal/settings/appearance"
+
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
 )
 
 // CurrentVariant contains the system’s theme variant.
@@ -18,3 +25,73 @@ var CurrentVariant atomic.Uint64
 func DefaultVariant() fyne.ThemeVariant {
 	return fyne.ThemeVariant(CurrentVariant.Load())
 }
+
+// SystemAccentColor returns the accent color reported by the desktop portal
+// and whether one was found.
+func SystemAccentColor() (color.Color, bool) {
+	accent, err := appearance.GetAccentColor()
+	if err != nil || accent == nil {
+		return nil, false
+	}
+	return accent, true
+}
+
+// fetch color variant from dbus portal desktop settings.
+func findFreedesktopColorScheme() fyne.ThemeVariant {
+	colorScheme, err := appearance.GetColorScheme()
+	if err != nil {
+		return gsettingsVariant()
+	}
+
+	return colorSchemeToThemeVariant(colorScheme)
+}
+
+func colorSchemeToThemeVariant(colorScheme appearance.ColorScheme) fyne.ThemeVariant {
+	switch colorScheme {
+	case appearance.Light:
+		return theme.VariantLight
+	case appearance.Dark:
+		return theme.VariantDark
+	}
+
+	// Default to light theme to support Gnome's default see https://github.com/fyne-io/fyne/pull/3561
+	return theme.VariantLight
+}
+
+// ReducedMotion returns whether the desktop has asked for animations to be disabled.
+func ReducedMotion() bool {
+	out, err := exec.Command("gsettings", "get", "org.gnome.desktop.interface", "enable-animations").Output()
+	return err == nil && strings.Contains(string(out), "false")
+}
+
+// gsettingsVariant is a fallback lookup for desktops that do not provide the settings portal.
+func gsettingsVariant() fyne.ThemeVariant {
+	out, err := exec.Command("gsettings", "get", "org.gnome.desktop.interface", "color-scheme").Output()
+	if err == nil && strings.Contains(string(out), "prefer-dark") {
+		return theme.VariantDark
+	}
+
+	return theme.VariantLight
+}
+
+// WatchTheme looks up the current desktop color scheme, then calls the supplied function
+// whenever the org.freedesktop.portal.Settings appearance values change.
+func WatchTheme(onChanged func()) {
+	// Theme lookup hangs on some desktops. Update theme variant cache from within goroutine.
+	CurrentVariant.Store(uint64(findFreedesktopColorScheme()))
+	onChanged()
+
+	portalSettings.OnSignalSettingChanged(func(changed portalSettings.Changed) {
+		if changed.Namespace != "org.freedesktop.appearance" {
+			return
+		}
+		switch changed.Key {
+		case "color-scheme":
+			themeVariant := colorSchemeToThemeVariant(appearance.ColorScheme(changed.Value.(uint32)))
+			CurrentVariant.Store(uint64(themeVariant))
+			onChanged()
+		case "accent-color":
+			onChanged() // the accent color is re-read from the portal when settings are applied
+		}
+	})
+}
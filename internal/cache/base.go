@@ -24,6 +24,21 @@ func init() {
 		cacheDuration = t
 		cleanTaskInterval = cacheDuration / 2
 	}
+
+	fyne.SetFreeCachesFunc(FreeMemory)
+}
+
+// FreeMemory drops all cached SVG rasters and font metrics along with any renderers and
+// canvases that have not been accessed recently. It is called when the system runs low
+// on memory, or manually through fyne.FreeCaches.
+func FreeMemory() {
+	now := timeNow()
+	expireAll := now.Add(cacheDuration)
+	destroyExpiredSvgs(expireAll)
+	destroyExpiredFontMetrics(expireAll)
+	destroyExpiredRenderers(now)
+	destroyExpiredCanvases(now)
+	lastClean = now
 }
 
 // Clean run cache clean task, it should be called on paint events.
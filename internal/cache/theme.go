@@ -29,6 +29,32 @@ func OverrideTheme(o fyne.CanvasObject, th fyne.Theme) {
 	overrideTheme(o, s)
 }
 
+// ClearThemeOverride removes any theme override from the given object and any
+// children, so they return to using the application theme.
+//
+// Since: 2.6
+func ClearThemeOverride(o fyne.CanvasObject) {
+	switch c := o.(type) {
+	case fyne.Widget:
+		ResetThemeCaches()
+		overrides.Delete(c)
+
+		r := Renderer(c)
+		if r == nil {
+			return
+		}
+		for _, child := range r.Objects() {
+			ClearThemeOverride(child)
+		}
+	case *fyne.Container:
+		for _, child := range c.Objects {
+			ClearThemeOverride(child)
+		}
+	default:
+		overrides.Delete(c)
+	}
+}
+
 func OverrideThemeMatchingScope(o, parent fyne.CanvasObject) bool {
 	data, ok := overrides.Load(parent)
 	if !ok { // not overridden in parent
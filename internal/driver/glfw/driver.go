@@ -72,6 +72,10 @@ func (d *gLDriver) RenderedTextSize(text string, textSize float32, style fyne.Te
 	return painter.RenderedTextSize(text, textSize, style, source)
 }
 
+func (d *gLDriver) RenderedTextMetrics(text string, textSize float32, style fyne.TextStyle, source fyne.Resource) fyne.TextMetrics {
+	return painter.RenderedTextMetrics(text, textSize, style, source)
+}
+
 func (d *gLDriver) CanvasForObject(obj fyne.CanvasObject) fyne.Canvas {
 	return common.CanvasForObject(obj)
 }
@@ -160,11 +164,14 @@ func (d *gLDriver) Run() {
 		panic("Run() or ShowAndRun() must be called from main goroutine")
 	}
 
+	l := fyne.CurrentApp().Lifecycle().(*intapp.Lifecycle)
+	stopMemoryWatch := l.WatchMemory()
+
 	go d.catchTerm()
 	d.runGL()
+	stopMemoryWatch()
 
 	// Ensure lifecycle events run to completion before the app exits
-	l := fyne.CurrentApp().Lifecycle().(*intapp.Lifecycle)
 	l.WaitForEvents()
 	l.DestroyEventQueue()
 }
@@ -36,8 +36,9 @@ func calculateScale(user, system, detected float32) float32 {
 		system = detected
 	}
 
+	// snap to the nearest 0.05 so fractional per-monitor scales such as 1.25 or 1.5 are kept
 	raw := system * user
-	return float32(math.Round(float64(raw*10.0))) / 10.0
+	return float32(math.Round(float64(raw*20.0))) / 20.0
 }
 
 func userScale() float32 {
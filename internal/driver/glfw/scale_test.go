@@ -45,9 +45,17 @@ func TestCalculateScale(t *testing.T) {
 	assert.Equal(t, float32(3.0), large)
 }
 
+func TestCalculateScale_Fractional(t *testing.T) {
+	quarter := calculateScale(1.25, 1.0, 1.0)
+	assert.Equal(t, float32(1.25), quarter)
+
+	monitor := calculateScale(1.0, scaleAuto, 1.25)
+	assert.Equal(t, float32(1.25), monitor)
+}
+
 func TestCalculateScale_Round(t *testing.T) {
-	trunc := calculateScale(1.04321, 1.0, 1.0)
-	assert.Equal(t, float32(1.0), trunc)
+	snapped := calculateScale(1.04321, 1.0, 1.0)
+	assert.Equal(t, float32(1.05), snapped)
 
 	round := calculateScale(1.1, 1.1, 1.0)
 	assert.Equal(t, float32(1.2), round)
@@ -333,12 +333,16 @@ func (w *window) resized(_ *glfw.Window, width, height int) {
 }
 
 func (w *window) scaled(_ *glfw.Window, x float32, y float32) {
-	if !build.IsWayland { // other platforms handle this using older APIs
+	if build.IsWayland { // Wayland output scaling is applied at the texture level
+		w.canvas.texScale = x
+		w.canvas.Refresh(w.canvas.content)
 		return
 	}
 
-	w.canvas.texScale = x
-	w.canvas.Refresh(w.canvas.content)
+	// the content scale changes when the window moves to a monitor with a different
+	// DPI setting, so recalculate the per-monitor scale live
+	w.canvas.detectedScale = w.detectScale()
+	go w.canvas.reloadScale()
 }
 
 func (w *window) frameSized(_ *glfw.Window, width, height int) {
@@ -0,0 +1,6 @@
+package app
+
+// OnLowMemory is called when the OS reports that memory is running low, before this
+// package frees what it can. It is set by the mobile driver to propagate the warning
+// to the running app.
+var OnLowMemory func()
@@ -10,6 +10,9 @@ import (
 
 // clean caches - called when the OS wants some memory back
 func cleanCaches() {
+	if f := OnLowMemory; f != nil {
+		f()
+	}
 	runtime.GC()
 	debug.FreeOSMemory()
 }
@@ -104,6 +104,10 @@ func (d *driver) RenderedTextSize(text string, textSize float32, style fyne.Text
 	return painter.RenderedTextSize(text, textSize, style, source)
 }
 
+func (d *driver) RenderedTextMetrics(text string, textSize float32, style fyne.TextStyle, source fyne.Resource) fyne.TextMetrics {
+	return painter.RenderedTextMetrics(text, textSize, style, source)
+}
+
 func (d *driver) CanvasForObject(obj fyne.CanvasObject) fyne.Canvas {
 	if len(d.windows) == 0 {
 		return nil
@@ -138,6 +142,10 @@ func (d *driver) Run() {
 		return // Run was called twice.
 	}
 
+	app.OnLowMemory = func() {
+		fyne.CurrentApp().Lifecycle().(*intapp.Lifecycle).TriggerMemoryWarning(fyne.MemoryWarningCritical)
+	}
+
 	app.Main(func(a app.App) {
 		d.app = a
 		settingsChange := make(chan fyne.Settings)
@@ -546,7 +554,9 @@ func (d *driver) typeDownCanvas(canvas *canvas, r rune, code key.Code, mod key.M
 			if canvas.onTypedKey != nil {
 				canvas.onTypedKey(keyEvent)
 			} else if keyName == mobile.KeyBack {
-				d.GoBack()
+				if !d.handleBack(canvas) {
+					d.GoBack()
+				}
 			}
 		}
 		if r > 0 && canvas.onTypedRune != nil {
@@ -555,6 +565,28 @@ func (d *driver) typeDownCanvas(canvas *canvas, r rune, code key.Code, mod key.M
 	}
 }
 
+// handleBack offers the back key to visible content implementing
+// mobile.BackHandler, topmost overlay first, before leaving the app.
+func (d *driver) handleBack(c *canvas) bool {
+	root := c.Content()
+	if top := c.Overlays().Top(); top != nil {
+		root = top
+	}
+	if root == nil {
+		return false
+	}
+
+	handled := false
+	intdriver.WalkVisibleObjectTree(root, func(obj fyne.CanvasObject, _, _ fyne.Position, _ fyne.Size) bool {
+		if h, ok := obj.(mobile.BackHandler); ok && h.HandleBack() {
+			handled = true
+			return true
+		}
+		return false
+	}, nil)
+	return handled
+}
+
 func (d *driver) typeUpCanvas(_ *canvas, _ rune, _ key.Code, _ key.Modifiers) {
 }
 
@@ -51,12 +51,13 @@ const (
 	RED          = 0x1903
 	RGBA         = 0x1908
 
-	Nearest          = 0x2600
-	Linear           = 0x2601
-	TextureMagFilter = 0x2800
-	TextureMinFilter = 0x2801
-	TextureWrapS     = 0x2802
-	TextureWrapT     = 0x2803
+	Nearest            = 0x2600
+	Linear             = 0x2601
+	LinearMipmapLinear = 0x2703
+	TextureMagFilter   = 0x2800
+	TextureMinFilter   = 0x2801
+	TextureWrapS       = 0x2802
+	TextureWrapT       = 0x2803
 
 	ConstantAlpha            = 0x8003
 	OneMinusConstantAlpha    = 0x8004
@@ -4,6 +4,7 @@ import (
 	"math"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/internal/cache"
 )
 
@@ -33,6 +34,32 @@ func FindObjectAtPositionMatching(mouse fyne.Position, matches func(object fyne.
 	var found fyne.CanvasObject
 	var foundPos fyne.Position
 
+	layer := 0
+	if overlay != nil {
+		found, foundPos = searchObjectTree(overlay, mouse, matches)
+	} else {
+		for _, root := range roots {
+			layer++
+			if root == nil {
+				continue
+			}
+			found, foundPos = searchObjectTree(root, mouse, matches)
+			if found != nil {
+				break
+			}
+		}
+	}
+
+	return found, foundPos, layer
+}
+
+// searchObjectTree finds the top-most matching object at mouse in a single tree.
+// When a canvas.Transform is met the position is mapped through the inverse
+// transform and the content searched as a nested tree.
+func searchObjectTree(root fyne.CanvasObject, mouse fyne.Position, matches func(object fyne.CanvasObject) bool) (fyne.CanvasObject, fyne.Position) {
+	var found fyne.CanvasObject
+	var foundPos fyne.Position
+
 	findFunc := func(walked fyne.CanvasObject, pos fyne.Position, clipPos fyne.Position, clipSize fyne.Size) bool {
 		if !walked.Visible() {
 			return false
@@ -54,6 +81,28 @@ func FindObjectAtPositionMatching(mouse fyne.Position, matches func(object fyne.
 			return false
 		}
 
+		switch wrapper := walked.(type) {
+		case *canvas.Transform:
+			if wrapper.Content == nil {
+				return false
+			}
+			local := wrapper.ToContentPosition(mouse.Subtract(pos))
+			if inner, innerPos := searchObjectTree(wrapper.Content, local, matches); inner != nil {
+				found = inner
+				foundPos = innerPos
+			}
+			return false
+		case *canvas.Group:
+			if wrapper.Content == nil {
+				return false
+			}
+			if inner, innerPos := searchObjectTree(wrapper.Content, mouse.Subtract(pos), matches); inner != nil {
+				found = inner
+				foundPos = innerPos
+			}
+			return false
+		}
+
 		if matches(walked) {
 			found = walked
 			foundPos = fyne.NewPos(mouse.X-pos.X, mouse.Y-pos.Y)
@@ -61,23 +110,8 @@ func FindObjectAtPositionMatching(mouse fyne.Position, matches func(object fyne.
 		return false
 	}
 
-	layer := 0
-	if overlay != nil {
-		WalkVisibleObjectTree(overlay, findFunc, nil)
-	} else {
-		for _, root := range roots {
-			layer++
-			if root == nil {
-				continue
-			}
-			WalkVisibleObjectTree(root, findFunc, nil)
-			if found != nil {
-				break
-			}
-		}
-	}
-
-	return found, foundPos, layer
+	WalkVisibleObjectTree(root, findFunc, nil)
+	return found, foundPos
 }
 
 // ReverseWalkVisibleObjectTree will walk an object tree in reverse order for all visible objects
@@ -33,9 +33,10 @@ func DrawCircle(circle *canvas.Circle, vectorPad float32, scale func(float32) fl
 		filler.Draw()
 	}
 
+	dashes, dashOffset := scaleDashes(circle.StrokeDashPattern, circle.StrokeDashOffset, scale)
 	dasher := rasterx.NewDasher(width, height, scanner)
 	dasher.SetColor(circle.StrokeColor)
-	dasher.SetStroke(fixed.Int26_6(float64(stroke)*64), 0, nil, nil, nil, 0, nil, 0)
+	dasher.SetStroke(fixed.Int26_6(float64(stroke)*64), 0, nil, nil, nil, 0, dashes, dashOffset)
 	rasterx.AddCircle(float64(width/2), float64(height/2), float64(scale(radius)), dasher)
 	dasher.Draw()
 
@@ -57,9 +58,10 @@ func DrawLine(line *canvas.Line, vectorPad float32, scale func(float32) float32)
 
 	raw := image.NewRGBA(image.Rect(0, 0, width, height))
 	scanner := rasterx.NewScannerGV(int(size.Width), int(size.Height), raw, raw.Bounds())
+	dashes, dashOffset := scaleDashes(line.StrokeDashPattern, line.StrokeDashOffset, scale)
 	dasher := rasterx.NewDasher(width, height, scanner)
 	dasher.SetColor(col)
-	dasher.SetStroke(fixed.Int26_6(float64(stroke)*64), 0, nil, nil, nil, 0, nil, 0)
+	dasher.SetStroke(fixed.Int26_6(float64(stroke)*64), 0, nil, nil, nil, 0, dashes, dashOffset)
 	positon := line.Position()
 	p1x, p1y := scale(line.Position1.X-positon.X+vectorPad), scale(line.Position1.Y-positon.Y+vectorPad)
 	p2x, p2y := scale(line.Position2.X-positon.X+vectorPad), scale(line.Position2.Y-positon.Y+vectorPad)
@@ -83,6 +85,109 @@ func DrawLine(line *canvas.Line, vectorPad float32, scale func(float32) float32)
 	return raw
 }
 
+// scaleDashes converts a dash pattern and offset from size units to the pixel values
+// that the rasterizer expects. A nil pattern is returned unchanged for a solid stroke.
+func scaleDashes(pattern []float32, offset float32, scale func(float32) float32) ([]float64, float64) {
+	if len(pattern) == 0 {
+		return nil, 0
+	}
+
+	dashes := make([]float64, len(pattern))
+	for i, dash := range pattern {
+		dashes[i] = float64(scale(dash))
+	}
+	return dashes, float64(scale(offset))
+}
+
+// DrawPath rasterizes the given path object into an image, filling then stroking its segments.
+// The bounds of the output image will be increased by vectorPad to allow for stroke overflow at the edges.
+// The scale function is used to understand how many pixels are required per unit of size.
+func DrawPath(path *canvas.Path, vectorPad float32, scale func(float32) float32) *image.RGBA {
+	size := path.Size()
+	width := int(scale(size.Width + vectorPad*2))
+	height := int(scale(size.Height + vectorPad*2))
+	stroke := scale(path.StrokeWidth)
+
+	raw := image.NewRGBA(image.Rect(0, 0, width, height))
+	scanner := rasterx.NewScannerGV(int(size.Width), int(size.Height), raw, raw.Bounds())
+
+	if path.FillColor != nil {
+		filler := rasterx.NewFiller(width, height, scanner)
+		filler.SetColor(path.FillColor)
+		addPathSegments(path, vectorPad, scale, filler)
+		filler.Draw()
+	}
+
+	if path.StrokeColor != nil && path.StrokeWidth > 0 {
+		var capFn rasterx.CapFunc
+		switch path.StrokeCap {
+		case canvas.LineCapRound:
+			capFn = rasterx.RoundCap
+		case canvas.LineCapSquare:
+			capFn = rasterx.SquareCap
+		default:
+			capFn = rasterx.ButtCap
+		}
+
+		join, gap := rasterx.Miter, rasterx.FlatGap
+		switch path.StrokeJoin {
+		case canvas.LineJoinRound:
+			join, gap = rasterx.Round, rasterx.RoundGap
+		case canvas.LineJoinBevel:
+			join = rasterx.Bevel
+		}
+
+		dashes, _ := scaleDashes(path.Dashes, 0, scale)
+
+		dasher := rasterx.NewDasher(width, height, scanner)
+		dasher.SetColor(path.StrokeColor)
+		dasher.SetStroke(fixed.Int26_6(float64(stroke)*64), 4<<6, capFn, capFn, gap, join, dashes, 0)
+		addPathSegments(path, vectorPad, scale, dasher)
+		dasher.Draw()
+	}
+
+	return raw
+}
+
+// addPathSegments walks the segments of a path passing each to the given rasterizer,
+// offset by the vector pad and scaled to pixels.
+func addPathSegments(path *canvas.Path, pad float32, scale func(float32) float32, to rasterx.Adder) {
+	at := func(p fyne.Position) fixed.Point26_6 {
+		return rasterx.ToFixedP(float64(scale(p.X+pad)), float64(scale(p.Y+pad)))
+	}
+
+	started := false
+	for _, seg := range path.Segments() {
+		if !started && seg.Op != canvas.PathMoveTo && seg.Op != canvas.PathClose {
+			to.Start(at(fyne.Position{})) // segments before any move start at the origin
+			started = true
+		}
+
+		switch seg.Op {
+		case canvas.PathMoveTo:
+			if started {
+				to.Stop(false)
+			}
+			to.Start(at(seg.Points[0]))
+			started = true
+		case canvas.PathLineTo:
+			to.Line(at(seg.Points[0]))
+		case canvas.PathQuadTo:
+			to.QuadBezier(at(seg.Points[0]), at(seg.Points[1]))
+		case canvas.PathCubicTo:
+			to.CubeBezier(at(seg.Points[0]), at(seg.Points[1]), at(seg.Points[2]))
+		case canvas.PathClose:
+			if started {
+				to.Stop(true)
+				started = false
+			}
+		}
+	}
+	if started {
+		to.Stop(false)
+	}
+}
+
 // DrawRectangle rasterizes the given rectangle object with stroke border into an image.
 // The bounds of the output image will be increased by vectorPad to allow for stroke overflow at the edges.
 // The scale function is used to understand how many pixels are required per unit of size.
@@ -96,50 +201,117 @@ func DrawRectangle(rect *canvas.Rectangle, vectorPad float32, scale func(float32
 	scanner := rasterx.NewScannerGV(int(size.Width), int(size.Height), raw, raw.Bounds())
 
 	scaledPad := scale(vectorPad)
-	p1x, p1y := scaledPad, scaledPad
-	p2x, p2y := scale(size.Width)+scaledPad, scaledPad
-	p3x, p3y := scale(size.Width)+scaledPad, scale(size.Height)+scaledPad
-	p4x, p4y := scaledPad, scale(rect.Size().Height)+scaledPad
+	x1, y1 := scaledPad, scaledPad
+	x2, y2 := scale(size.Width)+scaledPad, scale(size.Height)+scaledPad
+
+	radius := func(corner float32) float32 {
+		if corner == 0 {
+			corner = rect.CornerRadius
+		}
+		return scale(corner)
+	}
+	tl, tr := radius(rect.CornerRadii.TopLeft), radius(rect.CornerRadii.TopRight)
+	br, bl := radius(rect.CornerRadii.BottomRight), radius(rect.CornerRadii.BottomLeft)
 
 	if rect.FillColor != nil {
 		filler := rasterx.NewFiller(width, height, scanner)
 		filler.SetColor(rect.FillColor)
-		if rect.CornerRadius == 0 {
-			rasterx.AddRect(float64(p1x), float64(p1y), float64(p3x), float64(p3y), 0, filler)
+		if tl == 0 && tr == 0 && br == 0 && bl == 0 {
+			rasterx.AddRect(float64(x1), float64(y1), float64(x2), float64(y2), 0, filler)
+		} else if tl == tr && tr == br && br == bl {
+			rasterx.AddRoundRect(float64(x1), float64(y1), float64(x2), float64(y2), float64(tl), float64(tl), 0, rasterx.RoundGap, filler)
 		} else {
-			r := float64(scale(rect.CornerRadius))
-			rasterx.AddRoundRect(float64(p1x), float64(p1y), float64(p3x), float64(p3y), r, r, 0, rasterx.RoundGap, filler)
+			addRoundedRectOutline(filler, x1, y1, x2, y2, tl, tr, br, bl)
 		}
 		filler.Draw()
 	}
 
 	if rect.StrokeColor != nil && rect.StrokeWidth > 0 {
-		r := scale(rect.CornerRadius)
-		c := quarterCircleControl * r
+		dashes, dashOffset := scaleDashes(rect.StrokeDashPattern, rect.StrokeDashOffset, scale)
 		dasher := rasterx.NewDasher(width, height, scanner)
 		dasher.SetColor(rect.StrokeColor)
-		dasher.SetStroke(fixed.Int26_6(float64(stroke)*64), 0, nil, nil, nil, 0, nil, 0)
-		if c != 0 {
-			dasher.Start(rasterx.ToFixedP(float64(p1x), float64(p1y+r)))
-			dasher.CubeBezier(rasterx.ToFixedP(float64(p1x), float64(p1y+c)), rasterx.ToFixedP(float64(p1x+c), float64(p1y)), rasterx.ToFixedP(float64(p1x+r), float64(p2y)))
+		dasher.SetStroke(fixed.Int26_6(float64(stroke)*64), 0, nil, nil, nil, 0, dashes, dashOffset)
+		sides := rect.StrokeSides
+		if sides == 0 || sides == canvas.RectangleSideAll {
+			addRoundedRectOutline(dasher, x1, y1, x2, y2, tl, tr, br, bl)
 		} else {
-			dasher.Start(rasterx.ToFixedP(float64(p1x), float64(p1y)))
-		}
-		dasher.Line(rasterx.ToFixedP(float64(p2x-r), float64(p2y)))
-		if c != 0 {
-			dasher.CubeBezier(rasterx.ToFixedP(float64(p2x-c), float64(p2y)), rasterx.ToFixedP(float64(p2x), float64(p2y+c)), rasterx.ToFixedP(float64(p2x), float64(p2y+r)))
-		}
-		dasher.Line(rasterx.ToFixedP(float64(p3x), float64(p3y-r)))
-		if c != 0 {
-			dasher.CubeBezier(rasterx.ToFixedP(float64(p3x), float64(p3y-c)), rasterx.ToFixedP(float64(p3x-c), float64(p3y)), rasterx.ToFixedP(float64(p3x-r), float64(p3y)))
-		}
-		dasher.Line(rasterx.ToFixedP(float64(p4x+r), float64(p4y)))
-		if c != 0 {
-			dasher.CubeBezier(rasterx.ToFixedP(float64(p4x+c), float64(p4y)), rasterx.ToFixedP(float64(p4x), float64(p4y-c)), rasterx.ToFixedP(float64(p4x), float64(p4y-r)))
+			addRectangleSides(dasher, sides, x1, y1, x2, y2, tl, tr, br, bl)
 		}
-		dasher.Stop(true)
 		dasher.Draw()
 	}
 
 	return raw
 }
+
+// addRoundedRectOutline adds the closed outline of a rectangle with the given
+// per-corner radii to the rasterizer, running clockwise from the top-left corner.
+func addRoundedRectOutline(to rasterx.Adder, x1, y1, x2, y2, tl, tr, br, bl float32) {
+	ctl, ctr := quarterCircleControl*tl, quarterCircleControl*tr
+	cbr, cbl := quarterCircleControl*br, quarterCircleControl*bl
+
+	to.Start(rasterx.ToFixedP(float64(x1+tl), float64(y1)))
+	to.Line(rasterx.ToFixedP(float64(x2-tr), float64(y1)))
+	if tr > 0 {
+		to.CubeBezier(rasterx.ToFixedP(float64(x2-ctr), float64(y1)), rasterx.ToFixedP(float64(x2), float64(y1+ctr)), rasterx.ToFixedP(float64(x2), float64(y1+tr)))
+	}
+	to.Line(rasterx.ToFixedP(float64(x2), float64(y2-br)))
+	if br > 0 {
+		to.CubeBezier(rasterx.ToFixedP(float64(x2), float64(y2-cbr)), rasterx.ToFixedP(float64(x2-cbr), float64(y2)), rasterx.ToFixedP(float64(x2-br), float64(y2)))
+	}
+	to.Line(rasterx.ToFixedP(float64(x1+bl), float64(y2)))
+	if bl > 0 {
+		to.CubeBezier(rasterx.ToFixedP(float64(x1+cbl), float64(y2)), rasterx.ToFixedP(float64(x1), float64(y2-cbl)), rasterx.ToFixedP(float64(x1), float64(y2-bl)))
+	}
+	to.Line(rasterx.ToFixedP(float64(x1), float64(y1+tl)))
+	if tl > 0 {
+		to.CubeBezier(rasterx.ToFixedP(float64(x1), float64(y1+ctl)), rasterx.ToFixedP(float64(x1+ctl), float64(y1)), rasterx.ToFixedP(float64(x1+tl), float64(y1)))
+	}
+	to.Stop(true)
+}
+
+// addRectangleSides strokes only the selected sides of a rectangle outline.
+// A corner curve is drawn when both of its adjacent sides are selected.
+func addRectangleSides(to rasterx.Adder, sides canvas.RectangleSides, x1, y1, x2, y2, tl, tr, br, bl float32) {
+	ctl, ctr := quarterCircleControl*tl, quarterCircleControl*tr
+	cbr, cbl := quarterCircleControl*br, quarterCircleControl*bl
+
+	type side struct {
+		bit                        canvas.RectangleSides
+		sx, sy, ex, ey             float32 // the straight edge
+		c1x, c1y, c2x, c2y, cx, cy float32 // the trailing corner curve
+		radius                     float32
+	}
+	walk := [4]side{
+		{canvas.RectangleSideTop, x1 + tl, y1, x2 - tr, y1,
+			x2 - ctr, y1, x2, y1 + ctr, x2, y1 + tr, tr},
+		{canvas.RectangleSideRight, x2, y1 + tr, x2, y2 - br,
+			x2, y2 - cbr, x2 - cbr, y2, x2 - br, y2, br},
+		{canvas.RectangleSideBottom, x2 - br, y2, x1 + bl, y2,
+			x1 + cbl, y2, x1, y2 - cbl, x1, y2 - bl, bl},
+		{canvas.RectangleSideLeft, x1, y2 - bl, x1, y1 + tl,
+			x1, y1 + ctl, x1 + ctl, y1, x1 + tl, y1, tl},
+	}
+
+	running := false
+	for i, s := range walk {
+		if sides&s.bit == 0 {
+			if running {
+				to.Stop(false)
+				running = false
+			}
+			continue
+		}
+
+		if !running {
+			to.Start(rasterx.ToFixedP(float64(s.sx), float64(s.sy)))
+			running = true
+		}
+		to.Line(rasterx.ToFixedP(float64(s.ex), float64(s.ey)))
+		if sides&walk[(i+1)%4].bit != 0 && s.radius > 0 {
+			to.CubeBezier(rasterx.ToFixedP(float64(s.c1x), float64(s.c1y)), rasterx.ToFixedP(float64(s.c2x), float64(s.c2y)), rasterx.ToFixedP(float64(s.cx), float64(s.cy)))
+		}
+	}
+	if running {
+		to.Stop(false)
+	}
+}
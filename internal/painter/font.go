@@ -19,6 +19,7 @@ import (
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/internal/cache"
+	internaltheme "fyne.io/fyne/v2/internal/theme"
 	"fyne.io/fyne/v2/lang"
 	"fyne.io/fyne/v2/theme"
 )
@@ -69,6 +70,44 @@ func lookupRuneFont(r rune, family string, aspect metadata.Aspect) font.Face {
 	return fm.ResolveFace(r)
 }
 
+// lookupRegisteredFont finds a face for the given rune from the fonts registered at
+// runtime, checking fonts for the rune’s Unicode script before the general fallbacks.
+func lookupRegisteredFont(r rune) font.Face {
+	script := language.LookupScript(r).String()
+	for _, res := range internaltheme.ScriptFonts(script) {
+		if f := registeredFace(res); f != nil {
+			if _, ok := f.NominalGlyph(r); ok {
+				return f
+			}
+		}
+	}
+
+	for _, res := range internaltheme.FallbackFonts() {
+		if f := registeredFace(res); f != nil {
+			if _, ok := f.NominalGlyph(r); ok {
+				return f
+			}
+		}
+	}
+
+	return nil
+}
+
+func registeredFace(res fyne.Resource) font.Face {
+	if val, ok := registeredFaceCache.Load(res); ok {
+		if val == nil {
+			return nil
+		}
+		return val.(font.Face)
+	}
+
+	f := loadMeasureFont(res)
+	registeredFaceCache.Store(res, f)
+	return f
+}
+
+var registeredFaceCache = &sync.Map{} // map[fyne.Resource]font.Face for fonts registered at runtime
+
 func lookupFaces(theme, fallback, emoji fyne.Resource, family string, style fyne.TextStyle) (faces *dynamicFontMap) {
 	f1 := loadMeasureFont(theme)
 	if theme == fallback {
@@ -208,6 +247,54 @@ func MeasureString(f shaping.Fontmap, s string, textSize float32, style fyne.Tex
 	return walkString(f, s, float32ToFixed266(textSize), style, &advance, 1, func(shaping.Output, float32) {})
 }
 
+// RenderedTextMetrics measures a string like RenderedTextSize but additionally returns
+// the vertical font metrics and the advance of each glyph cluster, as needed for
+// baseline alignment and caret placement.
+func RenderedTextMetrics(text string, fontSize float32, style fyne.TextStyle, source fyne.Resource) fyne.TextMetrics {
+	face := CachedFontFace(style, source, nil)
+	size := float32ToFixed266(fontSize)
+
+	// empty strings still have line metrics, read from the face of a space
+	shaper := &shaping.HarfbuzzShaper{}
+	bounds := shaper.Shape(shaping.Input{
+		Text:      []rune{' '},
+		RunStart:  0,
+		RunEnd:    1,
+		Direction: di.DirectionLTR,
+		Face:      face.Fonts.ResolveFace(' '),
+		Size:      size,
+	}).LineBounds
+	metrics := fyne.TextMetrics{
+		Ascent:  fixed266ToFloat32(bounds.Ascent),
+		Descent: -fixed266ToFloat32(bounds.Descent),
+		LineGap: fixed266ToFloat32(bounds.Gap),
+	}
+
+	var advance, total float32
+	var clusters []int
+	walkString(face.Fonts, text, size, style, &advance, 1, func(run shaping.Output, x float32) {
+		if x > total { // a tab moved the dot to the next stop
+			clusters = append(clusters, -1)
+			metrics.Advances = append(metrics.Advances, x-total)
+			total = x
+		}
+		for _, g := range run.Glyphs {
+			adv := fixed266ToFloat32(g.XAdvance)
+			if n := len(clusters); n > 0 && clusters[n-1] == g.ClusterIndex {
+				metrics.Advances[n-1] += adv // multiple glyphs shaped from one cluster
+			} else {
+				clusters = append(clusters, g.ClusterIndex)
+				metrics.Advances = append(metrics.Advances, adv)
+			}
+			total += adv
+		}
+	})
+	if advance > total { // a trailing tab is not followed by any shaped glyphs
+		metrics.Advances = append(metrics.Advances, advance-total)
+	}
+	return metrics
+}
+
 // RenderedTextSize looks up how big a string would be if drawn on screen.
 // It also returns the distance from top to the text baseline.
 func RenderedTextSize(text string, fontSize float32, style fyne.TextStyle, source fyne.Resource) (size fyne.Size, baseline float32) {
@@ -365,6 +452,11 @@ func (d *dynamicFontMap) ResolveFace(r rune) font.Face {
 		}
 	}
 
+	if registered := lookupRegisteredFont(r); registered != nil {
+		d.addFace(registered)
+		return registered
+	}
+
 	toAdd := lookupRuneFont(r, d.family, metadata.Aspect{})
 	if toAdd != nil {
 		d.addFace(toAdd)
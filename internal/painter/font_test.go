@@ -125,6 +125,30 @@ func TestMeasureString(t *testing.T) {
 	}
 }
 
+func TestRenderedTextMetrics(t *testing.T) {
+	metrics := painter.RenderedTextMetrics("Hello", 20, fyne.TextStyle{}, nil)
+	size, baseline := painter.RenderedTextSize("Hello", 20, fyne.TextStyle{}, nil)
+
+	assert.Equal(t, baseline, metrics.Ascent)
+	assert.Positive(t, metrics.Descent)
+	assert.Equal(t, size.Height, metrics.Ascent+metrics.Descent+metrics.LineGap)
+	assert.Len(t, metrics.Advances, 5)
+
+	total := float32(0)
+	for _, advance := range metrics.Advances {
+		assert.Positive(t, advance)
+		total += advance
+	}
+	assert.InDelta(t, size.Width, total, 0.01)
+
+	empty := painter.RenderedTextMetrics("", 20, fyne.TextStyle{}, nil)
+	assert.Equal(t, metrics.Ascent, empty.Ascent)
+	assert.Empty(t, empty.Advances)
+
+	tabbed := painter.RenderedTextMetrics("a\tb", 20, fyne.TextStyle{}, nil)
+	assert.Len(t, tabbed.Advances, 3)
+}
+
 func TestRenderedTextSize(t *testing.T) {
 	size1, baseline1 := painter.RenderedTextSize("Hello World!", 20, fyne.TextStyle{}, nil)
 	size2, baseline2 := painter.RenderedTextSize("\rH\re\rl\rl\ro\r \rW\ro\rr\rl\rd\r!\r", 20, fyne.TextStyle{}, nil)
@@ -21,6 +21,7 @@ type context interface {
 	DrawArrays(mode uint32, first, count int)
 	Enable(capability uint32)
 	EnableVertexAttribArray(attribute Attribute)
+	GenerateMipmap(target uint32)
 	GetAttribLocation(program Program, name string) Attribute
 	GetError() uint32
 	GetProgrami(program Program, param uint32) int
@@ -27,6 +27,11 @@ func (p *painter) defineVertexArray(prog Program, name string, size, stride, off
 }
 
 func (p *painter) drawCircle(circle *canvas.Circle, pos fyne.Position, frame fyne.Size) {
+	if len(circle.StrokeDashPattern) > 0 && circle.StrokeColor != nil && circle.StrokeWidth > 0 {
+		p.drawVectorTexture(circle, p.newGlCircleTexture, pos, frame)
+		return
+	}
+
 	size := circle.Size()
 	radius := size.Width / 2
 	if size.Height < size.Width {
@@ -91,6 +96,14 @@ func (p *painter) drawGradient(o fyne.CanvasObject, texCreator func(fyne.CanvasO
 	p.drawTextureWithDetails(o, texCreator, pos, o.Size(), frame, canvas.ImageFillStretch, 1.0, 0)
 }
 
+func (p *painter) drawGroup(group *canvas.Group, pos fyne.Position, frame fyne.Size) {
+	if group.Content == nil || !group.Content.Visible() {
+		return
+	}
+
+	p.drawTextureWithDetails(group, p.newGlGroupTexture, pos, group.Size(), frame, canvas.ImageFillStretch, 1.0, 0)
+}
+
 func (p *painter) drawImage(img *canvas.Image, pos fyne.Position, frame fyne.Size) {
 	p.drawTextureWithDetails(img, p.newGlImageTexture, pos, img.Size(), frame, img.FillMode, float32(img.Alpha()), 0)
 }
@@ -99,6 +112,10 @@ func (p *painter) drawLine(line *canvas.Line, pos fyne.Position, frame fyne.Size
 	if line.StrokeColor == color.Transparent || line.StrokeColor == nil || line.StrokeWidth == 0 {
 		return
 	}
+	if len(line.StrokeDashPattern) > 0 {
+		p.drawVectorTexture(line, p.newGlLineTexture, pos, frame)
+		return
+	}
 	points, halfWidth, feather := p.lineCoords(pos, line.Position1, line.Position2, line.StrokeWidth, 0.5, frame)
 	p.ctx.UseProgram(p.lineProgram)
 	vbo := p.createBuffer(points)
@@ -127,21 +144,49 @@ func (p *painter) drawObject(o fyne.CanvasObject, pos fyne.Position, frame fyne.
 	switch obj := o.(type) {
 	case *canvas.Circle:
 		p.drawCircle(obj, pos, frame)
+	case *canvas.Group:
+		p.drawGroup(obj, pos, frame)
 	case *canvas.Line:
 		p.drawLine(obj, pos, frame)
 	case *canvas.Image:
 		p.drawImage(obj, pos, frame)
+	case *canvas.Path:
+		p.drawPath(obj, pos, frame)
 	case *canvas.Raster:
 		p.drawRaster(obj, pos, frame)
 	case *canvas.Rectangle:
 		p.drawRectangle(obj, pos, frame)
 	case *canvas.Text:
 		p.drawText(obj, pos, frame)
+	case *canvas.Transform:
+		p.drawTransform(obj, pos, frame)
 	case *canvas.LinearGradient:
 		p.drawGradient(obj, p.newGlLinearGradientTexture, pos, frame)
 	case *canvas.RadialGradient:
 		p.drawGradient(obj, p.newGlRadialGradientTexture, pos, frame)
+	case *canvas.ConicGradient:
+		p.drawGradient(obj, p.newGlConicGradientTexture, pos, frame)
+	case *canvas.Video:
+		p.drawGradient(obj, p.newGlVideoTexture, pos, frame)
+	}
+}
+
+func (p *painter) drawPath(path *canvas.Path, pos fyne.Position, frame fyne.Size) {
+	if path.FillColor == nil && (path.StrokeColor == nil || path.StrokeWidth == 0) {
+		return
 	}
+
+	p.drawVectorTexture(path, p.newGlPathTexture, pos, frame)
+}
+
+// drawVectorTexture draws a rasterized vector object, padded for stroke overflow at the edges.
+// It is used for shapes the shader programs cannot draw directly, such as dashed strokes.
+func (p *painter) drawVectorTexture(o fyne.CanvasObject, creator func(canvasObject fyne.CanvasObject) Texture,
+	pos fyne.Position, frame fyne.Size) {
+	pad := paint.VectorPad(o)
+	size := o.Size().Add(fyne.NewSquareSize(pad * 2))
+	pos = pos.Subtract(fyne.NewSquareOffsetPos(pad))
+	p.drawTextureWithDetails(o, creator, pos, size, frame, canvas.ImageFillStretch, 1.0, 0)
 }
 
 func (p *painter) drawRaster(img *canvas.Raster, pos fyne.Position, frame fyne.Size) {
@@ -152,6 +197,14 @@ func (p *painter) drawRectangle(rect *canvas.Rectangle, pos fyne.Position, frame
 	if (rect.FillColor == color.Transparent || rect.FillColor == nil) && (rect.StrokeColor == color.Transparent || rect.StrokeColor == nil || rect.StrokeWidth == 0) {
 		return
 	}
+	strokeVisible := rect.StrokeColor != nil && rect.StrokeWidth > 0
+	if rect.CornerRadii != (canvas.CornerRadii{}) ||
+		(strokeVisible && (len(rect.StrokeDashPattern) > 0 ||
+			(rect.StrokeSides != 0 && rect.StrokeSides != canvas.RectangleSideAll))) {
+		// the shaders only handle uniform corners and full outlines
+		p.drawVectorTexture(rect, p.newGlRectTexture, pos, frame)
+		return
+	}
 
 	roundedCorners := rect.CornerRadius != 0
 	var program Program
@@ -243,6 +296,60 @@ func (p *painter) drawText(text *canvas.Text, pos fyne.Position, frame fyne.Size
 	p.drawTextureWithDetails(text, p.newGlTextTexture, pos, size, frame, canvas.ImageFillStretch, 1.0, 0)
 }
 
+func (p *painter) drawTransform(t *canvas.Transform, pos fyne.Position, frame fyne.Size) {
+	if t.Content == nil || !t.Content.Visible() {
+		return
+	}
+
+	texture, err := p.getTexture(t, p.newGlTransformTexture)
+	if err != nil {
+		return
+	}
+
+	points := p.transformCoords(t, pos, frame)
+	p.ctx.UseProgram(p.program)
+	vbo := p.createBuffer(points)
+	p.defineVertexArray(p.program, "vert", 3, 5, 0)
+	p.defineVertexArray(p.program, "vertTexCoord", 2, 5, 3)
+
+	p.ctx.BlendFunc(one, oneMinusSrcAlpha)
+	p.logError()
+
+	p.ctx.ActiveTexture(texture0)
+	p.ctx.BindTexture(texture2D, texture)
+	p.logError()
+
+	p.ctx.DrawArrays(triangleStrip, 0, 4)
+	p.logError()
+	p.freeBuffer(vbo)
+}
+
+// transformCoords maps the corners of the transform content through its rotation
+// and scale matrix, returning a textured quad that may no longer be axis aligned.
+func (p *painter) transformCoords(t *canvas.Transform, pos fyne.Position, frame fyne.Size) []float32 {
+	a, b, c, d, e, f := paint.TransformMatrix(t)
+	size := t.Content.Size()
+
+	corner := func(x, y float32) (float32, float32) {
+		outX := pos.X + a*x + b*y + c
+		outY := pos.Y + d*x + e*y + f
+		return -1 + (outX/frame.Width)*2, 1 - (outY/frame.Height)*2
+	}
+
+	x1, y1 := corner(0, 0)
+	x2, y2 := corner(size.Width, 0)
+	x3, y3 := corner(0, size.Height)
+	x4, y4 := corner(size.Width, size.Height)
+
+	return []float32{
+		// coord x, y, z texture x, y
+		x3, y3, 0, 0, 1, // bottom left
+		x1, y1, 0, 0, 0, // top left
+		x4, y4, 0, 1, 1, // bottom right
+		x2, y2, 0, 1, 0, // top right
+	}
+}
+
 func (p *painter) drawTextureWithDetails(o fyne.CanvasObject, creator func(canvasObject fyne.CanvasObject) Texture,
 	pos fyne.Position, size, frame fyne.Size, fill canvas.ImageFill, alpha float32, pad float32) {
 
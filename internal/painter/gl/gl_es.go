@@ -22,6 +22,7 @@ const (
 	fragmentShader        = gl.FRAGMENT_SHADER
 	front                 = gl.FRONT
 	glFalse               = gl.FALSE
+	linearMipmapLinear    = gl.LINEAR_MIPMAP_LINEAR
 	linkStatus            = gl.LINK_STATUS
 	one                   = gl.ONE
 	oneMinusConstantAlpha = gl.ONE_MINUS_CONSTANT_ALPHA
@@ -57,6 +58,8 @@ type (
 	Uniform int32
 )
 
+const canMipmap = true // GLES 2+ generates mipmaps for any texture size
+
 var textureFilterToGL = []int32{gl.LINEAR, gl.NEAREST, gl.LINEAR}
 
 func (p *painter) Init() {
@@ -165,6 +168,10 @@ func (c *esContext) EnableVertexAttribArray(attribute Attribute) {
 	gl.EnableVertexAttribArray(uint32(attribute))
 }
 
+func (c *esContext) GenerateMipmap(target uint32) {
+	gl.GenerateMipmap(target)
+}
+
 func (c *esContext) GetAttribLocation(program Program, name string) Attribute {
 	return Attribute(gl.GetAttribLocation(uint32(program), gl.Str(name+"\x00")))
 }
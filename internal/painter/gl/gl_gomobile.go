@@ -22,6 +22,7 @@ const (
 	fragmentShader        = gl.FragmentShader
 	front                 = gl.Front
 	glFalse               = gl.False
+	linearMipmapLinear    = gl.LinearMipmapLinear
 	linkStatus            = gl.LinkStatus
 	one                   = gl.One
 	oneMinusConstantAlpha = gl.OneMinusConstantAlpha
@@ -57,6 +58,10 @@ type (
 var compiled []Program // avoid multiple compilations with the re-used mobile GUI context
 var noBuffer = Buffer{}
 var noShader = Shader{}
+
+// the worker based mobile context does not expose glGenerateMipmap
+const canMipmap = false
+
 var textureFilterToGL = []int32{gl.Linear, gl.Nearest}
 
 func (p *painter) glctx() gl.Context {
@@ -197,6 +202,10 @@ func (c *mobileContext) EnableVertexAttribArray(attribute Attribute) {
 	c.glContext.EnableVertexAttribArray(gl.Attrib(attribute))
 }
 
+func (c *mobileContext) GenerateMipmap(uint32) {
+	// not supported, trilinear filtering falls back to smooth before we get here
+}
+
 func (c *mobileContext) GetAttribLocation(program Program, name string) Attribute {
 	return Attribute(c.glContext.GetAttribLocation(gl.Program(program), name))
 }
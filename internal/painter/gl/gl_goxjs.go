@@ -21,6 +21,7 @@ const (
 	fragmentShader        = gl.FRAGMENT_SHADER
 	front                 = gl.FRONT
 	glFalse               = gl.FALSE
+	linearMipmapLinear    = gl.LINEAR_MIPMAP_LINEAR
 	linkStatus            = gl.LINK_STATUS
 	one                   = gl.ONE
 	oneMinusConstantAlpha = gl.ONE_MINUS_CONSTANT_ALPHA
@@ -55,6 +56,10 @@ type (
 
 var noBuffer = Buffer(gl.NoBuffer)
 var noShader = Shader(gl.NoShader)
+
+// WebGL 1 cannot mipmap the non-power-of-two textures we upload.
+const canMipmap = false
+
 var textureFilterToGL = []int32{gl.LINEAR, gl.NEAREST}
 
 func (p *painter) Init() {
@@ -152,6 +157,10 @@ func (c *xjsContext) EnableVertexAttribArray(attribute Attribute) {
 	gl.EnableVertexAttribArray(gl.Attrib(attribute))
 }
 
+func (c *xjsContext) GenerateMipmap(target uint32) {
+	gl.GenerateMipmap(gl.Enum(target))
+}
+
 func (c *xjsContext) GetAttribLocation(program Program, name string) Attribute {
 	return Attribute(gl.GetAttribLocation(gl.Program(program), name))
 }
@@ -11,6 +11,7 @@ import (
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/internal/cache"
 	paint "fyne.io/fyne/v2/internal/painter"
+	"fyne.io/fyne/v2/internal/painter/software"
 	"fyne.io/fyne/v2/theme"
 )
 
@@ -61,6 +62,7 @@ func (p *painter) imgToTexture(img image.Image, textureFilter canvas.ImageScale)
 			unsignedByte,
 			data,
 		)
+		p.generateMipmap(textureFilter)
 		p.logError()
 		return texture
 	case *image.RGBA:
@@ -78,6 +80,7 @@ func (p *painter) imgToTexture(img image.Image, textureFilter canvas.ImageScale)
 			unsignedByte,
 			i.Pix,
 		)
+		p.generateMipmap(textureFilter)
 		p.logError()
 		return texture
 	default:
@@ -87,6 +90,23 @@ func (p *painter) imgToTexture(img image.Image, textureFilter canvas.ImageScale)
 	}
 }
 
+func (p *painter) generateMipmap(textureFilter canvas.ImageScale) {
+	if textureFilter != canvas.ImageScaleTrilinear || !canMipmap {
+		return
+	}
+
+	p.ctx.GenerateMipmap(texture2D)
+}
+
+func (p *painter) newGlGroupTexture(obj fyne.CanvasObject) Texture {
+	group := obj.(*canvas.Group)
+	if group.Content == nil {
+		return noTexture
+	}
+
+	return p.imgToTexture(software.RenderGroup(group, p.canvas), canvas.ImageScaleSmooth)
+}
+
 func (p *painter) newGlImageTexture(obj fyne.CanvasObject) Texture {
 	img := obj.(*canvas.Image)
 
@@ -118,6 +138,43 @@ func (p *painter) newGlLinearGradientTexture(obj fyne.CanvasObject) Texture {
 	return p.imgToTexture(gradient.Generate(int(width), int(height)), canvas.ImageScaleSmooth)
 }
 
+func (p *painter) newGlConicGradientTexture(obj fyne.CanvasObject) Texture {
+	gradient := obj.(*canvas.ConicGradient)
+
+	width := p.textureScale(gradient.Size().Width)
+	height := p.textureScale(gradient.Size().Height)
+
+	return p.imgToTexture(gradient.Generate(int(width), int(height)), canvas.ImageScaleSmooth)
+}
+
+func (p *painter) newGlCircleTexture(obj fyne.CanvasObject) Texture {
+	circle := obj.(*canvas.Circle)
+
+	raw := paint.DrawCircle(circle, paint.VectorPad(circle), p.textureScale)
+	return p.imgToTexture(raw, canvas.ImageScaleSmooth)
+}
+
+func (p *painter) newGlLineTexture(obj fyne.CanvasObject) Texture {
+	line := obj.(*canvas.Line)
+
+	raw := paint.DrawLine(line, paint.VectorPad(line), p.textureScale)
+	return p.imgToTexture(raw, canvas.ImageScaleSmooth)
+}
+
+func (p *painter) newGlPathTexture(obj fyne.CanvasObject) Texture {
+	path := obj.(*canvas.Path)
+
+	raw := paint.DrawPath(path, paint.VectorPad(path), p.textureScale)
+	return p.imgToTexture(raw, canvas.ImageScaleSmooth)
+}
+
+func (p *painter) newGlRectTexture(obj fyne.CanvasObject) Texture {
+	rect := obj.(*canvas.Rectangle)
+
+	raw := paint.DrawRectangle(rect, paint.VectorPad(rect), p.textureScale)
+	return p.imgToTexture(raw, canvas.ImageScaleSmooth)
+}
+
 func (p *painter) newGlRadialGradientTexture(obj fyne.CanvasObject) Texture {
 	gradient := obj.(*canvas.RadialGradient)
 
@@ -153,19 +210,49 @@ func (p *painter) newGlTextTexture(obj fyne.CanvasObject) Texture {
 	return p.imgToTexture(img, canvas.ImageScaleSmooth)
 }
 
+func (p *painter) newGlTransformTexture(obj fyne.CanvasObject) Texture {
+	t := obj.(*canvas.Transform)
+	if t.Content == nil {
+		return noTexture
+	}
+
+	img := software.PaintObject(t.Content, p.canvas, t.Content.Size())
+	return p.imgToTexture(img, canvas.ImageScaleSmooth)
+}
+
+func (p *painter) newGlVideoTexture(obj fyne.CanvasObject) Texture {
+	video := obj.(*canvas.Video)
+
+	width := p.textureScale(video.Size().Width)
+	height := p.textureScale(video.Size().Height)
+
+	return p.imgToTexture(video.Generate(int(width), int(height)), canvas.ImageScaleSmooth)
+}
+
 func (p *painter) newTexture(textureFilter canvas.ImageScale) Texture {
-	if int(textureFilter) >= len(textureFilterToGL) {
-		fyne.LogError(fmt.Sprintf("Invalid canvas.ImageScale value (%d), using canvas.ImageScaleSmooth as default value", textureFilter), nil)
+	if textureFilter == canvas.ImageScaleTrilinear && !canMipmap {
 		textureFilter = canvas.ImageScaleSmooth
 	}
+	minFilter := int32(linearMipmapLinear)
+	if textureFilter != canvas.ImageScaleTrilinear {
+		if int(textureFilter) >= len(textureFilterToGL) {
+			fyne.LogError(fmt.Sprintf("Invalid canvas.ImageScale value (%d), using canvas.ImageScaleSmooth as default value", textureFilter), nil)
+			textureFilter = canvas.ImageScaleSmooth
+		}
+		minFilter = textureFilterToGL[textureFilter]
+	}
+	magFilter := minFilter
+	if textureFilter == canvas.ImageScaleTrilinear { // mipmap filters only apply to minification
+		magFilter = textureFilterToGL[canvas.ImageScaleSmooth]
+	}
 
 	texture := p.ctx.CreateTexture()
 	p.logError()
 	p.ctx.ActiveTexture(texture0)
 	p.ctx.BindTexture(texture2D, texture)
 	p.logError()
-	p.ctx.TexParameteri(texture2D, textureMinFilter, textureFilterToGL[textureFilter])
-	p.ctx.TexParameteri(texture2D, textureMagFilter, textureFilterToGL[textureFilter])
+	p.ctx.TexParameteri(texture2D, textureMinFilter, minFilter)
+	p.ctx.TexParameteri(texture2D, textureMagFilter, magFilter)
 	p.ctx.TexParameteri(texture2D, textureWrapS, clampToEdge)
 	p.ctx.TexParameteri(texture2D, textureWrapT, clampToEdge)
 	p.logError()
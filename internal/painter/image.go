@@ -39,13 +39,72 @@ func paintImage(img *canvas.Image, width, height int) (dst image.Image, err erro
 
 	size := dst.Bounds().Size()
 	if width != size.X || height != size.Y {
-		dst = scaleImage(dst, width, height, img.ScaleMode)
+		if img.CapInsets != (canvas.CapInsets{}) {
+			dst = ninePatchScale(dst, width, height, img.CapInsets, img.ScaleMode)
+		} else {
+			dst = scaleImage(dst, width, height, img.ScaleMode)
+		}
 	}
 	return
 }
 
+// ninePatchScale composes an image at the requested size keeping the corner regions
+// described by the insets unscaled, stretching the edges along one axis and the center
+// in both, so borders stay crisp at any size.
+func ninePatchScale(pixels image.Image, scaledW, scaledH int, insets canvas.CapInsets, mode canvas.ImageScale) image.Image {
+	bounds := pixels.Bounds()
+	left, right := capSpans(int(insets.Left), int(insets.Right), bounds.Dx(), scaledW)
+	top, bottom := capSpans(int(insets.Top), int(insets.Bottom), bounds.Dy(), scaledH)
+
+	var scaler draw.Scaler
+	switch mode {
+	case canvas.ImageScalePixels:
+		scaler = draw.NearestNeighbor
+	case canvas.ImageScaleFastest:
+		scaler = draw.ApproxBiLinear
+	case canvas.ImageScaleTrilinear:
+		scaler = draw.CatmullRom // the patches must compose at their final size, no mipmaps apply
+	default:
+		if mode != canvas.ImageScaleSmooth {
+			fyne.LogError("Invalid canvas.ImageScale value, using canvas.ImageScaleSmooth", nil)
+		}
+		scaler = draw.CatmullRom
+	}
+
+	srcX := [4]int{bounds.Min.X, bounds.Min.X + left, bounds.Max.X - right, bounds.Max.X}
+	srcY := [4]int{bounds.Min.Y, bounds.Min.Y + top, bounds.Max.Y - bottom, bounds.Max.Y}
+	dstX := [4]int{0, left, scaledW - right, scaledW}
+	dstY := [4]int{0, top, scaledH - bottom, scaledH}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, scaledW, scaledH))
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			sr := image.Rect(srcX[i], srcY[j], srcX[i+1], srcY[j+1])
+			dr := image.Rect(dstX[i], dstY[j], dstX[i+1], dstY[j+1])
+			if sr.Empty() || dr.Empty() {
+				continue
+			}
+			scaler.Scale(dst, dr, pixels, sr, draw.Src, nil)
+		}
+	}
+	return dst
+}
+
+// capSpans clamps a pair of insets so they never overlap in the source or the target.
+func capSpans(low, high, src, target int) (int, int) {
+	max := src
+	if target < max {
+		max = target
+	}
+	if sum := low + high; sum > max && sum > 0 {
+		low = low * max / sum
+		high = max - low
+	}
+	return low, high
+}
+
 func scaleImage(pixels image.Image, scaledW, scaledH int, scale canvas.ImageScale) image.Image {
-	if scale == canvas.ImageScaleFastest || scale == canvas.ImageScalePixels {
+	if scale == canvas.ImageScaleFastest || scale == canvas.ImageScalePixels || scale == canvas.ImageScaleTrilinear {
 		// do not perform software scaling
 		return pixels
 	}
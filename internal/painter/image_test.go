@@ -1,6 +1,8 @@
 package painter_test
 
 import (
+	"image"
+	"image/color"
 	"testing"
 
 	"fyne.io/fyne/v2"
@@ -85,6 +87,37 @@ func TestPaintImage_SVG(t *testing.T) {
 	}
 }
 
+func TestPaintImage_CapInsets(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 3, 3))
+	corner := color.NRGBA{R: 0xff, A: 0xff}
+	edge := color.NRGBA{G: 0xff, A: 0xff}
+	center := color.NRGBA{B: 0xff, A: 0xff}
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 3; x++ {
+			switch {
+			case x == 1 && y == 1:
+				src.Set(x, y, center)
+			case x == 1 || y == 1:
+				src.Set(x, y, edge)
+			default:
+				src.Set(x, y, corner)
+			}
+		}
+	}
+
+	img := canvas.NewImageFromImage(src)
+	img.ScaleMode = canvas.ImageScalePixels
+	img.CapInsets = canvas.CapInsets{Top: 1, Right: 1, Bottom: 1, Left: 1}
+	img.Resize(fyne.NewSize(9, 9))
+
+	pix := painter.PaintImage(img, nil, 9, 9)
+	assert.Equal(t, corner, pix.At(0, 0)) // corners stay a single pixel
+	assert.Equal(t, edge, pix.At(1, 0))
+	assert.Equal(t, corner, pix.At(8, 8))
+	assert.Equal(t, edge, pix.At(0, 4))
+	assert.Equal(t, center, pix.At(4, 4))
+}
+
 func TestPaintImage_Direct(t *testing.T) {
 	img := canvas.NewImageFromFile("testdata/stroke.svg")
 	pix := painter.PaintImage(img, nil, 480, 240)
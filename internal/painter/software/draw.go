@@ -3,6 +3,7 @@ package software
 import (
 	"fmt"
 	"image"
+	"image/color"
 	"math"
 
 	"fyne.io/fyne/v2"
@@ -12,6 +13,7 @@ import (
 	"fyne.io/fyne/v2/theme"
 
 	"golang.org/x/image/draw"
+	"golang.org/x/image/math/f64"
 )
 
 type gradient interface {
@@ -49,6 +51,63 @@ func drawGradient(c fyne.Canvas, g gradient, pos fyne.Position, base *image.NRGB
 	drawTex(scale.ToScreenCoordinate(c, pos.X), scale.ToScreenCoordinate(c, pos.Y), width, height, base, tex, clip)
 }
 
+// RenderGroup renders the content of a group into an image clipped to the group
+// bounds, with any corner rounding and translucency applied. It is shared with
+// the GL painter, which uploads the result as a texture.
+func RenderGroup(group *canvas.Group, c fyne.Canvas) *image.NRGBA {
+	img := PaintObject(group.Content, c, group.Size()).(*image.NRGBA)
+
+	if group.CornerRadius > 0 {
+		corners := canvas.NewRectangle(color.White)
+		corners.CornerRadius = group.CornerRadius
+		corners.Resize(group.Size())
+		mask := painter.DrawRectangle(corners, 0, func(in float32) float32 {
+			return float32(math.Round(float64(in) * float64(c.Scale())))
+		})
+		maskAlpha(img, mask)
+	}
+	if group.Translucency > 0 {
+		fadeAlpha(img, 1-group.Translucency)
+	}
+	return img
+}
+
+func maskAlpha(img *image.NRGBA, mask *image.RGBA) {
+	bounds := img.Bounds().Intersect(mask.Bounds())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			i := img.PixOffset(x, y) + 3
+			img.Pix[i] = uint8(uint32(img.Pix[i]) * uint32(mask.RGBAAt(x, y).A) / 0xff)
+		}
+	}
+	// anything beyond the mask is outside the clip region
+	for y := img.Bounds().Min.Y; y < img.Bounds().Max.Y; y++ {
+		for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x++ {
+			if !image.Pt(x, y).In(bounds) {
+				img.Pix[img.PixOffset(x, y)+3] = 0
+			}
+		}
+	}
+}
+
+func fadeAlpha(img *image.NRGBA, alpha float64) {
+	for i := 3; i < len(img.Pix); i += 4 {
+		img.Pix[i] = uint8(float64(img.Pix[i]) * alpha)
+	}
+}
+
+func drawGroup(c fyne.Canvas, group *canvas.Group, pos fyne.Position, base *image.NRGBA, clip image.Rectangle) {
+	if group.Content == nil || !group.Content.Visible() {
+		return
+	}
+
+	width := scale.ToScreenCoordinate(c, group.Size().Width)
+	height := scale.ToScreenCoordinate(c, group.Size().Height)
+	scaledX, scaledY := scale.ToScreenCoordinate(c, pos.X), scale.ToScreenCoordinate(c, pos.Y)
+
+	drawTex(scaledX, scaledY, width, height, base, RenderGroup(group, c), clip)
+}
+
 func drawImage(c fyne.Canvas, img *canvas.Image, pos fyne.Position, base *image.NRGBA, clip image.Rectangle) {
 	bounds := img.Size()
 	if bounds.IsZero() {
@@ -92,6 +151,8 @@ func drawPixels(x, y, width, height int, mode canvas.ImageScale, base *image.NRG
 		draw.NearestNeighbor.Scale(scaledImg, scaledBounds, origImg, origImg.Bounds(), draw.Over, nil)
 	case canvas.ImageScaleFastest:
 		draw.ApproxBiLinear.Scale(scaledImg, scaledBounds, origImg, origImg.Bounds(), draw.Over, nil)
+	case canvas.ImageScaleTrilinear: // no mipmaps in software, use the best single pass filter
+		draw.CatmullRom.Scale(scaledImg, scaledBounds, origImg, origImg.Bounds(), draw.Over, nil)
 	default:
 		if mode != canvas.ImageScaleSmooth {
 			fyne.LogError(fmt.Sprintf("Invalid canvas.ImageScale value (%d), using canvas.ImageScaleSmooth as default value", mode), nil)
@@ -124,6 +185,51 @@ func drawLine(c fyne.Canvas, line *canvas.Line, pos fyne.Position, base *image.N
 	draw.Draw(base, bounds, raw, image.Point{offX, offY}, draw.Over)
 }
 
+func drawPath(c fyne.Canvas, path *canvas.Path, pos fyne.Position, base *image.NRGBA, clip image.Rectangle) {
+	pad := painter.VectorPad(path)
+	scaledWidth := scale.ToScreenCoordinate(c, path.Size().Width+pad*2)
+	scaledHeight := scale.ToScreenCoordinate(c, path.Size().Height+pad*2)
+	scaledX, scaledY := scale.ToScreenCoordinate(c, pos.X-pad), scale.ToScreenCoordinate(c, pos.Y-pad)
+	bounds := clip.Intersect(image.Rect(scaledX, scaledY, scaledX+scaledWidth, scaledY+scaledHeight))
+
+	raw := painter.DrawPath(path, pad, func(in float32) float32 {
+		return float32(math.Round(float64(in) * float64(c.Scale())))
+	})
+
+	// the clip intersect above cannot be negative, so we may need to compensate
+	offX, offY := 0, 0
+	if scaledX < 0 {
+		offX = -scaledX
+	}
+	if scaledY < 0 {
+		offY = -scaledY
+	}
+	draw.Draw(base, bounds, raw, image.Point{offX, offY}, draw.Over)
+}
+
+func drawTransform(c fyne.Canvas, t *canvas.Transform, pos fyne.Position, base *image.NRGBA, clip image.Rectangle) {
+	content := t.Content
+	if content == nil || !content.Visible() {
+		return
+	}
+
+	src := PaintObject(content, c, content.Size())
+
+	// the matrix is in object units, screen translations scale to pixels
+	a, b, tx, d, e, ty := painter.TransformMatrix(t)
+	pixels := float64(c.Scale())
+	aff := f64.Aff3{
+		float64(a), float64(b), (float64(tx) + float64(pos.X)) * pixels,
+		float64(d), float64(e), (float64(ty) + float64(pos.Y)) * pixels,
+	}
+
+	dst := base
+	if sub, ok := base.SubImage(clip).(*image.NRGBA); ok {
+		dst = sub
+	}
+	draw.CatmullRom.Transform(dst, aff, src, src.Bounds(), draw.Over, nil)
+}
+
 func drawTex(x, y, width, height int, base *image.NRGBA, tex image.Image, clip image.Rectangle) {
 	outBounds := image.Rect(x, y, x+width, y+height)
 	clippedBounds := clip.Intersect(outBounds)
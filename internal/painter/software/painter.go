@@ -22,12 +22,40 @@ func NewPainter() *Painter {
 // The canvas to be drawn is passed in as a parameter and the return is an
 // image containing the result of rendering.
 func (*Painter) Paint(c fyne.Canvas) image.Image {
-	bounds := image.Rect(0, 0, scale.ToScreenCoordinate(c, c.Size().Width), scale.ToScreenCoordinate(c, c.Size().Height))
-	base := image.NewNRGBA(bounds)
+	base := newPaintImage(c, c.Size())
 
-	paint := func(obj fyne.CanvasObject, pos, clipPos fyne.Position, clipSize fyne.Size) bool {
-		w := fyne.Min(clipPos.X+clipSize.Width, c.Size().Width)
-		h := fyne.Min(clipPos.Y+clipSize.Height, c.Size().Height)
+	paint := paintFunc(base, c, c.Size(), fyne.Position{})
+	driver.WalkVisibleObjectTree(c.Content(), paint, nil)
+	for _, o := range c.Overlays().List() {
+		driver.WalkVisibleObjectTree(o, paint, nil)
+	}
+
+	return base
+}
+
+// PaintObject renders the given object tree into an image of the specified size, using
+// the scale and theme settings of the supplied canvas. The object keeps its current
+// size and state, making this suitable for snapshotting part of a live canvas.
+func PaintObject(obj fyne.CanvasObject, c fyne.Canvas, size fyne.Size) image.Image {
+	base := newPaintImage(c, size)
+
+	driver.WalkVisibleObjectTree(obj, paintFunc(base, c, size, obj.Position()), nil)
+	return base
+}
+
+func newPaintImage(c fyne.Canvas, size fyne.Size) *image.NRGBA {
+	bounds := image.Rect(0, 0, scale.ToScreenCoordinate(c, size.Width), scale.ToScreenCoordinate(c, size.Height))
+	return image.NewNRGBA(bounds)
+}
+
+// paintFunc returns a tree walker painting objects to base, clipped to the given size.
+// Any non-zero origin is subtracted so the object at that position paints at the top left.
+func paintFunc(base *image.NRGBA, c fyne.Canvas, size fyne.Size, origin fyne.Position) func(fyne.CanvasObject, fyne.Position, fyne.Position, fyne.Size) bool {
+	return func(obj fyne.CanvasObject, pos, clipPos fyne.Position, clipSize fyne.Size) bool {
+		pos = pos.Subtract(origin)
+		clipPos = clipPos.Subtract(origin)
+		w := fyne.Min(clipPos.X+clipSize.Width, size.Width)
+		h := fyne.Min(clipPos.Y+clipSize.Height, size.Height)
 		clip := image.Rect(
 			scale.ToScreenCoordinate(c, clipPos.X),
 			scale.ToScreenCoordinate(c, clipPos.Y),
@@ -39,25 +67,24 @@ func (*Painter) Paint(c fyne.Canvas) image.Image {
 			drawImage(c, o, pos, base, clip)
 		case *canvas.Text:
 			drawText(c, o, pos, base, clip)
-		case gradient:
+		case gradient: // also canvas.Video, which generates frame textures the same way
 			drawGradient(c, o, pos, base, clip)
 		case *canvas.Circle:
 			drawCircle(c, o, pos, base, clip)
+		case *canvas.Group:
+			drawGroup(c, o, pos, base, clip)
 		case *canvas.Line:
 			drawLine(c, o, pos, base, clip)
+		case *canvas.Path:
+			drawPath(c, o, pos, base, clip)
 		case *canvas.Raster:
 			drawRaster(c, o, pos, base, clip)
 		case *canvas.Rectangle:
 			drawRectangle(c, o, pos, base, clip)
+		case *canvas.Transform:
+			drawTransform(c, o, pos, base, clip)
 		}
 
 		return false
 	}
-
-	driver.WalkVisibleObjectTree(c.Content(), paint, nil)
-	for _, o := range c.Overlays().List() {
-		driver.WalkVisibleObjectTree(o, paint, nil)
-	}
-
-	return base
 }
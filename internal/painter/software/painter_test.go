@@ -334,6 +334,96 @@ func TestPainter_paintRectangle_stroke(t *testing.T) {
 	test.AssertImageMatches(t, "draw_rectangle_stroke.png", p.Paint(c))
 }
 
+func TestPainter_paintRectangle_cornersAndSides(t *testing.T) {
+	test.ApplyTheme(t, test.Theme())
+	obj := canvas.NewRectangle(color.Black)
+	obj.CornerRadii = canvas.CornerRadii{TopLeft: 15, TopRight: 15}
+	obj.StrokeWidth = 3
+	obj.StrokeColor = &color.RGBA{R: 0xFF, G: 0x33, B: 0x33, A: 0xFF}
+	obj.StrokeSides = canvas.RectangleSideTop | canvas.RectangleSideLeft | canvas.RectangleSideRight
+
+	c := test.NewCanvas()
+	c.SetPadded(true)
+	c.SetContent(obj)
+	c.Resize(fyne.NewSize(70+2*theme.Padding(), 70+2*theme.Padding()))
+	p := software.NewPainter()
+
+	test.AssertImageMatches(t, "draw_rectangle_corners_sides.png", p.Paint(c))
+}
+
+func TestPainter_paintRectangle_strokeDashed(t *testing.T) {
+	test.ApplyTheme(t, test.Theme())
+	obj := canvas.NewRectangle(color.Black)
+	obj.StrokeWidth = 3
+	obj.StrokeColor = &color.RGBA{R: 0xFF, G: 0x33, B: 0x33, A: 0xFF}
+	obj.StrokeDashPattern = []float32{6, 4}
+
+	c := test.NewCanvas()
+	c.SetPadded(true)
+	c.SetContent(obj)
+	c.Resize(fyne.NewSize(70+2*theme.Padding(), 70+2*theme.Padding()))
+	p := software.NewPainter()
+
+	test.AssertImageMatches(t, "draw_rectangle_stroke_dashed.png", p.Paint(c))
+}
+
+func TestPainter_paintPath(t *testing.T) {
+	test.ApplyTheme(t, test.Theme())
+	obj := canvas.NewPath()
+	obj.FillColor = color.White
+	obj.StrokeColor = color.Black
+	obj.StrokeWidth = 2
+	obj.MoveTo(10, 60)
+	obj.LineTo(35, 10)
+	obj.LineTo(60, 60)
+	obj.QuadTo(35, 40, 10, 60)
+	obj.Close()
+
+	c := test.NewCanvas()
+	c.SetPadded(true)
+	c.SetContent(obj)
+	c.Resize(fyne.NewSize(70+2*theme.Padding(), 70+2*theme.Padding()))
+	p := software.NewPainter()
+
+	test.AssertImageMatches(t, "draw_path.png", p.Paint(c))
+}
+
+func TestPainter_paintGroup(t *testing.T) {
+	test.ApplyTheme(t, test.Theme())
+	circle := canvas.NewCircle(color.White)
+	circle.Resize(fyne.NewSize(100, 100))
+	circle.Move(fyne.NewPos(20, 20)) // overflows the group and is clipped
+	content := container.NewWithoutLayout(circle)
+	obj := canvas.NewGroup(content)
+	obj.CornerRadius = 12
+	obj.Translucency = 0.25
+
+	c := test.NewCanvas()
+	c.SetPadded(true)
+	c.SetContent(obj)
+	c.Resize(fyne.NewSize(70+2*theme.Padding(), 70+2*theme.Padding()))
+	p := software.NewPainter()
+
+	test.AssertImageMatches(t, "draw_group.png", p.Paint(c))
+}
+
+func TestPainter_paintTransform(t *testing.T) {
+	test.ApplyTheme(t, test.Theme())
+	rect := canvas.NewRectangle(color.White)
+	rect.StrokeColor = color.Black
+	rect.StrokeWidth = 2
+	obj := canvas.NewTransform(rect)
+	obj.Rotation = 30
+
+	c := test.NewCanvas()
+	c.SetPadded(true)
+	c.SetContent(obj)
+	c.Resize(fyne.NewSize(70+2*theme.Padding(), 70+2*theme.Padding()))
+	p := software.NewPainter()
+
+	test.AssertImageMatches(t, "draw_transform.png", p.Paint(c))
+}
+
 func TestPainter_paintText_clipped(t *testing.T) {
 	test.ApplyTheme(t, test.Theme())
 	scroll := container.NewScroll(widget.NewLabel("some text\nis here\nand here"))
@@ -1,10 +1,34 @@
 package painter
 
 import (
+	"math"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 )
 
+// TransformMatrix returns the affine coefficients mapping content coordinates to
+// object coordinates for the given transform, in row-major [a b c; d e f] order.
+// The values are in the same units as the object size, painters scale for output.
+func TransformMatrix(t *canvas.Transform) (a, b, c, d, e, f float32) {
+	scale := float64(t.Scale)
+	if scale == 0 {
+		scale = 1
+	}
+	sin, cos := math.Sincos(float64(t.Rotation) * math.Pi / 180)
+	a, b = float32(scale*cos), float32(-scale*sin)
+	d, e = float32(scale*sin), float32(scale*cos)
+
+	origin := t.Origin
+	if origin == (fyne.Position{}) {
+		size := t.Size()
+		origin = fyne.NewPos(size.Width/2, size.Height/2)
+	}
+	c = origin.X - a*origin.X - b*origin.Y
+	f = origin.Y - d*origin.X - e*origin.Y
+	return
+}
+
 // VectorPad returns the number of additional points that should be added around a texture.
 // This is to accommodate overflow caused by stroke and line endings etc.
 // THe result is in fyne.Size type coordinates and should be scaled for output.
@@ -19,6 +43,11 @@ func VectorPad(obj fyne.CanvasObject) float32 {
 		if co.StrokeWidth > 0 {
 			return co.StrokeWidth + 2
 		}
+	case *canvas.Path:
+		if co.StrokeWidth > 0 && co.StrokeColor != nil {
+			return co.StrokeWidth + 2
+		}
+		return 1 // anti-alias on path fill
 	case *canvas.Rectangle:
 		if co.StrokeWidth > 0 && co.StrokeColor != nil {
 			return co.StrokeWidth + 2
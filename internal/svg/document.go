@@ -0,0 +1,119 @@
+package svg
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image/color"
+)
+
+// Document is a retained object model for a Scalable Vector Graphic, parsed once
+// and manipulated at runtime without templating the source text.
+type Document struct {
+	root *svg
+}
+
+// ParseDocument reads SVG content into a document model for later manipulation.
+func ParseDocument(content []byte) (*Document, error) {
+	root, err := svgFromXML(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	return &Document{root: root}, nil
+}
+
+// Marshal returns the XML form of the document in its current state.
+func (d *Document) Marshal() ([]byte, error) {
+	return xml.Marshal(d.root)
+}
+
+// ReplaceFillColor sets the fill color of every element in the document,
+// in the same way icon theming does.
+func (d *Document) ReplaceFillColor(clr color.Color) {
+	_ = d.root.replaceFillColor(clr)
+}
+
+// SetFillColor sets the fill color of the elements with the given id,
+// returning false if no element matched.
+func (d *Document) SetFillColor(id string, clr color.Color) bool {
+	hexColor, opacity := colorToHexAndOpacity(clr)
+	found := false
+	for _, el := range d.root.elements() {
+		if el.id != id {
+			continue
+		}
+		*el.fill = hexColor
+		*el.fillOpacity = opacity
+		found = true
+	}
+	return found
+}
+
+// SetVisible shows or hides the elements with the given id,
+// returning false if no element matched.
+func (d *Document) SetVisible(id string, visible bool) bool {
+	display := "none"
+	if visible {
+		display = ""
+	}
+
+	found := false
+	for _, el := range d.root.elements() {
+		if el.id != id {
+			continue
+		}
+		*el.display = display
+		found = true
+	}
+	return found
+}
+
+// SetViewBox updates the visible region of the document coordinate system.
+func (d *Document) SetViewBox(x, y, w, h float64) {
+	d.root.ViewBox = fmt.Sprintf("%g %g %g %g", x, y, w, h)
+}
+
+// ViewBox returns the current viewBox attribute of the document.
+func (d *Document) ViewBox() string {
+	return d.root.ViewBox
+}
+
+// element provides uniform access to the attributes shared by the svg object types.
+type element struct {
+	id                         string
+	fill, fillOpacity, display *string
+}
+
+func (s *svg) elements() []element {
+	els := appendElements(nil, s.Paths, s.Rects, s.Circles, s.Ellipses, s.Polygons)
+	return appendGroupElements(els, s.Groups)
+}
+
+func appendElements(els []element, paths []*pathObj, rects []*rectObj, circles []*circleObj,
+	ellipses []*ellipseObj, polygons []*polygonObj) []element {
+	for _, o := range paths {
+		els = append(els, element{o.ID, &o.Fill, &o.FillOpacity, &o.Display})
+	}
+	for _, o := range rects {
+		els = append(els, element{o.ID, &o.Fill, &o.FillOpacity, &o.Display})
+	}
+	for _, o := range circles {
+		els = append(els, element{o.ID, &o.Fill, &o.FillOpacity, &o.Display})
+	}
+	for _, o := range ellipses {
+		els = append(els, element{o.ID, &o.Fill, &o.FillOpacity, &o.Display})
+	}
+	for _, o := range polygons {
+		els = append(els, element{o.ID, &o.Fill, &o.FillOpacity, &o.Display})
+	}
+	return els
+}
+
+func appendGroupElements(els []element, groups []*objGroup) []element {
+	for _, g := range groups {
+		els = append(els, element{g.ID, &g.Fill, &g.FillOpacity, &g.Display})
+		els = appendElements(els, g.Paths, g.Rects, g.Circles, g.Ellipses, g.Polygons)
+		els = appendGroupElements(els, g.Groups)
+	}
+	return els
+}
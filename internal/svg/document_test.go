@@ -0,0 +1,74 @@
+package svg
+
+import (
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDocument = `<?xml version="1.0"?>
+<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24">
+  <rect id="background" fill="#ffffff" x="0" y="0" width="24" height="24"/>
+  <g id="badge">
+    <circle id="dot" fill="#ff0000" cx="18" cy="6" r="4"/>
+  </g>
+</svg>`
+
+func TestDocument_SetFillColor(t *testing.T) {
+	doc, err := ParseDocument([]byte(testDocument))
+	require.NoError(t, err)
+
+	assert.True(t, doc.SetFillColor("dot", color.NRGBA{G: 0xff, A: 0xff}))
+	assert.False(t, doc.SetFillColor("missing", color.Black))
+
+	out, err := doc.Marshal()
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "#00ff00")
+	assert.Contains(t, string(out), "#ffffff") // other elements are untouched
+}
+
+func TestDocument_SetVisible(t *testing.T) {
+	doc, err := ParseDocument([]byte(testDocument))
+	require.NoError(t, err)
+
+	assert.True(t, doc.SetVisible("badge", false))
+	out, err := doc.Marshal()
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `display="none"`)
+
+	assert.True(t, doc.SetVisible("badge", true))
+	out, err = doc.Marshal()
+	require.NoError(t, err)
+	assert.NotContains(t, string(out), `display="none"`)
+}
+
+func TestDocument_SetViewBox(t *testing.T) {
+	doc, err := ParseDocument([]byte(testDocument))
+	require.NoError(t, err)
+	assert.Equal(t, "0 0 24 24", doc.ViewBox())
+
+	doc.SetViewBox(12, 0, 12, 12)
+	assert.Equal(t, "12 0 12 12", doc.ViewBox())
+
+	out, err := doc.Marshal()
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `viewBox="12 0 12 12"`)
+}
+
+func TestDocument_Marshal_Rasterizes(t *testing.T) {
+	doc, err := ParseDocument([]byte(testDocument))
+	require.NoError(t, err)
+	doc.SetFillColor("background", color.Black)
+
+	out, err := doc.Marshal()
+	require.NoError(t, err)
+
+	decoder, err := NewDecoder(strings.NewReader(string(out)))
+	require.NoError(t, err)
+	img, err := decoder.Draw(24, 24)
+	require.NoError(t, err)
+	assert.Equal(t, color.NRGBA{A: 0xff}, img.At(2, 12))
+}
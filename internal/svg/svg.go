@@ -132,6 +132,8 @@ type svg struct {
 
 type pathObj struct {
 	XMLName         xml.Name `xml:"path"`
+	ID              string   `xml:"id,attr,omitempty"`
+	Display         string   `xml:"display,attr,omitempty"`
 	Fill            string   `xml:"fill,attr,omitempty"`
 	FillOpacity     string   `xml:"fill-opacity,attr,omitempty"`
 	Stroke          string   `xml:"stroke,attr,omitempty"`
@@ -144,6 +146,8 @@ type pathObj struct {
 
 type rectObj struct {
 	XMLName         xml.Name `xml:"rect"`
+	ID              string   `xml:"id,attr,omitempty"`
+	Display         string   `xml:"display,attr,omitempty"`
 	Fill            string   `xml:"fill,attr,omitempty"`
 	FillOpacity     string   `xml:"fill-opacity,attr,omitempty"`
 	Stroke          string   `xml:"stroke,attr,omitempty"`
@@ -159,6 +163,8 @@ type rectObj struct {
 
 type circleObj struct {
 	XMLName         xml.Name `xml:"circle"`
+	ID              string   `xml:"id,attr,omitempty"`
+	Display         string   `xml:"display,attr,omitempty"`
 	Fill            string   `xml:"fill,attr,omitempty"`
 	FillOpacity     string   `xml:"fill-opacity,attr,omitempty"`
 	Stroke          string   `xml:"stroke,attr,omitempty"`
@@ -173,6 +179,8 @@ type circleObj struct {
 
 type ellipseObj struct {
 	XMLName         xml.Name `xml:"ellipse"`
+	ID              string   `xml:"id,attr,omitempty"`
+	Display         string   `xml:"display,attr,omitempty"`
 	Fill            string   `xml:"fill,attr,omitempty"`
 	FillOpacity     string   `xml:"fill-opacity,attr,omitempty"`
 	Stroke          string   `xml:"stroke,attr,omitempty"`
@@ -188,6 +196,8 @@ type ellipseObj struct {
 
 type polygonObj struct {
 	XMLName         xml.Name `xml:"polygon"`
+	ID              string   `xml:"id,attr,omitempty"`
+	Display         string   `xml:"display,attr,omitempty"`
 	Fill            string   `xml:"fill,attr,omitempty"`
 	FillOpacity     string   `xml:"fill-opacity,attr,omitempty"`
 	Stroke          string   `xml:"stroke,attr,omitempty"`
@@ -201,7 +211,9 @@ type polygonObj struct {
 type objGroup struct {
 	XMLName         xml.Name      `xml:"g"`
 	ID              string        `xml:"id,attr,omitempty"`
+	Display         string        `xml:"display,attr,omitempty"`
 	Fill            string        `xml:"fill,attr,omitempty"`
+	FillOpacity     string        `xml:"fill-opacity,attr,omitempty"`
 	Stroke          string        `xml:"stroke,attr,omitempty"`
 	StrokeWidth     string        `xml:"stroke-width,attr,omitempty"`
 	StrokeLineCap   string        `xml:"stroke-linecap,attr,omitempty"`
@@ -0,0 +1,45 @@
+package theme
+
+import (
+	"sync"
+
+	"fyne.io/fyne/v2"
+)
+
+var (
+	fontLock      sync.RWMutex
+	fallbackFonts []fyne.Resource
+	scriptFonts   map[string][]fyne.Resource
+)
+
+// AddFallbackFont appends a font resource to the runtime font fallback chain.
+func AddFallbackFont(font fyne.Resource) {
+	fontLock.Lock()
+	defer fontLock.Unlock()
+	fallbackFonts = append(fallbackFonts, font)
+}
+
+// AddScriptFont registers a font resource for runes of the Unicode script with the
+// given ISO 15924 code.
+func AddScriptFont(script string, font fyne.Resource) {
+	fontLock.Lock()
+	defer fontLock.Unlock()
+	if scriptFonts == nil {
+		scriptFonts = make(map[string][]fyne.Resource)
+	}
+	scriptFonts[script] = append(scriptFonts[script], font)
+}
+
+// FallbackFonts returns the font resources registered with AddFallbackFont.
+func FallbackFonts() []fyne.Resource {
+	fontLock.RLock()
+	defer fontLock.RUnlock()
+	return fallbackFonts
+}
+
+// ScriptFonts returns the font resources registered for the given ISO 15924 script code.
+func ScriptFonts(script string) []fyne.Resource {
+	fontLock.RLock()
+	defer fontLock.RUnlock()
+	return scriptFonts[script]
+}
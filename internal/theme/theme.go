@@ -2,10 +2,42 @@ package theme
 
 import (
 	"image/color"
+	"sync/atomic"
+	"time"
 
 	"fyne.io/fyne/v2"
 )
 
+// systemAccent is the accent color reported by the OS, when one is known.
+var systemAccent atomic.Value // color.Color
+
+// SetSystemAccentColor records the accent color reported by the OS, used by
+// the built-in themes to resolve the accent color name.
+func SetSystemAccentColor(c color.Color) {
+	systemAccent.Store(c)
+}
+
+// SystemAccentColor returns the accent color reported by the OS and whether
+// one has been detected.
+func SystemAccentColor() (color.Color, bool) {
+	c, ok := systemAccent.Load().(color.Color)
+	return c, ok
+}
+
+// transitionDuration is how long animated theme transitions take, 0 meaning instant.
+var transitionDuration atomic.Int64
+
+// SetTransitionDuration records how long animated theme transitions should take.
+func SetTransitionDuration(d time.Duration) {
+	transitionDuration.Store(int64(d))
+}
+
+// TransitionDuration returns how long animated theme transitions should take,
+// 0 meaning the theme is applied instantly.
+func TransitionDuration() time.Duration {
+	return time.Duration(transitionDuration.Load())
+}
+
 // Primary color names.
 const (
 	ColorBlue   = "blue"
@@ -23,8 +55,14 @@ const (
 	VariantDark fyne.ThemeVariant = iota
 	VariantLight
 	VariantNameUserPreference // locally used in builtinTheme for backward compatibility
+	VariantHighContrastDark
+	VariantHighContrastLight
 )
 
+// VariantUserDefined is the first variant value available to applications that
+// define their own theme variants, so they cannot collide with built-in ones.
+const VariantUserDefined fyne.ThemeVariant = 100
+
 var (
 	colorLightOnPrimaryBlue   = color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
 	colorLightOnPrimaryBrown  = color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
@@ -1,6 +1,8 @@
 package widget
 
 import (
+	"time"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/driver/desktop"
@@ -25,6 +27,26 @@ const (
 	ScrollNone
 )
 
+// ScrollBarVisibility describes when the bars of a Scroll are shown.
+//
+// Since: 2.6
+type ScrollBarVisibility int
+
+const (
+	// ScrollBarVisibilityAuto shows a bar only while its content overflows.
+	//
+	// Since: 2.6
+	ScrollBarVisibilityAuto ScrollBarVisibility = iota
+	// ScrollBarVisibilityAlways keeps the bars visible even when nothing overflows.
+	//
+	// Since: 2.6
+	ScrollBarVisibilityAlways
+	// ScrollBarVisibilityNever hides the bars, leaving input as the only way to scroll.
+	//
+	// Since: 2.6
+	ScrollBarVisibilityNever
+)
+
 type scrollBarOrientation int
 
 // We default to vertical as 0 due to that being the original orientation offered
@@ -32,6 +54,10 @@ const (
 	scrollBarOrientationVertical   scrollBarOrientation = 0
 	scrollBarOrientationHorizontal scrollBarOrientation = 1
 	scrollContainerMinSize                              = float32(32) // TODO consider the smallest useful scroll view?
+
+	scrollFlingTime      = float32(0.2) // seconds of travel at the release velocity for a kinetic fling
+	scrollFlingThreshold = float32(100) // minimum release velocity to start a fling, px/sec
+	scrollMaxOverscroll  = float32(48)  // how far the content can be pulled past its edge
 )
 
 type scrollBarRenderer struct {
@@ -337,7 +363,11 @@ func (r *scrollContainerRenderer) Refresh() {
 }
 
 func (r *scrollContainerRenderer) handleAreaVisibility(contentSize, scrollSize float32, area *scrollBarArea) {
-	if contentSize <= scrollSize {
+	if r.scroll.BarVisibility == ScrollBarVisibilityNever {
+		area.Hide()
+		return
+	}
+	if contentSize <= scrollSize && r.scroll.BarVisibility != ScrollBarVisibilityAlways {
 		area.Hide()
 	} else if r.scroll.Visible() {
 		area.Show()
@@ -360,6 +390,26 @@ func (r *scrollContainerRenderer) handleShadowVisibility(offset, contentSize, sc
 	}
 }
 
+// updateRegions repositions any sticky or parallax regions found directly
+// inside the scrolled container to match the current offset.
+func (r *scrollContainerRenderer) updateRegions() {
+	cont, ok := r.scroll.Content.(*fyne.Container)
+	if !ok {
+		return
+	}
+
+	off := r.scroll.Offset
+	for _, o := range cont.Objects {
+		switch region := o.(type) {
+		case *StickyRegion:
+			pos := region.Position()
+			region.setScrollShift(fyne.NewPos(fyne.Max(off.X-pos.X, 0), fyne.Max(off.Y-pos.Y, 0)))
+		case *ParallaxRegion:
+			region.setScrollShift(fyne.NewPos(off.X*(1-region.Rate), off.Y*(1-region.Rate)))
+		}
+	}
+}
+
 func (r *scrollContainerRenderer) updatePosition() {
 	if r.scroll.Content == nil {
 		return
@@ -367,7 +417,8 @@ func (r *scrollContainerRenderer) updatePosition() {
 	scrollSize := r.scroll.size.Load()
 	contentSize := r.scroll.Content.Size()
 
-	r.scroll.Content.Move(fyne.NewPos(-r.scroll.Offset.X, -r.scroll.Offset.Y))
+	r.scroll.Content.Move(fyne.NewPos(-r.scroll.Offset.X+r.scroll.overscroll.DX, -r.scroll.Offset.Y+r.scroll.overscroll.DY))
+	r.updateRegions()
 
 	if r.scroll.Direction == ScrollVerticalOnly || r.scroll.Direction == ScrollBoth {
 		r.handleAreaVisibility(contentSize.Height, scrollSize.Height, r.vertArea)
@@ -403,11 +454,21 @@ type Scroll struct {
 	Direction ScrollDirection
 	Content   fyne.CanvasObject
 	Offset    fyne.Position
+	// BarVisibility overrides when the scroll bars are shown,
+	// by default they appear only while their content overflows.
+	//
+	// Since: 2.6
+	BarVisibility ScrollBarVisibility
 	// OnScrolled can be set to be notified when the Scroll has changed position.
 	// You should not update the Scroll.Offset from this method.
 	//
 	// Since: 2.0
 	OnScrolled func(fyne.Position) `json:"-"`
+
+	dragVelocity fyne.Delta
+	lastDragged  time.Time
+	overscroll   fyne.Delta
+	scrollAnim   *fyne.Animation
 }
 
 // CreateRenderer is a private method to Fyne which links this widget to its renderer
@@ -440,8 +501,67 @@ func (s *Scroll) ScrollToTop() {
 	s.scrollBy(0, -s.Offset.Y)
 }
 
-// DragEnd will stop scrolling on mobile has stopped
+// ScrollToOffset moves the view to the given offset within the content,
+// clamped to the content bounds.
+//
+// Since: 2.6
+func (s *Scroll) ScrollToOffset(offset fyne.Position) {
+	if s.updateOffset(s.Offset.X-offset.X, s.Offset.Y-offset.Y) {
+		s.refreshWithoutOffsetUpdate()
+	}
+}
+
+// ScrollToOffsetAnimated scrolls smoothly to the given offset within the content.
+//
+// Since: 2.6
+func (s *Scroll) ScrollToOffsetAnimated(offset fyne.Position) {
+	s.stopAnimation()
+	s.scrollAnim = canvas.NewPositionAnimation(s.Offset, offset, canvas.DurationStandard, s.ScrollToOffset)
+	s.scrollAnim.Curve = fyne.AnimationEaseInOut
+	s.scrollAnim.Start()
+}
+
+// ScrollToObject scrolls the smallest distance that brings the given object
+// fully into view. The object must be rendered within the content of this container.
+//
+// Since: 2.6
+func (s *Scroll) ScrollToObject(obj fyne.CanvasObject) {
+	s.ScrollToOffset(s.offsetForObject(obj))
+}
+
+// ScrollToObjectAnimated scrolls smoothly until the given object is fully in view.
+// The object must be rendered within the content of this container.
+//
+// Since: 2.6
+func (s *Scroll) ScrollToObjectAnimated(obj fyne.CanvasObject) {
+	s.ScrollToOffsetAnimated(s.offsetForObject(obj))
+}
+
+// DragEnd releases any rubber-band stretch and continues a fast touch scroll
+// with momentum.
 func (s *Scroll) DragEnd() {
+	if !fyne.CurrentDevice().IsMobile() {
+		return
+	}
+
+	if s.overscroll.DX != 0 || s.overscroll.DY != 0 {
+		start := fyne.NewPos(s.overscroll.DX, s.overscroll.DY)
+		s.scrollAnim = canvas.NewPositionAnimation(start, fyne.NewPos(0, 0), canvas.DurationShort, func(p fyne.Position) {
+			s.overscroll = fyne.Delta{DX: p.X, DY: p.Y}
+			s.refreshWithoutOffsetUpdate()
+		})
+		s.scrollAnim.Curve = fyne.AnimationEaseOut
+		s.scrollAnim.Start()
+	} else if s.flingSpeed() > scrollFlingThreshold {
+		target := fyne.NewPos(
+			s.Offset.X-s.dragVelocity.DX*scrollFlingTime,
+			s.Offset.Y-s.dragVelocity.DY*scrollFlingTime)
+		s.scrollAnim = canvas.NewPositionAnimation(s.Offset, target, canvas.DurationStandard, s.ScrollToOffset)
+		s.scrollAnim.Curve = fyne.AnimationEaseOut
+		s.scrollAnim.Start()
+	}
+	s.dragVelocity = fyne.Delta{}
+	s.lastDragged = time.Time{} // a velocity needs two events within the same gesture
 }
 
 // Dragged will scroll on any drag - bar or otherwise - for mobile
@@ -450,11 +570,77 @@ func (s *Scroll) Dragged(e *fyne.DragEvent) {
 		return
 	}
 
-	if s.updateOffset(e.Dragged.DX, e.Dragged.DY) {
+	s.stopAnimation()
+	now := time.Now()
+	if dt := float32(now.Sub(s.lastDragged).Seconds()); dt > 0 && dt < 0.2 {
+		s.dragVelocity = fyne.Delta{DX: e.Dragged.DX / dt, DY: e.Dragged.DY / dt}
+	}
+	s.lastDragged = now
+
+	old := s.Offset
+	moved := s.updateOffset(e.Dragged.DX, e.Dragged.DY)
+	s.applyOverscroll(e.Dragged.DX-(old.X-s.Offset.X), e.Dragged.DY-(old.Y-s.Offset.Y))
+	if moved || s.overscroll.DX != 0 || s.overscroll.DY != 0 {
 		s.refreshWithoutOffsetUpdate()
 	}
 }
 
+// applyOverscroll stretches a touch drag beyond the content edge with
+// resistance, so the scroll rubber-bands instead of stopping dead.
+func (s *Scroll) applyOverscroll(leftoverX, leftoverY float32) {
+	clamp := func(v float32) float32 {
+		if v > scrollMaxOverscroll {
+			return scrollMaxOverscroll
+		}
+		return fyne.Max(v, -scrollMaxOverscroll)
+	}
+	if s.Direction == ScrollHorizontalOnly || s.Direction == ScrollBoth {
+		s.overscroll.DX = clamp(s.overscroll.DX + leftoverX/2)
+	}
+	if s.Direction == ScrollVerticalOnly || s.Direction == ScrollBoth {
+		s.overscroll.DY = clamp(s.overscroll.DY + leftoverY/2)
+	}
+}
+
+func (s *Scroll) flingSpeed() float32 {
+	if time.Since(s.lastDragged) > time.Millisecond*100 {
+		return 0 // the drag paused before release
+	}
+	return fyne.Max(fyne.Max(s.dragVelocity.DX, -s.dragVelocity.DX),
+		fyne.Max(s.dragVelocity.DY, -s.dragVelocity.DY))
+}
+
+// offsetForObject returns the closest offset that shows the given object in full.
+func (s *Scroll) offsetForObject(obj fyne.CanvasObject) fyne.Position {
+	pos := obj.Position()
+	d := fyne.CurrentApp().Driver()
+	if d.CanvasForObject(obj) != nil && d.CanvasForObject(s.Content) != nil {
+		pos = d.AbsolutePositionForObject(obj).Subtract(d.AbsolutePositionForObject(s.Content))
+	}
+
+	off := s.Offset
+	size := s.Size()
+	objSize := obj.Size()
+	if pos.X < off.X {
+		off.X = pos.X
+	} else if pos.X+objSize.Width > off.X+size.Width {
+		off.X = pos.X + objSize.Width - size.Width
+	}
+	if pos.Y < off.Y {
+		off.Y = pos.Y
+	} else if pos.Y+objSize.Height > off.Y+size.Height {
+		off.Y = pos.Y + objSize.Height - size.Height
+	}
+	return off
+}
+
+func (s *Scroll) stopAnimation() {
+	if s.scrollAnim != nil {
+		s.scrollAnim.Stop()
+		s.scrollAnim = nil
+	}
+}
+
 // MinSize returns the smallest size this widget can shrink to
 func (s *Scroll) MinSize() fyne.Size {
 	min := fyne.NewSize(scrollContainerMinSize, scrollContainerMinSize).Max(s.minSize)
@@ -63,6 +63,40 @@ func TestScrollContainer_ScrollToBottom(t *testing.T) {
 	assert.Equal(t, ExpectedY, Y)
 }
 
+func TestScrollContainer_ScrollToOffset(t *testing.T) {
+	rect := canvas.NewRectangle(color.Black)
+	rect.SetMinSize(fyne.NewSize(500, 500))
+	scroll := NewScroll(rect)
+	scroll.Resize(fyne.NewSize(100, 100))
+
+	scroll.ScrollToOffset(fyne.NewPos(50, 60))
+	assert.Equal(t, fyne.NewPos(50, 60), scroll.Offset)
+
+	// offsets are clamped to the content bounds
+	scroll.ScrollToOffset(fyne.NewPos(1000, -10))
+	assert.Equal(t, fyne.NewPos(400, 0), scroll.Offset)
+}
+
+func TestScrollContainer_BarVisibility(t *testing.T) {
+	rect := canvas.NewRectangle(color.Black)
+	rect.SetMinSize(fyne.NewSize(100, 100))
+	scroll := NewScroll(rect)
+	scroll.Resize(fyne.NewSize(200, 200))
+	r := cache.Renderer(scroll).(*scrollContainerRenderer)
+	require.False(t, r.vertArea.Visible())
+
+	scroll.BarVisibility = ScrollBarVisibilityAlways
+	scroll.Refresh()
+	assert.True(t, r.vertArea.Visible())
+	assert.True(t, r.horizArea.Visible())
+
+	rect.SetMinSize(fyne.NewSize(300, 300))
+	scroll.BarVisibility = ScrollBarVisibilityNever
+	scroll.Resize(fyne.NewSize(100, 100))
+	assert.False(t, r.vertArea.Visible())
+	assert.False(t, r.horizArea.Visible())
+}
+
 func TestScrollContainer_MinSize_Direction(t *testing.T) {
 	t.Run("Both", func(t *testing.T) {
 		rect := canvas.NewRectangle(color.Black)
@@ -10,7 +10,9 @@ import (
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/internal/widget"
 	"fyne.io/fyne/v2/test"
+
 	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestScrollContainer_Theme(t *testing.T) {
@@ -30,6 +32,75 @@ func TestScrollContainer_Theme(t *testing.T) {
 	})
 }
 
+func TestScrollContainer_ScrollToObject(t *testing.T) {
+	rect1 := canvas.NewRectangle(color.Black)
+	rect1.SetMinSize(fyne.NewSize(100, 100))
+	rect2 := canvas.NewRectangle(color.Black)
+	rect2.SetMinSize(fyne.NewSize(100, 100))
+	rect3 := canvas.NewRectangle(color.Black)
+	rect3.SetMinSize(fyne.NewSize(100, 100))
+	content := &fyne.Container{Layout: manualLayout{}, Objects: []fyne.CanvasObject{rect1, rect2, rect3}}
+	scroll := widget.NewScroll(content)
+
+	w := test.NewTempWindow(t, scroll)
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(100, 100))
+	rect2.Move(fyne.NewPos(0, 100))
+	rect3.Move(fyne.NewPos(0, 200))
+
+	scroll.ScrollToObject(rect3)
+	assert.Equal(t, 300-scroll.Size().Height, scroll.Offset.Y)
+
+	scroll.ScrollToObject(rect1)
+	assert.Equal(t, float32(0), scroll.Offset.Y)
+}
+
+func TestScrollContainer_StickyRegion(t *testing.T) {
+	header := canvas.NewRectangle(color.Black)
+	header.SetMinSize(fyne.NewSize(100, 40))
+	body := canvas.NewRectangle(color.Black)
+	body.SetMinSize(fyne.NewSize(100, 500))
+	sticky := widget.NewStickyRegion(header)
+	scroll := widget.NewVScroll(container.NewVBox(sticky, body))
+
+	w := test.NewTempWindow(t, scroll)
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(100, 100))
+
+	scroll.ScrollToOffset(fyne.NewPos(0, 200))
+	assert.Equal(t, float32(200), sticky.Content.Position().Y-sticky.Position().Y)
+
+	scroll.ScrollToOffset(fyne.NewPos(0, 0))
+	assert.Equal(t, float32(0), sticky.Content.Position().Y-sticky.Position().Y)
+}
+
+func TestScrollContainer_ParallaxRegion(t *testing.T) {
+	backdrop := canvas.NewRectangle(color.Black)
+	backdrop.SetMinSize(fyne.NewSize(100, 150))
+	body := canvas.NewRectangle(color.Black)
+	body.SetMinSize(fyne.NewSize(100, 500))
+	parallax := widget.NewParallaxRegion(backdrop, 0.5)
+	scroll := widget.NewVScroll(container.NewVBox(parallax, body))
+
+	w := test.NewTempWindow(t, scroll)
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(100, 100))
+
+	scroll.ScrollToOffset(fyne.NewPos(0, 100))
+	assert.Equal(t, float32(50), parallax.Content.Position().Y)
+}
+
+// manualLayout keeps the positions given to the objects, for testing scroll targets.
+type manualLayout struct{}
+
+func (manualLayout) Layout(objs []fyne.CanvasObject, _ fyne.Size) {
+	for _, o := range objs {
+		o.Resize(o.MinSize())
+	}
+}
+
+func (manualLayout) MinSize([]fyne.CanvasObject) fyne.Size { return fyne.NewSize(100, 300) }
+
 func TestScrollContainer_ThemeOverride(t *testing.T) {
 	rect := canvas.NewRectangle(color.Transparent)
 	rect.SetMinSize(fyne.NewSize(250, 250))
@@ -0,0 +1,126 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+var _ fyne.Widget = (*StickyRegion)(nil)
+var _ fyne.Widget = (*ParallaxRegion)(nil)
+
+// StickyRegion wraps a fyne.CanvasObject placed directly inside the content of
+// a Scroll so it pins to the leading edge of the view instead of scrolling out,
+// floating over the items that follow it.
+//
+// Since: 2.6
+type StickyRegion struct {
+	Base
+	Content fyne.CanvasObject
+
+	shift fyne.Position
+}
+
+// NewStickyRegion creates a StickyRegion wrapping the given object.
+//
+// Since: 2.6
+func NewStickyRegion(content fyne.CanvasObject) *StickyRegion {
+	s := &StickyRegion{Content: content}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// CreateRenderer returns a new renderer for the sticky region.
+//
+// Implements: fyne.Widget
+func (s *StickyRegion) CreateRenderer() fyne.WidgetRenderer {
+	return &scrollRegionRenderer{BaseRenderer{[]fyne.CanvasObject{s.Content}}, s.Content, &s.shift}
+}
+
+// MinSize returns the smallest size this widget can shrink to.
+//
+// Implements: fyne.Widget
+func (s *StickyRegion) MinSize() fyne.Size {
+	return s.Content.MinSize()
+}
+
+// setScrollShift is called by the containing Scroll to keep the content pinned
+// while the region’s own position scrolls away.
+func (s *StickyRegion) setScrollShift(shift fyne.Position) {
+	if shift == s.shift {
+		return
+	}
+
+	s.shift = shift
+	s.Content.Move(shift)
+}
+
+// ParallaxRegion wraps a fyne.CanvasObject placed directly inside the content
+// of a Scroll so it moves at a fraction of the scrolling rate, giving a depth
+// effect for backgrounds and collapsing app bars.
+//
+// Since: 2.6
+type ParallaxRegion struct {
+	Base
+	Content fyne.CanvasObject
+	// Rate is how fast the content moves relative to the scroll,
+	// 1.0 scrolls normally and 0.0 stays fixed in the view.
+	Rate float32
+
+	shift fyne.Position
+}
+
+// NewParallaxRegion creates a ParallaxRegion wrapping the given object,
+// scrolling at the given fraction of the normal rate.
+//
+// Since: 2.6
+func NewParallaxRegion(content fyne.CanvasObject, rate float32) *ParallaxRegion {
+	p := &ParallaxRegion{Content: content, Rate: rate}
+	p.ExtendBaseWidget(p)
+	return p
+}
+
+// CreateRenderer returns a new renderer for the parallax region.
+//
+// Implements: fyne.Widget
+func (p *ParallaxRegion) CreateRenderer() fyne.WidgetRenderer {
+	return &scrollRegionRenderer{BaseRenderer{[]fyne.CanvasObject{p.Content}}, p.Content, &p.shift}
+}
+
+// MinSize returns the smallest size this widget can shrink to.
+//
+// Implements: fyne.Widget
+func (p *ParallaxRegion) MinSize() fyne.Size {
+	return p.Content.MinSize()
+}
+
+// setScrollShift is called by the containing Scroll to slide the content
+// against the scrolling direction, slowing its apparent movement.
+func (p *ParallaxRegion) setScrollShift(shift fyne.Position) {
+	if shift == p.shift {
+		return
+	}
+
+	p.shift = shift
+	p.Content.Move(shift)
+}
+
+type scrollRegionRenderer struct {
+	BaseRenderer
+	content fyne.CanvasObject
+	shift   *fyne.Position
+}
+
+var _ fyne.WidgetRenderer = (*scrollRegionRenderer)(nil)
+
+func (r *scrollRegionRenderer) Layout(size fyne.Size) {
+	r.content.Resize(size)
+	r.content.Move(*r.shift)
+}
+
+func (r *scrollRegionRenderer) MinSize() fyne.Size {
+	return r.content.MinSize()
+}
+
+func (r *scrollRegionRenderer) Refresh() {
+	canvas.Refresh(r.content)
+}
@@ -0,0 +1,58 @@
+package layout
+
+import "fyne.io/fyne/v2"
+
+// Declare conformity with Layout interface
+var _ fyne.Layout = (*aspectRatioLayout)(nil)
+
+type aspectRatioLayout struct {
+	ratio float32
+}
+
+// NewAspectRatioLayout returns a layout that sizes its children to the largest
+// area of the given width to height ratio that fits the available space,
+// centered so any spare space letterboxes the content. This suits video
+// surfaces, camera previews and thumbnails that must not distort.
+//
+// Since: 2.6
+func NewAspectRatioLayout(ratio float32) fyne.Layout {
+	return &aspectRatioLayout{ratio: ratio}
+}
+
+// Layout is called to pack all child objects into a specified size.
+// For an aspect ratio layout all children fill the largest rectangle of the
+// requested ratio that fits, centered in the remaining space.
+func (a *aspectRatioLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	if a.ratio <= 0 || size.Width <= 0 || size.Height <= 0 {
+		return
+	}
+
+	fit := fyne.NewSize(size.Width, size.Width/a.ratio)
+	if fit.Height > size.Height {
+		fit = fyne.NewSize(size.Height*a.ratio, size.Height)
+	}
+
+	pos := fyne.NewPos((size.Width-fit.Width)/2, (size.Height-fit.Height)/2)
+	for _, child := range objects {
+		child.Move(pos)
+		child.Resize(fit)
+	}
+}
+
+// MinSize finds the smallest size that satisfies all the child objects.
+// This is the largest child minimum grown to meet the required ratio.
+func (a *aspectRatioLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	minSize := fyne.NewSize(0, 0)
+	for _, child := range objects {
+		if !child.Visible() {
+			continue
+		}
+
+		minSize = minSize.Max(child.MinSize())
+	}
+
+	if a.ratio <= 0 {
+		return minSize
+	}
+	return minSize.Max(fyne.NewSize(minSize.Height*a.ratio, minSize.Width/a.ratio))
+}
@@ -0,0 +1,35 @@
+package layout_test
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/layout"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAspectRatioLayout_Layout(t *testing.T) {
+	obj := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+
+	layout.NewAspectRatioLayout(2).Layout([]fyne.CanvasObject{obj}, fyne.NewSize(200, 200))
+	assert.Equal(t, fyne.NewSize(200, 100), obj.Size())
+	assert.Equal(t, fyne.NewPos(0, 50), obj.Position())
+
+	layout.NewAspectRatioLayout(2).Layout([]fyne.CanvasObject{obj}, fyne.NewSize(500, 100))
+	assert.Equal(t, fyne.NewSize(200, 100), obj.Size())
+	assert.Equal(t, fyne.NewPos(150, 0), obj.Position())
+}
+
+func TestAspectRatioLayout_MinSize(t *testing.T) {
+	obj := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	obj.SetMinSize(fyne.NewSize(50, 50))
+
+	min := layout.NewAspectRatioLayout(2).MinSize([]fyne.CanvasObject{obj})
+	assert.Equal(t, fyne.NewSize(100, 50), min)
+
+	min = layout.NewAspectRatioLayout(0.5).MinSize([]fyne.CanvasObject{obj})
+	assert.Equal(t, fyne.NewSize(50, 100), min)
+}
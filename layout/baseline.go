@@ -0,0 +1,28 @@
+package layout
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// BaselineObject is any object that reports the position of its first text
+// baseline, measured down from the top of the object at its minimum size.
+// Layouts that align on baselines fall back to the bottom edge of objects
+// that do not implement this interface.
+//
+// Since: 2.6
+type BaselineObject interface {
+	Baseline() float32
+}
+
+// baselineOf finds the first text baseline of an object, measured from its top edge.
+func baselineOf(obj fyne.CanvasObject) float32 {
+	if base, ok := obj.(BaselineObject); ok {
+		return base.Baseline()
+	}
+	if text, ok := obj.(*canvas.Text); ok {
+		_, base := fyne.CurrentApp().Driver().RenderedTextSize(text.Text, text.TextSize, text.TextStyle, text.FontSource)
+		return base
+	}
+	return obj.MinSize().Height
+}
@@ -24,6 +24,11 @@ func NewBorderLayout(top, bottom, left, right fyne.CanvasObject) fyne.Layout {
 // the sides and any remaining widgets are maximised in the middle space.
 func (b *borderLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
 	padding := theme.Padding()
+	left, right := b.left, b.right
+	if isRightToLeft() { // the leading and trailing edges swap sides
+		left, right = right, left
+	}
+
 	var topSize, bottomSize, leftSize, rightSize fyne.Size
 	if b.top != nil && b.top.Visible() {
 		topHeight := b.top.MinSize().Height
@@ -37,16 +42,16 @@ func (b *borderLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
 		b.bottom.Move(fyne.NewPos(0, size.Height-bottomHeight))
 		bottomSize = fyne.NewSize(size.Width, bottomHeight+padding)
 	}
-	if b.left != nil && b.left.Visible() {
-		leftWidth := b.left.MinSize().Width
-		b.left.Resize(fyne.NewSize(leftWidth, size.Height-topSize.Height-bottomSize.Height))
-		b.left.Move(fyne.NewPos(0, topSize.Height))
+	if left != nil && left.Visible() {
+		leftWidth := left.MinSize().Width
+		left.Resize(fyne.NewSize(leftWidth, size.Height-topSize.Height-bottomSize.Height))
+		left.Move(fyne.NewPos(0, topSize.Height))
 		leftSize = fyne.NewSize(leftWidth+padding, size.Height-topSize.Height-bottomSize.Height)
 	}
-	if b.right != nil && b.right.Visible() {
-		rightWidth := b.right.MinSize().Width
-		b.right.Resize(fyne.NewSize(rightWidth, size.Height-topSize.Height-bottomSize.Height))
-		b.right.Move(fyne.NewPos(size.Width-rightWidth, topSize.Height))
+	if right != nil && right.Visible() {
+		rightWidth := right.MinSize().Width
+		right.Resize(fyne.NewSize(rightWidth, size.Height-topSize.Height-bottomSize.Height))
+		right.Move(fyne.NewPos(size.Width-rightWidth, topSize.Height))
 		rightSize = fyne.NewSize(rightWidth+padding, size.Height-topSize.Height-bottomSize.Height)
 	}
 
@@ -170,3 +170,20 @@ func TestBorderLayout_MinSize_LeftOnly(t *testing.T) {
 
 	assert.Equal(t, minSize, layoutMin)
 }
+
+func TestBorderLayout_RightToLeft(t *testing.T) {
+	fyne.SetLayoutDirection(fyne.LayoutDirectionRightToLeft)
+	defer fyne.SetLayoutDirection(fyne.LayoutDirectionAuto)
+
+	size := fyne.NewSize(100, 100)
+	obj1 := NewMinSizeRect(fyne.NewSize(50, 50))
+	obj2 := NewMinSizeRect(fyne.NewSize(50, 50))
+
+	container := container.NewWithoutLayout(obj1, obj2)
+	container.Resize(size)
+
+	layout.NewBorderLayout(nil, nil, obj1, obj2).Layout(container.Objects, size)
+
+	assert.Equal(t, fyne.NewPos(50, 0), obj1.Position())
+	assert.Equal(t, fyne.NewPos(0, 0), obj2.Position())
+}
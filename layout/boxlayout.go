@@ -45,6 +45,18 @@ func NewCustomPaddedVBoxLayout(padding float32) fyne.Layout {
 	}
 }
 
+// NewBaselineHBoxLayout returns a horizontal box layout that keeps items at
+// their minimum size and aligns them on their first text baseline, instead of
+// stretching them to the full height of the container.
+//
+// Since: 2.6
+func NewBaselineHBoxLayout() fyne.Layout {
+	return hBoxLayout{
+		paddingFunc: theme.Padding,
+		baseline:    true,
+	}
+}
+
 // Declare conformity with Layout interface
 var _ fyne.Layout = (*vBoxLayout)(nil)
 
@@ -133,6 +145,7 @@ var _ fyne.Layout = (*hBoxLayout)(nil)
 
 type hBoxLayout struct {
 	paddingFunc func() float32
+	baseline    bool
 }
 
 // Layout is called to pack all child objects into a specified size.
@@ -144,6 +157,7 @@ func (g hBoxLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
 	visibleObjects := 0
 	// Size taken up by visible objects
 	total := float32(0)
+	maxBaseline := float32(0)
 
 	for _, child := range objects {
 		if !child.Visible() {
@@ -157,6 +171,9 @@ func (g hBoxLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
 
 		visibleObjects++
 		total += child.MinSize().Width
+		if g.baseline {
+			maxBaseline = fyne.Max(maxBaseline, baselineOf(child))
+		}
 	}
 
 	padding := g.paddingFunc()
@@ -170,6 +187,7 @@ func (g hBoxLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
 		spacerSize = extra / float32(spacers)
 	}
 
+	rtl := isRightToLeft()
 	x, y := float32(0), float32(0)
 	for _, child := range objects {
 		if !child.Visible() {
@@ -180,11 +198,21 @@ func (g hBoxLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
 			x += spacerSize
 			continue
 		}
-		child.Move(fyne.NewPos(x, y))
 
-		width := child.MinSize().Width
-		x += padding + width
-		child.Resize(fyne.NewSize(width, size.Height))
+		childMin := child.MinSize()
+		height := size.Height
+		if g.baseline {
+			y = maxBaseline - baselineOf(child)
+			height = childMin.Height
+		}
+		if rtl {
+			child.Move(fyne.NewPos(size.Width-x-childMin.Width, y))
+		} else {
+			child.Move(fyne.NewPos(x, y))
+		}
+
+		x += padding + childMin.Width
+		child.Resize(fyne.NewSize(childMin.Width, height))
 	}
 }
 
@@ -195,6 +223,7 @@ func (g hBoxLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
 	minSize := fyne.NewSize(0, 0)
 	addPadding := false
 	padding := g.paddingFunc()
+	maxBaseline, maxBelow := float32(0), float32(0)
 	for _, child := range objects {
 		if !child.Visible() || isHorizontalSpacer(child) {
 			continue
@@ -207,6 +236,16 @@ func (g hBoxLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
 			minSize.Width += padding
 		}
 		addPadding = true
+
+		if g.baseline {
+			base := baselineOf(child)
+			maxBaseline = fyne.Max(maxBaseline, base)
+			maxBelow = fyne.Max(maxBelow, childMin.Height-base)
+		}
+	}
+
+	if g.baseline {
+		minSize.Height = fyne.Max(minSize.Height, maxBaseline+maxBelow)
 	}
 	return minSize
 }
@@ -220,3 +259,7 @@ func isHorizontalSpacer(obj fyne.CanvasObject) bool {
 	spacer, ok := obj.(SpacerObject)
 	return ok && spacer.ExpandHorizontal()
 }
+
+func isRightToLeft() bool {
+	return fyne.CurrentLayoutDirection() == fyne.LayoutDirectionRightToLeft
+}
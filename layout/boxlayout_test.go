@@ -320,3 +320,41 @@ func TestHBoxLayout_MiddleSpacerHiddenItem(t *testing.T) {
 	container.Refresh()
 	assert.Equal(t, fyne.NewPos(300, 0), obj3.Position())
 }
+
+type baselineObject struct {
+	*canvas.Rectangle
+	base float32
+}
+
+func (b *baselineObject) Baseline() float32 {
+	return b.base
+}
+
+func TestHBoxLayout_Baseline(t *testing.T) {
+	obj1 := &baselineObject{Rectangle: NewMinSizeRect(fyne.NewSize(50, 50)), base: 40}
+	obj2 := &baselineObject{Rectangle: NewMinSizeRect(fyne.NewSize(50, 30)), base: 20}
+
+	lay := layout.NewBaselineHBoxLayout()
+	lay.Layout([]fyne.CanvasObject{obj1, obj2}, fyne.NewSize(200, 60))
+
+	assert.Equal(t, fyne.NewPos(0, 0), obj1.Position())
+	assert.Equal(t, fyne.NewSize(50, 50), obj1.Size())
+	assert.Equal(t, fyne.NewPos(50+theme.Padding(), 20), obj2.Position())
+	assert.Equal(t, fyne.NewSize(50, 30), obj2.Size())
+
+	min := lay.MinSize([]fyne.CanvasObject{obj1, obj2})
+	assert.Equal(t, fyne.NewSize(100+theme.Padding(), 50), min)
+}
+
+func TestHBoxLayout_RightToLeft(t *testing.T) {
+	fyne.SetLayoutDirection(fyne.LayoutDirectionRightToLeft)
+	defer fyne.SetLayoutDirection(fyne.LayoutDirectionAuto)
+
+	obj1 := NewMinSizeRect(fyne.NewSize(50, 50))
+	obj2 := NewMinSizeRect(fyne.NewSize(50, 50))
+
+	layout.NewHBoxLayout().Layout([]fyne.CanvasObject{obj1, obj2}, fyne.NewSize(200, 50))
+
+	assert.Equal(t, fyne.NewPos(150, 0), obj1.Position())
+	assert.Equal(t, fyne.NewPos(100-theme.Padding(), 0), obj2.Position())
+}
@@ -0,0 +1,238 @@
+package layout
+
+import (
+	"errors"
+
+	"fyne.io/fyne/v2"
+)
+
+// Declare conformity with Layout interface
+var _ fyne.Layout = (*ConstraintLayout)(nil)
+
+// ConstraintEdge identifies one side of an object, a guideline or the parent
+// container that a constraint can attach to.
+//
+// Since: 2.6
+type ConstraintEdge int
+
+const (
+	// ConstraintLeading is the left edge of an object or the container.
+	//
+	// Since: 2.6
+	ConstraintLeading ConstraintEdge = iota
+	// ConstraintTrailing is the right edge of an object or the container.
+	//
+	// Since: 2.6
+	ConstraintTrailing
+	// ConstraintTop is the top edge of an object or the container.
+	//
+	// Since: 2.6
+	ConstraintTop
+	// ConstraintBottom is the bottom edge of an object or the container.
+	//
+	// Since: 2.6
+	ConstraintBottom
+)
+
+// Guideline is a virtual line at a fraction of the container size that objects
+// can attach to without introducing an invisible spacer object.
+//
+// Since: 2.6
+type Guideline struct {
+	horizontal bool
+	fraction   float32
+}
+
+// ConstraintLayout positions each object by attaching its edges to the parent
+// container, to sibling objects or to guidelines. An edge with no constraint is
+// derived from the opposite edge and the object minimum size, so deep nesting
+// of box layouts can be replaced by a single flat container. Each edge is
+// solved at most once per layout pass.
+//
+// Since: 2.6
+type ConstraintLayout struct {
+	constraints map[fyne.CanvasObject]map[ConstraintEdge]constraint
+}
+
+type constraint struct {
+	target     fyne.CanvasObject // nil attaches to the parent container or a guideline
+	guide      *Guideline
+	targetEdge ConstraintEdge
+	offset     float32
+}
+
+// NewConstraintLayout returns a layout that positions objects using the
+// constraints registered with Attach, AttachToParent and AttachToGuideline.
+//
+// Since: 2.6
+func NewConstraintLayout() *ConstraintLayout {
+	return &ConstraintLayout{constraints: make(map[fyne.CanvasObject]map[ConstraintEdge]constraint)}
+}
+
+// Attach pins an edge of obj at the given offset from an edge of a sibling
+// object in the same container. The offset is added along the axis, so a
+// negative value moves toward the leading or top edge.
+//
+// Since: 2.6
+func (c *ConstraintLayout) Attach(obj fyne.CanvasObject, edge ConstraintEdge, sibling fyne.CanvasObject, siblingEdge ConstraintEdge, offset float32) {
+	c.set(obj, edge, constraint{target: sibling, targetEdge: siblingEdge, offset: offset})
+}
+
+// AttachToParent pins an edge of obj at the given offset from the matching
+// edge of the parent container.
+//
+// Since: 2.6
+func (c *ConstraintLayout) AttachToParent(obj fyne.CanvasObject, edge ConstraintEdge, offset float32) {
+	c.set(obj, edge, constraint{targetEdge: edge, offset: offset})
+}
+
+// AttachToGuideline pins an edge of obj at the given offset from a guideline
+// created with HorizontalGuideline or VerticalGuideline.
+//
+// Since: 2.6
+func (c *ConstraintLayout) AttachToGuideline(obj fyne.CanvasObject, edge ConstraintEdge, guide *Guideline, offset float32) {
+	c.set(obj, edge, constraint{guide: guide, offset: offset})
+}
+
+// HorizontalGuideline returns a guideline that top and bottom edges can attach
+// to, placed at the given fraction of the container height.
+//
+// Since: 2.6
+func (c *ConstraintLayout) HorizontalGuideline(fraction float32) *Guideline {
+	return &Guideline{horizontal: true, fraction: fraction}
+}
+
+// VerticalGuideline returns a guideline that leading and trailing edges can
+// attach to, placed at the given fraction of the container width.
+//
+// Since: 2.6
+func (c *ConstraintLayout) VerticalGuideline(fraction float32) *Guideline {
+	return &Guideline{fraction: fraction}
+}
+
+// Layout is called to pack all child objects into a specified size.
+// Every constrained edge is resolved exactly once, objects with both edges of
+// an axis attached stretch between them and others keep their minimum size.
+func (c *ConstraintLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	s := &constraintSolver{layout: c, size: size, solved: make(map[solvedEdge]float32)}
+	rtl := isRightToLeft()
+
+	for _, child := range objects {
+		if !child.Visible() {
+			continue
+		}
+
+		leading := s.solve(child, ConstraintLeading)
+		trailing := s.solve(child, ConstraintTrailing)
+		top := s.solve(child, ConstraintTop)
+		bottom := s.solve(child, ConstraintBottom)
+
+		width := fyne.Max(trailing-leading, 0)
+		if rtl {
+			leading = size.Width - leading - width
+		}
+		child.Move(fyne.NewPos(leading, top))
+		child.Resize(fyne.NewSize(width, fyne.Max(bottom-top, 0)))
+	}
+}
+
+// MinSize finds the smallest size that satisfies all the child objects.
+// The constraints are relative to the container so this is the largest of the
+// child minimum sizes, as for a stack layout.
+func (c *ConstraintLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	minSize := fyne.NewSize(0, 0)
+	for _, child := range objects {
+		if !child.Visible() {
+			continue
+		}
+
+		minSize = minSize.Max(child.MinSize())
+	}
+	return minSize
+}
+
+func (c *ConstraintLayout) set(obj fyne.CanvasObject, edge ConstraintEdge, con constraint) {
+	edges, ok := c.constraints[obj]
+	if !ok {
+		edges = make(map[ConstraintEdge]constraint)
+		c.constraints[obj] = edges
+	}
+	edges[edge] = con
+}
+
+type solvedEdge struct {
+	obj  fyne.CanvasObject
+	edge ConstraintEdge
+}
+
+type constraintSolver struct {
+	layout  *ConstraintLayout
+	size    fyne.Size
+	solved  map[solvedEdge]float32
+	solving []solvedEdge
+}
+
+// solve returns the position of an object edge along its axis, memoising the
+// result so chains of attached objects are walked only once.
+func (s *constraintSolver) solve(obj fyne.CanvasObject, edge ConstraintEdge) float32 {
+	key := solvedEdge{obj, edge}
+	if v, ok := s.solved[key]; ok {
+		return v
+	}
+	for _, pending := range s.solving {
+		if pending == key {
+			fyne.LogError("Constraint cycle detected", errors.New("constraint layout edges attach to each other"))
+			return 0
+		}
+	}
+	s.solving = append(s.solving, key)
+
+	v := s.resolve(obj, edge)
+	s.solving = s.solving[:len(s.solving)-1]
+	s.solved[key] = v
+	return v
+}
+
+func (s *constraintSolver) resolve(obj fyne.CanvasObject, edge ConstraintEdge) float32 {
+	if con, ok := s.layout.constraints[obj][edge]; ok {
+		return s.anchor(con) + con.offset
+	}
+
+	// an unconstrained edge follows the opposite one at the minimum size
+	min := obj.MinSize()
+	switch edge {
+	case ConstraintTrailing:
+		return s.solve(obj, ConstraintLeading) + min.Width
+	case ConstraintBottom:
+		return s.solve(obj, ConstraintTop) + min.Height
+	case ConstraintLeading:
+		if _, ok := s.layout.constraints[obj][ConstraintTrailing]; ok {
+			return s.solve(obj, ConstraintTrailing) - min.Width
+		}
+	case ConstraintTop:
+		if _, ok := s.layout.constraints[obj][ConstraintBottom]; ok {
+			return s.solve(obj, ConstraintBottom) - min.Height
+		}
+	}
+	return 0
+}
+
+func (s *constraintSolver) anchor(con constraint) float32 {
+	if con.guide != nil {
+		if con.guide.horizontal {
+			return s.size.Height * con.guide.fraction
+		}
+		return s.size.Width * con.guide.fraction
+	}
+	if con.target != nil {
+		return s.solve(con.target, con.targetEdge)
+	}
+
+	switch con.targetEdge {
+	case ConstraintTrailing:
+		return s.size.Width
+	case ConstraintBottom:
+		return s.size.Height
+	}
+	return 0
+}
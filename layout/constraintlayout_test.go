@@ -0,0 +1,91 @@
+package layout_test
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/layout"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstraintLayout_Layout_Parent(t *testing.T) {
+	obj := NewMinSizeRect(fyne.NewSize(40, 30))
+
+	l := layout.NewConstraintLayout()
+	l.AttachToParent(obj, layout.ConstraintLeading, 10)
+	l.AttachToParent(obj, layout.ConstraintTop, 20)
+	l.Layout([]fyne.CanvasObject{obj}, fyne.NewSize(200, 100))
+
+	assert.Equal(t, fyne.NewPos(10, 20), obj.Position())
+	assert.Equal(t, fyne.NewSize(40, 30), obj.Size())
+}
+
+func TestConstraintLayout_Layout_Stretch(t *testing.T) {
+	obj := NewMinSizeRect(fyne.NewSize(40, 30))
+
+	l := layout.NewConstraintLayout()
+	l.AttachToParent(obj, layout.ConstraintLeading, 10)
+	l.AttachToParent(obj, layout.ConstraintTrailing, -10)
+	l.Layout([]fyne.CanvasObject{obj}, fyne.NewSize(200, 100))
+
+	assert.Equal(t, fyne.NewPos(10, 0), obj.Position())
+	assert.Equal(t, fyne.NewSize(180, 30), obj.Size())
+}
+
+func TestConstraintLayout_Layout_Sibling(t *testing.T) {
+	obj1 := NewMinSizeRect(fyne.NewSize(40, 30))
+	obj2 := NewMinSizeRect(fyne.NewSize(40, 30))
+
+	l := layout.NewConstraintLayout()
+	l.AttachToParent(obj1, layout.ConstraintLeading, 0)
+	l.Attach(obj2, layout.ConstraintLeading, obj1, layout.ConstraintTrailing, 5)
+	l.Attach(obj2, layout.ConstraintTop, obj1, layout.ConstraintBottom, 5)
+	l.Layout([]fyne.CanvasObject{obj1, obj2}, fyne.NewSize(200, 100))
+
+	assert.Equal(t, fyne.NewPos(45, 35), obj2.Position())
+}
+
+func TestConstraintLayout_Layout_Guideline(t *testing.T) {
+	obj := NewMinSizeRect(fyne.NewSize(40, 30))
+
+	l := layout.NewConstraintLayout()
+	guide := l.VerticalGuideline(0.5)
+	l.AttachToGuideline(obj, layout.ConstraintLeading, guide, 0)
+	l.Layout([]fyne.CanvasObject{obj}, fyne.NewSize(200, 100))
+
+	assert.Equal(t, fyne.NewPos(100, 0), obj.Position())
+}
+
+func TestConstraintLayout_Layout_TrailingOnly(t *testing.T) {
+	obj := NewMinSizeRect(fyne.NewSize(40, 30))
+
+	l := layout.NewConstraintLayout()
+	l.AttachToParent(obj, layout.ConstraintTrailing, 0)
+	l.AttachToParent(obj, layout.ConstraintBottom, 0)
+	l.Layout([]fyne.CanvasObject{obj}, fyne.NewSize(200, 100))
+
+	assert.Equal(t, fyne.NewPos(160, 70), obj.Position())
+	assert.Equal(t, fyne.NewSize(40, 30), obj.Size())
+}
+
+func TestConstraintLayout_Layout_Cycle(t *testing.T) {
+	obj1 := NewMinSizeRect(fyne.NewSize(40, 30))
+	obj2 := NewMinSizeRect(fyne.NewSize(40, 30))
+
+	l := layout.NewConstraintLayout()
+	l.Attach(obj1, layout.ConstraintLeading, obj2, layout.ConstraintTrailing, 0)
+	l.Attach(obj2, layout.ConstraintLeading, obj1, layout.ConstraintTrailing, 0)
+
+	assert.NotPanics(t, func() {
+		l.Layout([]fyne.CanvasObject{obj1, obj2}, fyne.NewSize(200, 100))
+	})
+}
+
+func TestConstraintLayout_MinSize(t *testing.T) {
+	obj1 := NewMinSizeRect(fyne.NewSize(40, 30))
+	obj2 := NewMinSizeRect(fyne.NewSize(20, 50))
+
+	l := layout.NewConstraintLayout()
+	assert.Equal(t, fyne.NewSize(40, 50), l.MinSize([]fyne.CanvasObject{obj1, obj2}))
+}
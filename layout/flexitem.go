@@ -0,0 +1,128 @@
+package layout
+
+import "fyne.io/fyne/v2"
+
+// Declare conformity with the Widget and FlexItemObject interfaces
+var _ fyne.Widget = (*FlexItem)(nil)
+var _ FlexItemObject = (*FlexItem)(nil)
+
+// FlexItem wraps a canvas object with flexbox sizing hints for use in a
+// container with a Flex layout. The content always fills this item.
+//
+// Since: 2.6
+type FlexItem struct {
+	// Content is the object sized and positioned by the surrounding Flex layout.
+	Content fyne.CanvasObject
+	// Grow is the proportion of free main axis space given to this item.
+	Grow float32
+	// Shrink is the proportion of main axis overflow removed from this item.
+	Shrink float32
+	// Basis is the main axis size before growing or shrinking,
+	// if 0 or less the content MinSize is used.
+	Basis float32
+
+	size   fyne.Size
+	pos    fyne.Position
+	hidden bool
+}
+
+// NewFlexItem returns a new item wrapping content for a Flex layout, with the
+// specified grow factor. The Shrink factor defaults to 1 and the Basis to the
+// content minimum size, both can be set before the item is laid out.
+//
+// Since: 2.6
+func NewFlexItem(content fyne.CanvasObject, grow float32) *FlexItem {
+	return &FlexItem{Content: content, Grow: grow, Shrink: 1}
+}
+
+// CreateRenderer returns a new renderer that fills this item with its content.
+func (f *FlexItem) CreateRenderer() fyne.WidgetRenderer {
+	return &flexItemRenderer{item: f}
+}
+
+// FlexGrow returns the proportion of free space this item takes when growing.
+func (f *FlexItem) FlexGrow() float32 {
+	return f.Grow
+}
+
+// FlexShrink returns the proportion of overflow this item gives up when shrinking.
+func (f *FlexItem) FlexShrink() float32 {
+	return f.Shrink
+}
+
+// FlexBasis returns the main axis size this item starts from, 0 or less meaning
+// the content minimum size.
+func (f *FlexItem) FlexBasis() float32 {
+	return f.Basis
+}
+
+// Size returns the current size of this item.
+func (f *FlexItem) Size() fyne.Size {
+	return f.size
+}
+
+// Resize sets a new size for the item and its content - this will be called by the layout.
+func (f *FlexItem) Resize(size fyne.Size) {
+	f.size = size
+	f.Content.Resize(size)
+}
+
+// Position returns the current position of this item.
+func (f *FlexItem) Position() fyne.Position {
+	return f.pos
+}
+
+// Move sets a new position for the item - this will be called by the layout.
+func (f *FlexItem) Move(pos fyne.Position) {
+	f.pos = pos
+}
+
+// MinSize returns the smallest size that the wrapped content requires.
+func (f *FlexItem) MinSize() fyne.Size {
+	return f.Content.MinSize()
+}
+
+// Visible returns true if this item should affect the layout.
+func (f *FlexItem) Visible() bool {
+	return !f.hidden
+}
+
+// Show sets the item and its content to be visible.
+func (f *FlexItem) Show() {
+	f.hidden = false
+	f.Content.Show()
+}
+
+// Hide removes this item from layout calculations and hides its content.
+func (f *FlexItem) Hide() {
+	f.hidden = true
+	f.Content.Hide()
+}
+
+// Refresh requests a redraw of the wrapped content.
+func (f *FlexItem) Refresh() {
+	f.Content.Refresh()
+}
+
+type flexItemRenderer struct {
+	item *FlexItem
+}
+
+func (r *flexItemRenderer) Destroy() {
+}
+
+func (r *flexItemRenderer) Layout(size fyne.Size) {
+	r.item.Content.Resize(size)
+}
+
+func (r *flexItemRenderer) MinSize() fyne.Size {
+	return r.item.Content.MinSize()
+}
+
+func (r *flexItemRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.item.Content}
+}
+
+func (r *flexItemRenderer) Refresh() {
+	r.item.Content.Refresh()
+}
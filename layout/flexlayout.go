@@ -0,0 +1,294 @@
+package layout
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// FlexDirection defines the main axis that a Flex layout packs items along.
+//
+// Since: 2.6
+type FlexDirection int
+
+const (
+	// FlexRow packs items horizontally, left to right.
+	//
+	// Since: 2.6
+	FlexRow FlexDirection = iota
+	// FlexColumn packs items vertically, top to bottom.
+	//
+	// Since: 2.6
+	FlexColumn
+)
+
+// FlexJustify defines how a Flex layout distributes free space along its main axis
+// when no item is growing.
+//
+// Since: 2.6
+type FlexJustify int
+
+const (
+	// FlexJustifyStart packs items at the beginning of the main axis.
+	//
+	// Since: 2.6
+	FlexJustifyStart FlexJustify = iota
+	// FlexJustifyCenter packs items around the centre of the main axis.
+	//
+	// Since: 2.6
+	FlexJustifyCenter
+	// FlexJustifyEnd packs items at the end of the main axis.
+	//
+	// Since: 2.6
+	FlexJustifyEnd
+	// FlexJustifySpaceBetween shares the free space equally between items,
+	// with no space before the first item or after the last.
+	//
+	// Since: 2.6
+	FlexJustifySpaceBetween
+)
+
+// FlexAlign defines how a Flex layout places items across its cross axis.
+//
+// Since: 2.6
+type FlexAlign int
+
+const (
+	// FlexAlignStretch resizes items to fill the cross axis of their line.
+	//
+	// Since: 2.6
+	FlexAlignStretch FlexAlign = iota
+	// FlexAlignStart places items at the beginning of the cross axis.
+	//
+	// Since: 2.6
+	FlexAlignStart
+	// FlexAlignCenter places items at the centre of the cross axis.
+	//
+	// Since: 2.6
+	FlexAlignCenter
+	// FlexAlignEnd places items at the end of the cross axis.
+	//
+	// Since: 2.6
+	FlexAlignEnd
+)
+
+// FlexItemObject is any object that carries flexbox sizing hints for a Flex layout.
+// Objects that do not implement this interface keep their MinSize on the main axis.
+//
+// Since: 2.6
+type FlexItemObject interface {
+	FlexGrow() float32
+	FlexShrink() float32
+	FlexBasis() float32
+}
+
+// Declare conformity with Layout interface
+var _ fyne.Layout = (*FlexLayout)(nil)
+
+// FlexLayout arranges items along a main axis in the manner of CSS flexbox.
+// Items wrapped in a FlexItem can grow to share free space or shrink when the
+// container is too small, and wrapping onto further lines is supported.
+//
+// Since: 2.6
+type FlexLayout struct {
+	// Direction selects the main axis that items are packed along.
+	Direction FlexDirection
+	// Wrap moves items that do not fit the main axis onto a new line.
+	Wrap bool
+	// Justify distributes any free space left on the main axis after growing.
+	Justify FlexJustify
+	// Align places items across the cross axis of their line.
+	Align FlexAlign
+}
+
+// NewFlex returns a flexbox-style layout packing items along the given direction.
+// The Wrap, Justify and Align fields can be set before use to tune the behaviour.
+//
+// Since: 2.6
+func NewFlex(direction FlexDirection) *FlexLayout {
+	return &FlexLayout{Direction: direction}
+}
+
+// flexChild caches the sizing information for one visible object in a line.
+type flexChild struct {
+	obj          fyne.CanvasObject
+	basis        float32 // main axis size before growing or shrinking
+	grow, shrink float32
+	crossMin     float32
+}
+
+// Layout is called to pack all child objects into a specified size.
+// Items are placed along the main axis at their basis size, then any free
+// space is shared between growing items or overflow removed from shrinking ones.
+func (f *FlexLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	padding := theme.Padding()
+	lines := f.buildLines(objects, f.mainOf(size), padding)
+
+	crossPos := float32(0)
+	for _, line := range lines {
+		lineCross := f.crossOf(size)
+		if f.Wrap {
+			lineCross = float32(0)
+			for _, child := range line {
+				lineCross = fyne.Max(lineCross, child.crossMin)
+			}
+		}
+
+		f.layoutLine(line, f.mainOf(size), lineCross, crossPos, padding)
+		crossPos += lineCross + padding
+	}
+}
+
+// MinSize finds the smallest size that satisfies all the child objects.
+// Without wrapping this is every basis summed along the main axis, with the
+// largest cross axis minimum. When wrapping only the largest item sets the
+// main axis minimum as the others may move to another line.
+func (f *FlexLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	padding := theme.Padding()
+	mainMin, crossMin := float32(0), float32(0)
+	addPadding := false
+	for _, obj := range objects {
+		if !obj.Visible() {
+			continue
+		}
+
+		child := f.childFor(obj)
+		if f.Wrap {
+			mainMin = fyne.Max(mainMin, child.basis)
+		} else {
+			mainMin += child.basis
+			if addPadding {
+				mainMin += padding
+			}
+			addPadding = true
+		}
+		crossMin = fyne.Max(crossMin, child.crossMin)
+	}
+
+	if f.Direction == FlexColumn {
+		return fyne.NewSize(crossMin, mainMin)
+	}
+	return fyne.NewSize(mainMin, crossMin)
+}
+
+// buildLines splits the visible objects into lines that fit the main axis limit.
+// Without wrapping a single line holds every visible object.
+func (f *FlexLayout) buildLines(objects []fyne.CanvasObject, limit, padding float32) [][]flexChild {
+	var lines [][]flexChild
+	var line []flexChild
+	used := float32(0)
+	for _, obj := range objects {
+		if !obj.Visible() {
+			continue
+		}
+
+		child := f.childFor(obj)
+		needed := child.basis
+		if len(line) > 0 {
+			needed += padding
+		}
+		if f.Wrap && len(line) > 0 && used+needed > limit {
+			lines = append(lines, line)
+			line = nil
+			used = float32(0)
+			needed = child.basis
+		}
+		line = append(line, child)
+		used += needed
+	}
+	if len(line) > 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// layoutLine places one line of items, growing or shrinking them on the main
+// axis then aligning each across the line.
+func (f *FlexLayout) layoutLine(line []flexChild, mainLimit, lineCross, crossPos, padding float32) {
+	total := padding * float32(len(line)-1)
+	sumGrow, sumShrink := float32(0), float32(0)
+	for _, child := range line {
+		total += child.basis
+		sumGrow += child.grow
+		sumShrink += child.shrink * child.basis
+	}
+
+	free := mainLimit - total
+	mains := make([]float32, len(line))
+	for i, child := range line {
+		mains[i] = child.basis
+		if free > 0 && sumGrow > 0 {
+			mains[i] += free * child.grow / sumGrow
+		} else if free < 0 && sumShrink > 0 {
+			// shrink in proportion to both the factor and the basis, as larger
+			// items have more room to give up
+			mains[i] = fyne.Max(mains[i]+free*child.shrink*child.basis/sumShrink, 0)
+		}
+	}
+	if sumGrow > 0 || free < 0 {
+		free = 0
+	}
+
+	mainPos, gap := float32(0), padding
+	switch f.Justify {
+	case FlexJustifyCenter:
+		mainPos = free / 2
+	case FlexJustifyEnd:
+		mainPos = free
+	case FlexJustifySpaceBetween:
+		if len(line) > 1 {
+			gap += free / float32(len(line)-1)
+		}
+	}
+
+	for i, child := range line {
+		cross := lineCross
+		crossOffset := float32(0)
+		if f.Align != FlexAlignStretch {
+			cross = child.crossMin
+			switch f.Align {
+			case FlexAlignCenter:
+				crossOffset = (lineCross - cross) / 2
+			case FlexAlignEnd:
+				crossOffset = lineCross - cross
+			}
+		}
+
+		if f.Direction == FlexColumn {
+			child.obj.Move(fyne.NewPos(crossPos+crossOffset, mainPos))
+			child.obj.Resize(fyne.NewSize(cross, mains[i]))
+		} else {
+			child.obj.Move(fyne.NewPos(mainPos, crossPos+crossOffset))
+			child.obj.Resize(fyne.NewSize(mains[i], cross))
+		}
+		mainPos += mains[i] + gap
+	}
+}
+
+// childFor looks up the flexbox hints for an object, falling back to a rigid
+// item at its MinSize for objects without them.
+func (f *FlexLayout) childFor(obj fyne.CanvasObject) flexChild {
+	child := flexChild{obj: obj, crossMin: f.crossOf(obj.MinSize())}
+	child.basis = f.mainOf(obj.MinSize())
+	if item, ok := obj.(FlexItemObject); ok {
+		child.grow = item.FlexGrow()
+		child.shrink = item.FlexShrink()
+		if basis := item.FlexBasis(); basis > 0 {
+			child.basis = basis
+		}
+	}
+	return child
+}
+
+func (f *FlexLayout) mainOf(size fyne.Size) float32 {
+	if f.Direction == FlexColumn {
+		return size.Height
+	}
+	return size.Width
+}
+
+func (f *FlexLayout) crossOf(size fyne.Size) float32 {
+	if f.Direction == FlexColumn {
+		return size.Width
+	}
+	return size.Height
+}
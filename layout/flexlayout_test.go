@@ -0,0 +1,115 @@
+package layout_test
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlexLayout_Layout_Grow(t *testing.T) {
+	obj1 := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	obj1.SetMinSize(fyne.NewSize(50, 50))
+	obj2 := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	obj2.SetMinSize(fyne.NewSize(50, 50))
+	item := layout.NewFlexItem(obj2, 1)
+
+	size := fyne.NewSize(300, 50)
+	layout.NewFlex(layout.FlexRow).Layout([]fyne.CanvasObject{obj1, item}, size)
+
+	assert.Equal(t, fyne.NewSize(50, 50), obj1.Size())
+	assert.Equal(t, fyne.NewPos(50+theme.Padding(), 0), item.Position())
+	assert.Equal(t, fyne.NewSize(250-theme.Padding(), 50), item.Size())
+}
+
+func TestFlexLayout_Layout_Shrink(t *testing.T) {
+	obj1 := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	obj1.SetMinSize(fyne.NewSize(100, 50))
+	obj2 := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	obj2.SetMinSize(fyne.NewSize(100, 50))
+	item1 := layout.NewFlexItem(obj1, 0)
+	item2 := layout.NewFlexItem(obj2, 0)
+
+	size := fyne.NewSize(150+theme.Padding(), 50)
+	layout.NewFlex(layout.FlexRow).Layout([]fyne.CanvasObject{item1, item2}, size)
+
+	assert.Equal(t, fyne.NewSize(75, 50), item1.Size())
+	assert.Equal(t, fyne.NewSize(75, 50), item2.Size())
+	assert.Equal(t, fyne.NewPos(75+theme.Padding(), 0), item2.Position())
+}
+
+func TestFlexLayout_Layout_Wrap(t *testing.T) {
+	objects := make([]fyne.CanvasObject, 3)
+	for i := range objects {
+		rect := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+		rect.SetMinSize(fyne.NewSize(50, 50))
+		objects[i] = rect
+	}
+
+	flex := layout.NewFlex(layout.FlexRow)
+	flex.Wrap = true
+	flex.Layout(objects, fyne.NewSize(120, 120))
+
+	assert.Equal(t, fyne.NewPos(0, 0), objects[0].Position())
+	assert.Equal(t, fyne.NewPos(50+theme.Padding(), 0), objects[1].Position())
+	assert.Equal(t, fyne.NewPos(0, 50+theme.Padding()), objects[2].Position())
+}
+
+func TestFlexLayout_Layout_JustifyCenter(t *testing.T) {
+	obj1 := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	obj1.SetMinSize(fyne.NewSize(50, 50))
+	obj2 := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	obj2.SetMinSize(fyne.NewSize(50, 50))
+
+	flex := layout.NewFlex(layout.FlexRow)
+	flex.Justify = layout.FlexJustifyCenter
+	flex.Layout([]fyne.CanvasObject{obj1, obj2}, fyne.NewSize(300, 50))
+
+	free := 300 - 100 - theme.Padding()
+	assert.Equal(t, fyne.NewPos(free/2, 0), obj1.Position())
+	assert.Equal(t, fyne.NewPos(free/2+50+theme.Padding(), 0), obj2.Position())
+}
+
+func TestFlexLayout_Layout_AlignCenter(t *testing.T) {
+	obj := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	obj.SetMinSize(fyne.NewSize(50, 50))
+
+	flex := layout.NewFlex(layout.FlexRow)
+	flex.Align = layout.FlexAlignCenter
+	flex.Layout([]fyne.CanvasObject{obj}, fyne.NewSize(100, 100))
+
+	assert.Equal(t, fyne.NewPos(0, 25), obj.Position())
+	assert.Equal(t, fyne.NewSize(50, 50), obj.Size())
+}
+
+func TestFlexLayout_Layout_Column(t *testing.T) {
+	obj1 := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	obj1.SetMinSize(fyne.NewSize(50, 50))
+	obj2 := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	obj2.SetMinSize(fyne.NewSize(50, 50))
+	item := layout.NewFlexItem(obj2, 1)
+
+	layout.NewFlex(layout.FlexColumn).Layout([]fyne.CanvasObject{obj1, item}, fyne.NewSize(50, 300))
+
+	assert.Equal(t, fyne.NewPos(0, 50+theme.Padding()), item.Position())
+	assert.Equal(t, fyne.NewSize(50, 250-theme.Padding()), item.Size())
+}
+
+func TestFlexLayout_MinSize(t *testing.T) {
+	obj1 := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	obj1.SetMinSize(fyne.NewSize(50, 50))
+	obj2 := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	obj2.SetMinSize(fyne.NewSize(70, 30))
+
+	flex := layout.NewFlex(layout.FlexRow)
+	min := flex.MinSize([]fyne.CanvasObject{obj1, obj2})
+	assert.Equal(t, fyne.NewSize(120+theme.Padding(), 50), min)
+
+	flex.Wrap = true
+	assert.Equal(t, fyne.NewSize(70, 50), flex.MinSize([]fyne.CanvasObject{obj1, obj2}))
+}
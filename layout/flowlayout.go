@@ -0,0 +1,122 @@
+package layout
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Declare conformity with Layout interface
+var _ fyne.Layout = (*FlowLayout)(nil)
+
+// FlowLayout arranges items at their minimum size along lines, wrapping onto a
+// new line when the available width runs out. Unlike GridWrapLayout the items
+// may all be different sizes, making it useful for tag clouds, chip groups and
+// wrapping toolbars.
+//
+// Since: 2.6
+type FlowLayout struct {
+	// Alignment places the items of each line at the leading edge, the centre
+	// or the trailing edge of the available width.
+	Alignment fyne.TextAlign
+
+	itemSpacing func() float32
+	lineSpacing func() float32
+	minHeight   float32
+}
+
+// NewFlowLayout returns a flow layout that wraps items across lines, spaced
+// with the theme.Padding value.
+//
+// Since: 2.6
+func NewFlowLayout() *FlowLayout {
+	return &FlowLayout{itemSpacing: theme.Padding, lineSpacing: theme.Padding}
+}
+
+// NewFlowLayoutWithSpacing returns a flow layout that wraps items across lines
+// using a custom gap between items and between lines instead of theme.Padding.
+//
+// Since: 2.6
+func NewFlowLayoutWithSpacing(itemSpacing, lineSpacing float32) *FlowLayout {
+	return &FlowLayout{
+		itemSpacing: func() float32 { return itemSpacing },
+		lineSpacing: func() float32 { return lineSpacing },
+	}
+}
+
+// Layout is called to pack all child objects into a specified size.
+// Items keep their minimum size and are placed along lines that wrap at the
+// available width, each line aligned according to the Alignment field.
+func (f *FlowLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	spacing := f.itemSpacing()
+	lineSpacing := f.lineSpacing()
+	rtl := isRightToLeft()
+
+	var line []fyne.CanvasObject
+	lineWidth, lineHeight, y := float32(0), float32(0), float32(0)
+	flushLine := func() {
+		if len(line) == 0 {
+			return
+		}
+
+		x := float32(0)
+		switch f.Alignment {
+		case fyne.TextAlignCenter:
+			x = (size.Width - lineWidth) / 2
+		case fyne.TextAlignTrailing:
+			x = size.Width - lineWidth
+		}
+
+		for _, item := range line {
+			min := item.MinSize()
+			if rtl {
+				item.Move(fyne.NewPos(size.Width-x-min.Width, y))
+			} else {
+				item.Move(fyne.NewPos(x, y))
+			}
+			item.Resize(min)
+			x += min.Width + spacing
+		}
+
+		y += lineHeight + lineSpacing
+		line, lineWidth, lineHeight = nil, 0, 0
+	}
+
+	for _, child := range objects {
+		if !child.Visible() {
+			continue
+		}
+
+		min := child.MinSize()
+		needed := min.Width
+		if len(line) > 0 {
+			needed += spacing
+		}
+		if len(line) > 0 && lineWidth+needed > size.Width {
+			flushLine()
+			needed = min.Width
+		}
+		line = append(line, child)
+		lineWidth += needed
+		lineHeight = fyne.Max(lineHeight, min.Height)
+	}
+	flushLine()
+
+	f.minHeight = fyne.Max(y-lineSpacing, 0)
+}
+
+// MinSize finds the smallest size that satisfies all the child objects.
+// The width is that of the widest item as this layout re-flows dynamically,
+// the height covers the lines of the most recent layout pass.
+func (f *FlowLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	minSize := fyne.NewSize(0, 0)
+	for _, child := range objects {
+		if !child.Visible() {
+			continue
+		}
+
+		minSize = minSize.Max(child.MinSize())
+	}
+
+	minSize.Height = fyne.Max(minSize.Height, f.minHeight)
+	return minSize
+}
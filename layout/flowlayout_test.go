@@ -0,0 +1,61 @@
+package layout_test
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlowLayout_Layout(t *testing.T) {
+	obj1 := NewMinSizeRect(fyne.NewSize(80, 30))
+	obj2 := NewMinSizeRect(fyne.NewSize(60, 50))
+	obj3 := NewMinSizeRect(fyne.NewSize(80, 30))
+
+	layout.NewFlowLayout().Layout([]fyne.CanvasObject{obj1, obj2, obj3}, fyne.NewSize(160, 120))
+
+	assert.Equal(t, fyne.NewPos(0, 0), obj1.Position())
+	assert.Equal(t, fyne.NewPos(80+theme.Padding(), 0), obj2.Position())
+	assert.Equal(t, fyne.NewPos(0, 50+theme.Padding()), obj3.Position())
+	assert.Equal(t, fyne.NewSize(60, 50), obj2.Size())
+}
+
+func TestFlowLayout_Layout_Center(t *testing.T) {
+	obj1 := NewMinSizeRect(fyne.NewSize(50, 30))
+	obj2 := NewMinSizeRect(fyne.NewSize(50, 30))
+
+	flow := layout.NewFlowLayout()
+	flow.Alignment = fyne.TextAlignCenter
+	flow.Layout([]fyne.CanvasObject{obj1, obj2}, fyne.NewSize(200, 30))
+
+	offset := (200 - 100 - theme.Padding()) / 2
+	assert.Equal(t, fyne.NewPos(offset, 0), obj1.Position())
+	assert.Equal(t, fyne.NewPos(offset+50+theme.Padding(), 0), obj2.Position())
+}
+
+func TestFlowLayout_Layout_CustomSpacing(t *testing.T) {
+	obj1 := NewMinSizeRect(fyne.NewSize(50, 30))
+	obj2 := NewMinSizeRect(fyne.NewSize(50, 30))
+	obj3 := NewMinSizeRect(fyne.NewSize(50, 30))
+
+	layout.NewFlowLayoutWithSpacing(10, 20).Layout([]fyne.CanvasObject{obj1, obj2, obj3}, fyne.NewSize(115, 100))
+
+	assert.Equal(t, fyne.NewPos(60, 0), obj2.Position())
+	assert.Equal(t, fyne.NewPos(0, 50), obj3.Position())
+}
+
+func TestFlowLayout_MinSize(t *testing.T) {
+	obj1 := NewMinSizeRect(fyne.NewSize(80, 30))
+	obj2 := NewMinSizeRect(fyne.NewSize(60, 50))
+	objects := []fyne.CanvasObject{obj1, obj2}
+
+	flow := layout.NewFlowLayout()
+	assert.Equal(t, fyne.NewSize(80, 50), flow.MinSize(objects))
+
+	// after wrapping to two lines the minimum height covers both
+	flow.Layout(objects, fyne.NewSize(100, 120))
+	assert.Equal(t, fyne.NewSize(80, 80+theme.Padding()), flow.MinSize(objects))
+}
@@ -12,7 +12,9 @@ const formLayoutCols = 2
 var _ fyne.Layout = (*formLayout)(nil)
 
 // formLayout is two column grid where each row has a label and a widget.
-type formLayout struct{}
+type formLayout struct {
+	baseline bool
+}
 
 // calculateTableSizes calculates the izes of the table.
 // This includes the width of the label column (maximum width of all labels),
@@ -85,6 +87,12 @@ func (f *formLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
 		rowHeight := fyne.Max(labelMin.Height, contentMin.Height)
 
 		pos, size := objectLayout(labelCell, 0, labelWidth, rowHeight, labelMin.Height)
+		if f.baseline && contentCell.Visible() {
+			if delta := baselineOf(contentCell) - baselineOf(labelCell); delta > 0 {
+				pos.Y = y + delta
+				size.Height = labelMin.Height
+			}
+		}
 		labelCell.Move(pos)
 		labelCell.Resize(size)
 
@@ -118,3 +126,12 @@ func (f *formLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
 func NewFormLayout() fyne.Layout {
 	return &formLayout{}
 }
+
+// NewBaselineFormLayout returns a FormLayout variant where each label is moved
+// down so its text baseline lines up with the baseline of the content beside it,
+// which reads better when fields have differing heights.
+//
+// Since: 2.6
+func NewBaselineFormLayout() fyne.Layout {
+	return &formLayout{baseline: true}
+}
@@ -178,3 +178,15 @@ func TestFormLayout_MinSize_Hidden(t *testing.T) {
 	expectedRowHeight := float32(100)
 	assert.Equal(t, fyne.NewSize(expectedRowWidth, expectedRowHeight), layoutMin)
 }
+
+func TestFormLayout_Baseline(t *testing.T) {
+	label := &baselineObject{Rectangle: NewMinSizeRect(fyne.NewSize(50, 20)), base: 10}
+	content := &baselineObject{Rectangle: NewMinSizeRect(fyne.NewSize(50, 40)), base: 30}
+
+	objects := []fyne.CanvasObject{label, content}
+	layout.NewBaselineFormLayout().Layout(objects, fyne.NewSize(200, 40))
+
+	assert.Equal(t, fyne.NewPos(0, 20), label.Position())
+	assert.Equal(t, fyne.NewSize(50, 20), label.Size())
+	assert.Equal(t, fyne.NewPos(0, 0).Y, content.Position().Y)
+}
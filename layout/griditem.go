@@ -0,0 +1,114 @@
+package layout
+
+import "fyne.io/fyne/v2"
+
+// Declare conformity with the Widget and GridItemObject interfaces
+var _ fyne.Widget = (*GridItem)(nil)
+var _ GridItemObject = (*GridItem)(nil)
+
+// GridItem wraps a canvas object with a cell position and span for use in a
+// container with a GridPlacementLayout. The content always fills this item.
+//
+// Since: 2.6
+type GridItem struct {
+	// Content is the object sized and positioned by the surrounding grid layout.
+	Content fyne.CanvasObject
+	// Row and Col are the top-left cell this item occupies, counted from 0.
+	Row, Col int
+	// RowSpan and ColSpan are how many cells this item covers, at least 1.
+	RowSpan, ColSpan int
+
+	size   fyne.Size
+	pos    fyne.Position
+	hidden bool
+}
+
+// NewGridItem returns a new item wrapping content for a GridPlacementLayout,
+// placed at the given cell. The spans default to a single cell and can be set
+// before the item is laid out.
+//
+// Since: 2.6
+func NewGridItem(content fyne.CanvasObject, row, col int) *GridItem {
+	return &GridItem{Content: content, Row: row, Col: col, RowSpan: 1, ColSpan: 1}
+}
+
+// CreateRenderer returns a new renderer that fills this item with its content.
+func (g *GridItem) CreateRenderer() fyne.WidgetRenderer {
+	return &gridItemRenderer{item: g}
+}
+
+// GridPlacement returns the cell this item occupies and how many cells it spans.
+func (g *GridItem) GridPlacement() (row, col, rowSpan, colSpan int) {
+	return g.Row, g.Col, g.RowSpan, g.ColSpan
+}
+
+// Size returns the current size of this item.
+func (g *GridItem) Size() fyne.Size {
+	return g.size
+}
+
+// Resize sets a new size for the item and its content - this will be called by the layout.
+func (g *GridItem) Resize(size fyne.Size) {
+	g.size = size
+	g.Content.Resize(size)
+}
+
+// Position returns the current position of this item.
+func (g *GridItem) Position() fyne.Position {
+	return g.pos
+}
+
+// Move sets a new position for the item - this will be called by the layout.
+func (g *GridItem) Move(pos fyne.Position) {
+	g.pos = pos
+}
+
+// MinSize returns the smallest size that the wrapped content requires.
+func (g *GridItem) MinSize() fyne.Size {
+	return g.Content.MinSize()
+}
+
+// Visible returns true if this item should affect the layout.
+func (g *GridItem) Visible() bool {
+	return !g.hidden
+}
+
+// Show sets the item and its content to be visible.
+func (g *GridItem) Show() {
+	g.hidden = false
+	g.Content.Show()
+}
+
+// Hide removes this item from layout calculations and hides its content.
+func (g *GridItem) Hide() {
+	g.hidden = true
+	g.Content.Hide()
+}
+
+// Refresh requests a redraw of the wrapped content.
+func (g *GridItem) Refresh() {
+	g.Content.Refresh()
+}
+
+type gridItemRenderer struct {
+	item *GridItem
+}
+
+func (r *gridItemRenderer) Destroy() {
+}
+
+func (r *gridItemRenderer) Layout(size fyne.Size) {
+	r.item.Content.Resize(size)
+}
+
+func (r *gridItemRenderer) MinSize() fyne.Size {
+	return r.item.Content.MinSize()
+}
+
+func (r *gridItemRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.item.Content}
+}
+
+func (r *gridItemRenderer) Refresh() {
+	r.item.Content.Refresh()
+}
@@ -13,11 +13,12 @@ var _ fyne.Layout = (*gridLayout)(nil)
 type gridLayout struct {
 	Cols            int
 	vertical, adapt bool
+	paddingFunc     func() float32
 }
 
 // NewAdaptiveGridLayout returns a new grid layout which uses columns when horizontal but rows when vertical.
 func NewAdaptiveGridLayout(rowcols int) fyne.Layout {
-	return &gridLayout{Cols: rowcols, adapt: true}
+	return &gridLayout{Cols: rowcols, adapt: true, paddingFunc: theme.Padding}
 }
 
 // NewGridLayout returns a grid layout arranged in a specified number of columns.
@@ -28,12 +29,20 @@ func NewGridLayout(cols int) fyne.Layout {
 
 // NewGridLayoutWithColumns returns a new grid layout that specifies a column count and wrap to new rows when needed.
 func NewGridLayoutWithColumns(cols int) fyne.Layout {
-	return &gridLayout{Cols: cols}
+	return &gridLayout{Cols: cols, paddingFunc: theme.Padding}
 }
 
 // NewGridLayoutWithRows returns a new grid layout that specifies a row count that creates new rows as required.
 func NewGridLayoutWithRows(rows int) fyne.Layout {
-	return &gridLayout{Cols: rows, vertical: true}
+	return &gridLayout{Cols: rows, vertical: true, paddingFunc: theme.Padding}
+}
+
+// NewCustomPaddedGridLayout returns a layout similar to GridLayout that uses a
+// custom amount of padding in between cells instead of the theme.Padding value.
+//
+// Since: 2.6
+func NewCustomPaddedGridLayout(cols int, padding float32) fyne.Layout {
+	return &gridLayout{Cols: cols, paddingFunc: func() float32 { return padding }}
 }
 
 func (g *gridLayout) horizontal() bool {
@@ -60,15 +69,15 @@ func (g *gridLayout) countRows(objects []fyne.CanvasObject) int {
 
 // Get the leading (top or left) edge of a grid cell.
 // size is the ideal cell size and the offset is which col or row its on.
-func getLeading(size float64, offset int) float32 {
-	ret := (size + float64(theme.Padding())) * float64(offset)
+func getLeading(size float64, offset int, padding float32) float32 {
+	ret := (size + float64(padding)) * float64(offset)
 	return float32(ret)
 }
 
 // Get the trailing (bottom or right) edge of a grid cell.
 // size is the ideal cell size and the offset is which col or row its on.
-func getTrailing(size float64, offset int) float32 {
-	return getLeading(size, offset+1) - theme.Padding()
+func getTrailing(size float64, offset int, padding float32) float32 {
+	return getLeading(size, offset+1, padding) - padding
 }
 
 // Layout is called to pack all child objects into a specified size.
@@ -77,7 +86,7 @@ func getTrailing(size float64, offset int) float32 {
 func (g *gridLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
 	rows := g.countRows(objects)
 
-	padding := theme.Padding()
+	padding := g.paddingFunc()
 
 	primaryObjects := rows
 	secondaryObjects := g.Cols
@@ -97,10 +106,10 @@ func (g *gridLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
 			continue
 		}
 
-		x1 := getLeading(cellWidth, col)
-		y1 := getLeading(cellHeight, row)
-		x2 := getTrailing(cellWidth, col)
-		y2 := getTrailing(cellHeight, row)
+		x1 := getLeading(cellWidth, col, padding)
+		y1 := getLeading(cellHeight, row, padding)
+		x2 := getTrailing(cellWidth, col, padding)
+		y2 := getTrailing(cellHeight, row, padding)
 
 		child.Move(fyne.NewPos(x1, y1))
 		child.Resize(fyne.NewSize(x2-x1, y2-y1))
@@ -139,7 +148,7 @@ func (g *gridLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
 		minSize = minSize.Max(child.MinSize())
 	}
 
-	padding := theme.Padding()
+	padding := g.paddingFunc()
 
 	primaryObjects := rows
 	secondaryObjects := g.Cols
@@ -116,3 +116,22 @@ func TestGridLayout_MinSize_HiddenItem(t *testing.T) {
 	layoutMin = layout.NewGridLayout(0).MinSize(container.Objects)
 	assert.Equal(t, minSize, layoutMin)
 }
+
+func TestGridLayout_CustomPadding(t *testing.T) {
+	gridSize := fyne.NewSize(110, 50)
+	cellSize := fyne.NewSize(50, 50)
+
+	obj1 := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	obj2 := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+
+	container := container.NewWithoutLayout(obj1, obj2)
+	container.Resize(gridSize)
+
+	layout.NewCustomPaddedGridLayout(2, 10).Layout(container.Objects, gridSize)
+
+	assert.Equal(t, cellSize, obj1.Size())
+	assert.Equal(t, fyne.NewPos(60, 0), obj2.Position())
+
+	layoutMin := layout.NewCustomPaddedGridLayout(2, 10).MinSize(container.Objects)
+	assert.Equal(t, fyne.NewSize(10, 0), layoutMin.SubtractWidthHeight(obj1.MinSize().Width*2, obj1.MinSize().Height))
+}
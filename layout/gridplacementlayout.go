@@ -0,0 +1,231 @@
+package layout
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// GridItemObject is any object that declares its own cell position and span
+// for a GridPlacementLayout.
+//
+// Since: 2.6
+type GridItemObject interface {
+	GridPlacement() (row, col, rowSpan, colSpan int)
+}
+
+// Declare conformity with Layout interface
+var _ fyne.Layout = (*GridPlacementLayout)(nil)
+
+// GridPlacementLayout arranges items in a grid where each child declares its
+// row, column and span through a GridItem, so complex forms do not need deeply
+// nested box containers. Children without placement flow into the next free cell.
+// Spare space is shared between rows and columns according to their weights.
+//
+// Since: 2.6
+type GridPlacementLayout struct {
+	// RowWeights is the proportion of spare height given to each row,
+	// rows without an entry have weight 1 and weight 0 rows stay at minimum size.
+	RowWeights []float32
+	// ColumnWeights is the proportion of spare width given to each column,
+	// columns without an entry have weight 1 and weight 0 columns stay at minimum size.
+	ColumnWeights []float32
+}
+
+// NewGridPlacementLayout returns a grid layout where children declare their own
+// position and span, typically by wrapping them in a GridItem.
+//
+// Since: 2.6
+func NewGridPlacementLayout() *GridPlacementLayout {
+	return &GridPlacementLayout{}
+}
+
+// gridPlaced records where one visible object settled in the grid.
+type gridPlaced struct {
+	obj                        fyne.CanvasObject
+	row, col, rowSpan, colSpan int
+}
+
+// Layout is called to pack all child objects into a specified size.
+// Every row and column is given its minimum size and then spare space is
+// shared out according to the track weights.
+func (g *GridPlacementLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	placed, rows, cols := g.place(objects)
+	if len(placed) == 0 {
+		return
+	}
+
+	padding := theme.Padding()
+	rowMins, colMins := g.trackMins(placed, rows, cols, padding)
+	rowSizes := trackSizes(rowMins, g.RowWeights, size.Height, padding)
+	colSizes := trackSizes(colMins, g.ColumnWeights, size.Width, padding)
+	rowOffsets := trackOffsets(rowSizes, padding)
+	colOffsets := trackOffsets(colSizes, padding)
+
+	for _, item := range placed {
+		x := colOffsets[item.col]
+		y := rowOffsets[item.row]
+		width := colOffsets[item.col+item.colSpan] - x - padding
+		height := rowOffsets[item.row+item.rowSpan] - y - padding
+
+		item.obj.Move(fyne.NewPos(x, y))
+		item.obj.Resize(fyne.NewSize(width, height))
+	}
+}
+
+// MinSize finds the smallest size that satisfies all the child objects.
+// This is the sum of the minimum row and column sizes with padding between each.
+func (g *GridPlacementLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	placed, rows, cols := g.place(objects)
+	if len(placed) == 0 {
+		return fyne.NewSize(0, 0)
+	}
+
+	padding := theme.Padding()
+	rowMins, colMins := g.trackMins(placed, rows, cols, padding)
+
+	min := fyne.NewSize(padding*float32(cols-1), padding*float32(rows-1))
+	for _, width := range colMins {
+		min.Width += width
+	}
+	for _, height := range rowMins {
+		min.Height += height
+	}
+	return min
+}
+
+// place resolves the cell of every visible object, flowing objects without
+// placement hints into the first free cell in reading order.
+func (g *GridPlacementLayout) place(objects []fyne.CanvasObject) (placed []gridPlaced, rows, cols int) {
+	used := make(map[[2]int]bool)
+	var flow []fyne.CanvasObject
+	for _, obj := range objects {
+		if !obj.Visible() {
+			continue
+		}
+
+		item, ok := obj.(GridItemObject)
+		if !ok {
+			flow = append(flow, obj)
+			continue
+		}
+
+		row, col, rowSpan, colSpan := item.GridPlacement()
+		if rowSpan < 1 {
+			rowSpan = 1
+		}
+		if colSpan < 1 {
+			colSpan = 1
+		}
+		placed = append(placed, gridPlaced{obj, row, col, rowSpan, colSpan})
+		for r := row; r < row+rowSpan; r++ {
+			for c := col; c < col+colSpan; c++ {
+				used[[2]int{r, c}] = true
+			}
+		}
+		rows = intMax(rows, row+rowSpan)
+		cols = intMax(cols, col+colSpan)
+	}
+
+	if cols == 0 && len(flow) > 0 {
+		cols = 1
+	}
+	row, col := 0, 0
+	for _, obj := range flow {
+		for used[[2]int{row, col}] {
+			col++
+			if col >= cols {
+				col = 0
+				row++
+			}
+		}
+		placed = append(placed, gridPlaced{obj, row, col, 1, 1})
+		used[[2]int{row, col}] = true
+		rows = intMax(rows, row+1)
+	}
+	return placed, rows, cols
+}
+
+// trackMins calculates the minimum size of every row and column, sharing the
+// requirement of spanning children across the tracks they cover.
+func (g *GridPlacementLayout) trackMins(placed []gridPlaced, rows, cols int, padding float32) (rowMins, colMins []float32) {
+	rowMins = make([]float32, rows)
+	colMins = make([]float32, cols)
+	for _, item := range placed {
+		min := item.obj.MinSize()
+		if item.colSpan == 1 {
+			colMins[item.col] = fyne.Max(colMins[item.col], min.Width)
+		}
+		if item.rowSpan == 1 {
+			rowMins[item.row] = fyne.Max(rowMins[item.row], min.Height)
+		}
+	}
+
+	// spanning children only enlarge their tracks if the combined size is too small
+	for _, item := range placed {
+		min := item.obj.MinSize()
+		if item.colSpan > 1 {
+			spreadTrackMin(colMins[item.col:item.col+item.colSpan], min.Width, padding)
+		}
+		if item.rowSpan > 1 {
+			spreadTrackMin(rowMins[item.row:item.row+item.rowSpan], min.Height, padding)
+		}
+	}
+	return rowMins, colMins
+}
+
+// spreadTrackMin grows the given tracks equally until they can hold min combined.
+func spreadTrackMin(tracks []float32, min, padding float32) {
+	total := padding * float32(len(tracks)-1)
+	for _, size := range tracks {
+		total += size
+	}
+	if extra := min - total; extra > 0 {
+		for i := range tracks {
+			tracks[i] += extra / float32(len(tracks))
+		}
+	}
+}
+
+// trackSizes shares any space not used by the track minimums according to the
+// given weights, missing entries weighing 1.
+func trackSizes(mins, weights []float32, available, padding float32) []float32 {
+	spare := available - padding*float32(len(mins)-1)
+	sumWeight := float32(0)
+	for i, min := range mins {
+		spare -= min
+		sumWeight += trackWeight(weights, i)
+	}
+
+	sizes := make([]float32, len(mins))
+	for i, min := range mins {
+		sizes[i] = min
+		if spare > 0 && sumWeight > 0 {
+			sizes[i] += spare * trackWeight(weights, i) / sumWeight
+		}
+	}
+	return sizes
+}
+
+// trackOffsets returns the leading edge of every track plus a final trailing
+// entry, each followed by padding.
+func trackOffsets(sizes []float32, padding float32) []float32 {
+	offsets := make([]float32, len(sizes)+1)
+	for i, size := range sizes {
+		offsets[i+1] = offsets[i] + size + padding
+	}
+	return offsets
+}
+
+func trackWeight(weights []float32, i int) float32 {
+	if i >= len(weights) {
+		return 1
+	}
+	return fyne.Max(weights[i], 0)
+}
+
+func intMax(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,83 @@
+package layout_test
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func placementTestItem(row, col int) *layout.GridItem {
+	rect := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	rect.SetMinSize(fyne.NewSize(50, 50))
+	return layout.NewGridItem(rect, row, col)
+}
+
+func TestGridPlacementLayout_Layout(t *testing.T) {
+	item1 := placementTestItem(0, 0)
+	item2 := placementTestItem(0, 1)
+	item3 := placementTestItem(1, 0)
+
+	size := fyne.NewSize(100+theme.Padding(), 100+theme.Padding())
+	layout.NewGridPlacementLayout().Layout([]fyne.CanvasObject{item1, item2, item3}, size)
+
+	assert.Equal(t, fyne.NewPos(0, 0), item1.Position())
+	assert.Equal(t, fyne.NewPos(50+theme.Padding(), 0), item2.Position())
+	assert.Equal(t, fyne.NewPos(0, 50+theme.Padding()), item3.Position())
+	assert.Equal(t, fyne.NewSize(50, 50), item1.Size())
+}
+
+func TestGridPlacementLayout_Layout_Span(t *testing.T) {
+	span := placementTestItem(0, 0)
+	span.ColSpan = 2
+	item1 := placementTestItem(1, 0)
+	item2 := placementTestItem(1, 1)
+
+	size := fyne.NewSize(100+theme.Padding(), 100+theme.Padding())
+	layout.NewGridPlacementLayout().Layout([]fyne.CanvasObject{span, item1, item2}, size)
+
+	assert.Equal(t, fyne.NewSize(100+theme.Padding(), 50), span.Size())
+	assert.Equal(t, fyne.NewPos(50+theme.Padding(), 50+theme.Padding()), item2.Position())
+}
+
+func TestGridPlacementLayout_Layout_Weights(t *testing.T) {
+	item1 := placementTestItem(0, 0)
+	item2 := placementTestItem(0, 1)
+
+	grid := layout.NewGridPlacementLayout()
+	grid.ColumnWeights = []float32{0, 1}
+	grid.Layout([]fyne.CanvasObject{item1, item2}, fyne.NewSize(200+theme.Padding(), 50))
+
+	assert.Equal(t, fyne.NewSize(50, 50), item1.Size())
+	assert.Equal(t, fyne.NewPos(50+theme.Padding(), 0), item2.Position())
+	assert.Equal(t, fyne.NewSize(150, 50), item2.Size())
+}
+
+func TestGridPlacementLayout_Layout_Flow(t *testing.T) {
+	objects := make([]fyne.CanvasObject, 3)
+	for i := range objects {
+		rect := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+		rect.SetMinSize(fyne.NewSize(50, 50))
+		objects[i] = rect
+	}
+
+	size := fyne.NewSize(50, 150+theme.Padding()*2)
+	layout.NewGridPlacementLayout().Layout(objects, size)
+
+	assert.Equal(t, fyne.NewPos(0, 0), objects[0].Position())
+	assert.Equal(t, fyne.NewPos(0, 50+theme.Padding()), objects[1].Position())
+	assert.Equal(t, fyne.NewPos(0, 100+theme.Padding()*2), objects[2].Position())
+}
+
+func TestGridPlacementLayout_MinSize(t *testing.T) {
+	item1 := placementTestItem(0, 0)
+	item2 := placementTestItem(1, 1)
+
+	min := layout.NewGridPlacementLayout().MinSize([]fyne.CanvasObject{item1, item2})
+	assert.Equal(t, fyne.NewSize(100+theme.Padding(), 100+theme.Padding()), min)
+}
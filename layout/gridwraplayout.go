@@ -11,21 +11,30 @@ import (
 var _ fyne.Layout = (*gridWrapLayout)(nil)
 
 type gridWrapLayout struct {
-	CellSize fyne.Size
-	colCount int
-	rowCount int
+	CellSize    fyne.Size
+	colCount    int
+	rowCount    int
+	paddingFunc func() float32
 }
 
 // NewGridWrapLayout returns a new GridWrapLayout instance
 func NewGridWrapLayout(size fyne.Size) fyne.Layout {
-	return &gridWrapLayout{size, 1, 1}
+	return &gridWrapLayout{size, 1, 1, theme.Padding}
+}
+
+// NewCustomPaddedGridWrapLayout returns a layout similar to GridWrapLayout that
+// uses a custom amount of padding in between cells instead of the theme.Padding value.
+//
+// Since: 2.6
+func NewCustomPaddedGridWrapLayout(size fyne.Size, padding float32) fyne.Layout {
+	return &gridWrapLayout{size, 1, 1, func() float32 { return padding }}
 }
 
 // Layout is called to pack all child objects into a specified size.
 // For a GridWrapLayout this will attempt to lay all the child objects in a row
 // and wrap to a new row if the size is not large enough.
 func (g *gridWrapLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
-	padding := theme.Padding()
+	padding := g.paddingFunc()
 	g.colCount = 1
 	g.rowCount = 0
 
@@ -66,5 +75,5 @@ func (g *gridWrapLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
 		rows = 1
 	}
 	return fyne.NewSize(g.CellSize.Width,
-		(g.CellSize.Height*float32(rows))+(float32(rows-1)*theme.Padding()))
+		(g.CellSize.Height*float32(rows))+(float32(rows-1)*g.paddingFunc()))
 }
@@ -135,3 +135,22 @@ func TestGridLWrapLayout_Resize_LessThanMinSize(t *testing.T) {
 	layoutMin := l.MinSize(container.Objects)
 	assert.Equal(t, minSize, layoutMin)
 }
+
+func TestGridWrapLayout_CustomPadding(t *testing.T) {
+	cellSize := fyne.NewSize(50, 50)
+
+	obj1 := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	obj2 := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+	obj3 := canvas.NewRectangle(color.NRGBA{0, 0, 0, 0})
+
+	container := &fyne.Container{
+		Objects: []fyne.CanvasObject{obj1, obj2, obj3},
+	}
+	container.Resize(fyne.NewSize(115, 115))
+
+	layout.NewCustomPaddedGridWrapLayout(cellSize, 10).Layout(container.Objects, container.Size())
+
+	assert.Equal(t, cellSize, obj1.Size())
+	assert.Equal(t, fyne.NewPos(60, 0), obj2.Position())
+	assert.Equal(t, fyne.NewPos(0, 60), obj3.Position())
+}
@@ -19,6 +19,23 @@ func (l Locale) LanguageString() string {
 	return string(l)[:pos]
 }
 
+// IsRightToLeft returns whether this locale uses a language that is written
+// right-to-left, such as Arabic or Hebrew.
+//
+// Since: 2.6
+func (l Locale) IsRightToLeft() bool {
+	lang := string(l)
+	if pos := strings.IndexAny(lang, "-_"); pos != -1 {
+		lang = lang[:pos]
+	}
+
+	switch strings.ToLower(lang) {
+	case "ar", "ckb", "dv", "fa", "he", "iw", "ps", "sd", "ug", "ur", "yi":
+		return true
+	}
+	return false
+}
+
 // String returns the complete locale as a standard string.
 func (l Locale) String() string {
 	return string(l)
@@ -0,0 +1,25 @@
+package fyne
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocale_IsRightToLeft(t *testing.T) {
+	assert.False(t, Locale("en").IsRightToLeft())
+	assert.False(t, Locale("fr-FR").IsRightToLeft())
+	assert.True(t, Locale("ar").IsRightToLeft())
+	assert.True(t, Locale("he-IL").IsRightToLeft())
+	assert.True(t, Locale("fa_IR").IsRightToLeft())
+}
+
+func TestSetLayoutDirection(t *testing.T) {
+	defer SetLayoutDirection(LayoutDirectionAuto)
+
+	SetLayoutDirection(LayoutDirectionRightToLeft)
+	assert.Equal(t, LayoutDirectionRightToLeft, CurrentLayoutDirection())
+
+	SetLayoutDirection(LayoutDirectionLeftToRight)
+	assert.Equal(t, LayoutDirectionLeftToRight, CurrentLayoutDirection())
+}
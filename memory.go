@@ -0,0 +1,23 @@
+package fyne
+
+import "runtime/debug"
+
+var freeCaches func()
+
+// SetFreeCachesFunc is an internal function to register the cache release implementation.
+func SetFreeCachesFunc(f func()) {
+	freeCaches = f
+}
+
+// FreeCaches releases memory held by the internal image, glyph and renderer caches that
+// is not immediately required, then returns as much memory as possible to the operating
+// system. It is called automatically when the system reports a memory warning but can
+// also be invoked manually by long-running apps, such as kiosks, at a quiet moment.
+//
+// Since: 2.6
+func FreeCaches() {
+	if freeCaches != nil {
+		freeCaches()
+	}
+	debug.FreeOSMemory()
+}
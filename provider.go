@@ -0,0 +1,53 @@
+package fyne
+
+import "sync"
+
+var (
+	providers     = map[string][]any{}
+	providersLock sync.RWMutex
+)
+
+// RegisterProvider records an implementation of the named extension point, such as a
+// markdown node renderer, spell checker or icon pack. Third-party modules typically
+// call this from an init function so that importing the module is enough to extend
+// the toolkit. The meaning of each kind and the interface an implementation must
+// satisfy are documented by the package that consumes it.
+//
+// Since: 2.6
+func RegisterProvider(kind string, impl any) {
+	providersLock.Lock()
+	defer providersLock.Unlock()
+	providers[kind] = append(providers[kind], impl)
+}
+
+// Provider returns the most recently registered implementation of the named extension
+// point, or nil if none has been registered. It is used by consumers of extension
+// points where only one implementation makes sense, letting later registrations
+// override earlier ones.
+//
+// Since: 2.6
+func Provider(kind string) any {
+	providersLock.RLock()
+	defer providersLock.RUnlock()
+	impls := providers[kind]
+	if len(impls) == 0 {
+		return nil
+	}
+	return impls[len(impls)-1]
+}
+
+// Providers returns all implementations registered for the named extension point, in
+// the order they were registered.
+//
+// Since: 2.6
+func Providers(kind string) []any {
+	providersLock.RLock()
+	defer providersLock.RUnlock()
+	impls := providers[kind]
+	if len(impls) == 0 {
+		return nil
+	}
+	out := make([]any, len(impls))
+	copy(out, impls)
+	return out
+}
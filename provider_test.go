@@ -0,0 +1,18 @@
+package fyne
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterProvider(t *testing.T) {
+	assert.Nil(t, Provider("test.missing"))
+	assert.Nil(t, Providers("test.missing"))
+
+	RegisterProvider("test.kind", "first")
+	RegisterProvider("test.kind", "second")
+
+	assert.Equal(t, "second", Provider("test.kind"))
+	assert.Equal(t, []any{"first", "second"}, Providers("test.kind"))
+}
@@ -31,4 +31,16 @@ type Settings interface {
 	BuildType() BuildType
 
 	ShowAnimations() bool
+
+	// ReducedMotion returns whether the user has asked for non-essential motion to be
+	// minimised, either in the OS accessibility settings or this app's configuration.
+	//
+	// Since: 2.6
+	ReducedMotion() bool
+
+	// AnimationScale returns the multiplier applied to animation durations.
+	// Values above 1 slow animations down and values below 1 speed them up, 1 being normal speed.
+	//
+	// Since: 2.6
+	AnimationScale() float32
 }
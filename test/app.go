@@ -226,6 +226,14 @@ func (s *testSettings) ShowAnimations() bool {
 	return true
 }
 
+func (s *testSettings) ReducedMotion() bool {
+	return false
+}
+
+func (s *testSettings) AnimationScale() float32 {
+	return 1
+}
+
 func (s *testSettings) Theme() fyne.Theme {
 	s.propertyLock.RLock()
 	defer s.propertyLock.RUnlock()
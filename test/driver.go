@@ -99,6 +99,10 @@ func (d *driver) RenderedTextSize(text string, size float32, style fyne.TextStyl
 	return painter.RenderedTextSize(text, size, style, source)
 }
 
+func (d *driver) RenderedTextMetrics(text string, size float32, style fyne.TextStyle, source fyne.Resource) fyne.TextMetrics {
+	return painter.RenderedTextMetrics(text, size, style, source)
+}
+
 func (d *driver) Run() {
 	// no-op
 }
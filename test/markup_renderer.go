@@ -386,6 +386,7 @@ func nrgbaColor(c color.Color) color.NRGBA {
 
 func knownColor(c color.Color) string {
 	return map[color.Color]string{
+		nrgbaColor(theme.Color(theme.ColorNameAccent)):              "accent",
 		nrgbaColor(theme.Color(theme.ColorNameBackground)):          "background",
 		nrgbaColor(theme.Color(theme.ColorNameButton)):              "button",
 		nrgbaColor(theme.Color(theme.ColorNameDisabledButton)):      "disabled button",
@@ -13,36 +13,7 @@ import (
 
 var defaultTheme fyne.Theme
 
-// Try to keep these in sync with the existing color names at theme/color.go.
-var knownColorNames = []fyne.ThemeColorName{
-	theme.ColorNameBackground,
-	theme.ColorNameButton,
-	theme.ColorNameDisabled,
-	theme.ColorNameDisabledButton,
-	theme.ColorNameError,
-	theme.ColorNameFocus,
-	theme.ColorNameForeground,
-	theme.ColorNameForegroundOnError,
-	theme.ColorNameForegroundOnPrimary,
-	theme.ColorNameForegroundOnSuccess,
-	theme.ColorNameForegroundOnWarning,
-	theme.ColorNameHeaderBackground,
-	theme.ColorNameHover,
-	theme.ColorNameHyperlink,
-	theme.ColorNameInputBackground,
-	theme.ColorNameInputBorder,
-	theme.ColorNameMenuBackground,
-	theme.ColorNameOverlayBackground,
-	theme.ColorNamePlaceHolder,
-	theme.ColorNamePressed,
-	theme.ColorNamePrimary,
-	theme.ColorNameScrollBar,
-	theme.ColorNameSelection,
-	theme.ColorNameSeparator,
-	theme.ColorNameShadow,
-	theme.ColorNameSuccess,
-	theme.ColorNameWarning,
-}
+var knownColorNames = theme.ColorNames()
 
 // AssertAllColorNamesDefined asserts that all known color names are defined for the given theme.
 func AssertAllColorNamesDefined(t *testing.T, th fyne.Theme, themeName string) {
@@ -89,6 +60,7 @@ func NewTheme() fyne.Theme {
 
 	return &configurableTheme{
 		colors: map[fyne.ThemeColorName]color.Color{
+			theme.ColorNameAccent:              green(250),
 			theme.ColorNameBackground:          red(255),
 			theme.ColorNameButton:              gray(100),
 			theme.ColorNameDisabled:            gray(20),
@@ -151,6 +123,7 @@ func Theme() fyne.Theme {
 	if defaultTheme == nil {
 		defaultTheme = &configurableTheme{
 			colors: map[fyne.ThemeColorName]color.Color{
+				theme.ColorNameAccent:              color.NRGBA{R: 0xff, G: 0xb0, B: 0x70, A: 0xff},
 				theme.ColorNameBackground:          color.NRGBA{R: 0x44, G: 0x44, B: 0x44, A: 0xff},
 				theme.ColorNameButton:              color.NRGBA{R: 0x33, G: 0x33, B: 0x33, A: 0xff},
 				theme.ColorNameDisabled:            color.NRGBA{R: 0x88, G: 0x88, B: 0x88, A: 0xff},
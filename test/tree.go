@@ -0,0 +1,44 @@
+package test
+
+import (
+	"encoding/json"
+	"image/color"
+	"io"
+
+	"fyne.io/fyne/v2"
+	fynecanvas "fyne.io/fyne/v2/canvas"
+)
+
+// LoadTree reconstructs a layout from a dump previously written by canvas.DumpTree.
+// Nodes with children become plain containers and all other objects placeholder
+// rectangles, each moved and sized as recorded in the dump. This allows a layout
+// reported in a bug to be reproduced without the application that produced it.
+//
+// Since: 2.6
+func LoadTree(r io.Reader) (fyne.CanvasObject, error) {
+	node := &fynecanvas.TreeNode{}
+	if err := json.NewDecoder(r).Decode(node); err != nil {
+		return nil, err
+	}
+	return buildTreeNode(node), nil
+}
+
+func buildTreeNode(node *fynecanvas.TreeNode) fyne.CanvasObject {
+	var obj fyne.CanvasObject
+	if len(node.Children) > 0 || node.Type == "*fyne.Container" {
+		c := &fyne.Container{}
+		for _, child := range node.Children {
+			c.Objects = append(c.Objects, buildTreeNode(child))
+		}
+		obj = c
+	} else {
+		obj = fynecanvas.NewRectangle(color.Transparent)
+	}
+
+	obj.Move(fyne.NewPos(node.X, node.Y))
+	obj.Resize(fyne.NewSize(node.Width, node.Height))
+	if !node.Visible {
+		obj.Hide()
+	}
+	return obj
+}
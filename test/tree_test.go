@@ -0,0 +1,38 @@
+package test
+
+import (
+	"bytes"
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	fynecanvas "fyne.io/fyne/v2/canvas"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTree(t *testing.T) {
+	rect := fynecanvas.NewRectangle(color.Black)
+	rect.Move(fyne.NewPos(10, 20))
+	rect.Resize(fyne.NewSize(30, 40))
+	circle := fynecanvas.NewCircle(color.White)
+	circle.Hide()
+	root := &fyne.Container{Objects: []fyne.CanvasObject{rect, circle}}
+	root.Resize(fyne.NewSize(100, 100))
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, fynecanvas.DumpTree(buf, root))
+
+	loaded, err := LoadTree(buf)
+	require.NoError(t, err)
+
+	c, ok := loaded.(*fyne.Container)
+	require.True(t, ok)
+	assert.Equal(t, fyne.NewSize(100, 100), c.Size())
+	require.Len(t, c.Objects, 2)
+	assert.Equal(t, fyne.NewPos(10, 20), c.Objects[0].Position())
+	assert.Equal(t, fyne.NewSize(30, 40), c.Objects[0].Size())
+	assert.True(t, c.Objects[0].Visible())
+	assert.False(t, c.Objects[1].Visible())
+}
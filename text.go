@@ -66,9 +66,33 @@ type TextStyle struct {
 	Underline bool // Should text be underlined.
 }
 
+// TextMetrics describes the detailed measurements of a rendered string of text.
+//
+// Since: 2.6
+type TextMetrics struct {
+	// Ascent is the distance from the baseline up to the top of the tallest glyphs in the face.
+	Ascent float32
+	// Descent is the distance from the baseline down to the lowest glyphs in the face.
+	Descent float32
+	// LineGap is the additional space the face requests between lines of text.
+	LineGap float32
+	// Advances holds how far the draw position moves for each glyph cluster, in order.
+	// A tab character appears as a single advance to the following tab stop.
+	Advances []float32
+}
+
 // MeasureText uses the current driver to calculate the size of text when rendered.
 // The font used will be read from the current app's theme.
 func MeasureText(text string, size float32, style TextStyle) Size {
 	s, _ := CurrentApp().Driver().RenderedTextSize(text, size, style, nil)
 	return s
 }
+
+// MeasureTextMetrics uses the current driver to calculate the detailed metrics of text
+// when rendered, for use in baseline alignment and caret placement.
+// The font used will be read from the current app's theme.
+//
+// Since: 2.6
+func MeasureTextMetrics(text string, size float32, style TextStyle) TextMetrics {
+	return CurrentApp().Driver().RenderedTextMetrics(text, size, style, nil)
+}
@@ -32,6 +32,16 @@ type Theme interface {
 	Size(ThemeSizeName) float32
 }
 
+// ThemeAware describes an object, usually a widget, that wants to be told when the
+// application theme changes. ThemeChanged is called exactly once per settings change,
+// before the object is refreshed, so objects caching theme colours or images can
+// rebuild them without watching for global refreshes.
+//
+// Since: 2.6
+type ThemeAware interface {
+	ThemeChanged(old, new Theme)
+}
+
 // LegacyTheme defines the requirements of any Fyne theme.
 // This was previously called Theme and is kept for simpler transition of applications built before v2.0.0.
 //
@@ -0,0 +1,79 @@
+package theme
+
+import (
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/storage"
+)
+
+// Declare conformity with Resource interface.
+var _ fyne.Resource = (*asyncResource)(nil)
+
+type asyncResource struct {
+	uri         fyne.URI
+	placeholder fyne.Resource
+
+	lock   sync.RWMutex
+	loaded fyne.Resource
+}
+
+// AsyncResource returns a resource that serves the given placeholder until the content
+// at the URI has been loaded through the storage layer, off the main thread.
+// It can be used anywhere a resource is expected, such as button or tree node icons,
+// avoiding UI stalls when icons come from disk or the network.
+// Once the load completes the content of all shown windows is refreshed.
+//
+// Since: 2.6
+func AsyncResource(u fyne.URI, placeholder fyne.Resource) fyne.Resource {
+	res := &asyncResource{uri: u, placeholder: placeholder}
+	go res.load()
+	return res
+}
+
+// Name returns the name of the underlying URI, so caches update when content arrives.
+func (res *asyncResource) Name() string {
+	res.lock.RLock()
+	defer res.lock.RUnlock()
+
+	if res.loaded != nil {
+		return res.loaded.Name()
+	}
+	return res.uri.Name() + "!loading"
+}
+
+// Content returns the loaded bytes, or the placeholder content while loading.
+func (res *asyncResource) Content() []byte {
+	res.lock.RLock()
+	defer res.lock.RUnlock()
+
+	if res.loaded != nil {
+		return res.loaded.Content()
+	}
+	if res.placeholder != nil {
+		return res.placeholder.Content()
+	}
+	return nil
+}
+
+func (res *asyncResource) load() {
+	loaded, err := storage.LoadResourceFromURI(res.uri)
+	if err != nil {
+		fyne.LogError("Unable to load async resource "+res.uri.String(), err)
+		return
+	}
+
+	res.lock.Lock()
+	res.loaded = loaded
+	res.lock.Unlock()
+
+	app := fyne.CurrentApp()
+	if app == nil || app.Driver() == nil {
+		return
+	}
+	for _, window := range app.Driver().AllWindows() {
+		if content := window.Content(); content != nil {
+			content.Refresh()
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	intRepo "fyne.io/fyne/v2/internal/repository"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/storage/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsyncResource(t *testing.T) {
+	repository.Register("file", intRepo.NewFileRepository()) // file uri resolving (avoid test import loop)
+
+	path := filepath.Join(t.TempDir(), "icon.svg")
+	content := []byte("<svg></svg>")
+	assert.NoError(t, os.WriteFile(path, content, 0644))
+
+	placeholder := fyne.NewStaticResource("placeholder", []byte("wait"))
+	res := AsyncResource(storage.NewFileURI(path), placeholder)
+
+	deadline := time.Now().Add(time.Second)
+	for string(res.Content()) != string(content) && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, content, res.Content())
+	assert.Equal(t, "icon.svg", res.Name())
+}
+
+func TestAsyncResource_Placeholder(t *testing.T) {
+	repository.Register("file", intRepo.NewFileRepository())
+
+	placeholder := fyne.NewStaticResource("placeholder", []byte("wait"))
+	res := AsyncResource(storage.NewFileURI("/missing/icon.svg"), placeholder)
+
+	assert.Equal(t, []byte("wait"), res.Content())
+}
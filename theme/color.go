@@ -42,6 +42,12 @@ const (
 	// Since: 1.4
 	ColorGray = internaltheme.ColorGray
 
+	// ColorNameAccent is the name of theme lookup for the OS accent color,
+	// falling back to the primary color when the system does not report one.
+	//
+	// Since: 2.6
+	ColorNameAccent fyne.ThemeColorName = "accent"
+
 	// ColorNameBackground is the name of theme lookup for background color.
 	//
 	// Since: 2.0
@@ -415,6 +421,43 @@ func PrimaryColorNames() []string {
 	return []string{ColorRed, ColorOrange, ColorYellow, ColorGreen, ColorBlue, ColorPurple, ColorBrown, ColorGray}
 }
 
+// ColorNames returns a list of all the known theme color names, so tools such as a
+// theme editor can enumerate and resolve every color a theme defines.
+//
+// Since: 2.6
+func ColorNames() []fyne.ThemeColorName {
+	return []fyne.ThemeColorName{
+		ColorNameAccent,
+		ColorNameBackground,
+		ColorNameButton,
+		ColorNameDisabled,
+		ColorNameDisabledButton,
+		ColorNameError,
+		ColorNameFocus,
+		ColorNameForeground,
+		ColorNameForegroundOnError,
+		ColorNameForegroundOnPrimary,
+		ColorNameForegroundOnSuccess,
+		ColorNameForegroundOnWarning,
+		ColorNameHeaderBackground,
+		ColorNameHover,
+		ColorNameHyperlink,
+		ColorNameInputBackground,
+		ColorNameInputBorder,
+		ColorNameMenuBackground,
+		ColorNameOverlayBackground,
+		ColorNamePlaceHolder,
+		ColorNamePressed,
+		ColorNamePrimary,
+		ColorNameScrollBar,
+		ColorNameSelection,
+		ColorNameSeparator,
+		ColorNameShadow,
+		ColorNameSuccess,
+		ColorNameWarning,
+	}
+}
+
 // ScrollBarColor returns the color (and translucency) for a scrollBar.
 //
 // Deprecated: Use Color(theme.ColorNameScrollBar) instead.
@@ -9,6 +9,21 @@ import (
 	"fyne.io/fyne/v2/theme"
 )
 
+func Test_ColorNames(t *testing.T) {
+	names := theme.ColorNames()
+	assert.Contains(t, names, theme.ColorNameBackground)
+	assert.Contains(t, names, theme.ColorNameWarning)
+
+	for _, name := range names { // every listed name resolves in the default theme
+		for _, variant := range []fyne.ThemeVariant{theme.VariantLight, theme.VariantDark} {
+			c := theme.DefaultTheme().Color(name, variant)
+			assert.NotNil(t, c, "unresolved color %s", name)
+			_, _, _, a := c.RGBA()
+			assert.NotZero(t, a, "unresolved color %s", name)
+		}
+	}
+}
+
 func Test_BackgroundColor(t *testing.T) {
 	t.Run("dark theme", func(t *testing.T) {
 		fyne.CurrentApp().Settings().SetTheme(theme.DarkTheme())
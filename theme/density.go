@@ -0,0 +1,68 @@
+package theme
+
+import (
+	"sync/atomic"
+
+	"fyne.io/fyne/v2"
+)
+
+// Density describes how tightly the built-in theme packs interface elements.
+// Denser settings shrink padding and icon sizes for data heavy desktop apps,
+// looser settings grow them so tap targets suit touch screens.
+//
+// Since: 2.6
+type Density int
+
+const (
+	// DensityNormal is the standard spacing used by the built-in theme.
+	//
+	// Since: 2.6
+	DensityNormal Density = iota
+
+	// DensityCompact reduces padding and icon sizes to fit more content on screen.
+	//
+	// Since: 2.6
+	DensityCompact
+
+	// DensityTouch increases padding and icon sizes so that minimum tap
+	// targets are comfortable on touch screens.
+	//
+	// Since: 2.6
+	DensityTouch
+)
+
+var density atomic.Int32
+
+// CurrentDensity returns the density mode that the built-in theme sizes are using.
+//
+// Since: 2.6
+func CurrentDensity() Density {
+	return Density(density.Load())
+}
+
+// SetDensity sets the density mode used by the built-in theme sizes.
+// Padding, inline icon and scroll bar sizes scale together so widget minimum
+// sizes stay coherent. If an app is running its theme is re-applied so that
+// visible widgets pick up the new sizes.
+//
+// Since: 2.6
+func SetDensity(d Density) {
+	density.Store(int32(d))
+
+	if app := fyne.CurrentApp(); app != nil {
+		if th := app.Settings().Theme(); th != nil {
+			app.Settings().SetTheme(th) // re-apply so widgets refresh with the new sizes
+		}
+	}
+}
+
+// densityScale is the multiplier the current density mode applies to spatial sizes.
+func densityScale() float32 {
+	switch CurrentDensity() {
+	case DensityCompact:
+		return 0.75
+	case DensityTouch:
+		return 1.5
+	}
+	return 1
+}
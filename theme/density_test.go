@@ -0,0 +1,32 @@
+package theme_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2/theme"
+)
+
+func TestSetDensity(t *testing.T) {
+	defer theme.SetDensity(theme.DensityNormal)
+
+	theme.SetDensity(theme.DensityNormal)
+	padding := theme.DefaultTheme().Size(theme.SizeNamePadding)
+	icon := theme.DefaultTheme().Size(theme.SizeNameInlineIcon)
+	text := theme.DefaultTheme().Size(theme.SizeNameText)
+
+	theme.SetDensity(theme.DensityCompact)
+	assert.Equal(t, theme.DensityCompact, theme.CurrentDensity())
+	assert.Less(t, theme.DefaultTheme().Size(theme.SizeNamePadding), padding)
+	assert.Less(t, theme.DefaultTheme().Size(theme.SizeNameInlineIcon), icon)
+	assert.Equal(t, text, theme.DefaultTheme().Size(theme.SizeNameText), "text size does not scale with density")
+
+	theme.SetDensity(theme.DensityTouch)
+	assert.Greater(t, theme.DefaultTheme().Size(theme.SizeNamePadding), padding)
+	assert.Greater(t, theme.DefaultTheme().Size(theme.SizeNameInlineIcon), icon)
+	assert.Equal(t, text, theme.DefaultTheme().Size(theme.SizeNameText), "text size does not scale with density")
+
+	theme.SetDensity(theme.DensityNormal)
+	assert.Equal(t, padding, theme.DefaultTheme().Size(theme.SizeNamePadding))
+}
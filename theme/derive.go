@@ -0,0 +1,187 @@
+package theme
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	col "fyne.io/fyne/v2/internal/color"
+)
+
+// Lighten returns a copy of the given color with its lightness increased by the given
+// fraction of the remaining headroom, so 0 returns the color unchanged and 1 white.
+// The alpha channel is preserved.
+//
+// Since: 2.6
+func Lighten(c color.Color, fraction float32) color.Color {
+	return shade(c, fraction)
+}
+
+// Darken returns a copy of the given color with its lightness decreased by the given
+// fraction, so 0 returns the color unchanged and 1 black. The alpha channel is preserved.
+//
+// Since: 2.6
+func Darken(c color.Color, fraction float32) color.Color {
+	return shade(c, -fraction)
+}
+
+// WithAlpha returns a copy of the given color with the alpha channel replaced.
+//
+// Since: 2.6
+func WithAlpha(c color.Color, alpha uint8) color.Color {
+	r, g, b, _ := col.ToNRGBA(c)
+	return color.NRGBA{R: uint8(r), G: uint8(g), B: uint8(b), A: alpha}
+}
+
+// WithDerivedColors returns a theme wrapping the given one that derives every color the
+// wrapped theme does not define, reported by returning nil, from its base colors.
+// A custom theme then only needs to supply a handful of names, typically background,
+// foreground and primary, instead of the full set of color names. Undefined fonts,
+// icons and sizes fall back to the default theme.
+//
+// Since: 2.6
+func WithDerivedColors(base fyne.Theme) fyne.Theme {
+	return &derivedTheme{base: base, fallback: DefaultTheme()}
+}
+
+type derivedTheme struct {
+	base, fallback fyne.Theme
+}
+
+func (t *derivedTheme) Color(n fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	if c := t.base.Color(n, v); c != nil {
+		return c
+	}
+
+	return t.derive(n, v)
+}
+
+func (t *derivedTheme) Font(style fyne.TextStyle) fyne.Resource {
+	if f := t.base.Font(style); f != nil {
+		return f
+	}
+	return t.fallback.Font(style)
+}
+
+func (t *derivedTheme) Icon(n fyne.ThemeIconName) fyne.Resource {
+	if i := t.base.Icon(n); i != nil {
+		return i
+	}
+	return t.fallback.Icon(n)
+}
+
+func (t *derivedTheme) Size(s fyne.ThemeSizeName) float32 {
+	if size := t.base.Size(s); size != 0 {
+		return size
+	}
+	return t.fallback.Size(s)
+}
+
+// baseColor looks up a color to derive from, falling back to the default theme if the
+// wrapped theme does not define it either.
+func (t *derivedTheme) baseColor(n fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	if c := t.base.Color(n, v); c != nil {
+		return c
+	}
+	return t.fallback.Color(n, v)
+}
+
+func (t *derivedTheme) derive(n fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	bg := t.baseColor(ColorNameBackground, v)
+	fg := t.baseColor(ColorNameForeground, v)
+	primary := t.baseColor(ColorNamePrimary, v)
+
+	shadeBG := func(fraction float32) color.Color {
+		if lightness(bg) < 50 { // dark surfaces get lighter shades, light surfaces darker
+			return Lighten(bg, fraction)
+		}
+		return Darken(bg, fraction)
+	}
+
+	switch n {
+	case ColorNameAccent, ColorNameHyperlink:
+		return primary
+	case ColorNameButton:
+		return shadeBG(0.08)
+	case ColorNameDisabled:
+		return WithAlpha(fg, 0x42)
+	case ColorNameDisabledButton:
+		return shadeBG(0.05)
+	case ColorNameFocus:
+		return WithAlpha(primary, 0x2a)
+	case ColorNameForegroundOnError:
+		return contrastingForeground(t.baseColor(ColorNameError, v))
+	case ColorNameForegroundOnPrimary:
+		return contrastingForeground(primary)
+	case ColorNameForegroundOnSuccess:
+		return contrastingForeground(t.baseColor(ColorNameSuccess, v))
+	case ColorNameForegroundOnWarning:
+		return contrastingForeground(t.baseColor(ColorNameWarning, v))
+	case ColorNameHeaderBackground:
+		return shadeBG(0.06)
+	case ColorNameHover:
+		return WithAlpha(fg, 0x0f)
+	case ColorNameInputBackground:
+		return shadeBG(0.04)
+	case ColorNameInputBorder:
+		return shadeBG(0.2)
+	case ColorNameMenuBackground:
+		return shadeBG(0.03)
+	case ColorNameOverlayBackground:
+		return shadeBG(0.03)
+	case ColorNamePlaceHolder:
+		return WithAlpha(fg, 0xb3)
+	case ColorNamePressed:
+		return WithAlpha(fg, 0x19)
+	case ColorNameScrollBar:
+		return WithAlpha(fg, 0x99)
+	case ColorNameSelection:
+		return WithAlpha(primary, 0x40)
+	case ColorNameSeparator:
+		return shadeBG(0.12)
+	case ColorNameShadow:
+		if lightness(bg) < 50 {
+			return color.NRGBA{A: 0x66}
+		}
+		return color.NRGBA{A: 0x33}
+	}
+
+	// semantic colors such as error, success and warning keep their built-in values
+	return t.fallback.Color(n, v)
+}
+
+// contrastingForeground picks a light or dark text color to sit on top of the given
+// color, judged by its perceived luma rather than HSL lightness so saturated colors
+// such as primary blues still get light text.
+func contrastingForeground(c color.Color) color.Color {
+	r, g, b, _ := col.ToNRGBA(c)
+	luma := (299*r + 587*g + 114*b) / 1000
+	if luma < 140 {
+		return color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	}
+	return color.NRGBA{R: 0x17, G: 0x17, B: 0x18, A: 0xff}
+}
+
+func lightness(c color.Color) int {
+	r, g, b, _ := col.ToNRGBA(c)
+	_, _, l := seedRgbToHsl(r, g, b)
+	return l
+}
+
+func shade(c color.Color, fraction float32) color.Color {
+	r, g, b, a := col.ToNRGBA(c)
+	h, s, l := seedRgbToHsl(r, g, b)
+
+	if fraction > 0 {
+		l += int(float32(100-l) * fraction)
+	} else {
+		l += int(float32(l) * fraction)
+	}
+	if l < 0 {
+		l = 0
+	} else if l > 100 {
+		l = 100
+	}
+
+	nr, ng, nb := seedHslToRgb(h, s, l)
+	return color.NRGBA{R: uint8(nr), G: uint8(ng), B: uint8(nb), A: uint8(a)}
+}
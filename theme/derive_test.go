@@ -0,0 +1,87 @@
+package theme
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// minimalTheme defines only the base colors, leaving everything else to be derived.
+type minimalTheme struct{}
+
+func (minimalTheme) Color(n fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	switch n {
+	case ColorNameBackground:
+		if v == VariantLight {
+			return color.NRGBA{R: 0xfa, G: 0xfa, B: 0xfa, A: 0xff}
+		}
+		return color.NRGBA{R: 0x18, G: 0x18, B: 0x1c, A: 0xff}
+	case ColorNameForeground:
+		if v == VariantLight {
+			return color.NRGBA{R: 0x20, G: 0x20, B: 0x20, A: 0xff}
+		}
+		return color.NRGBA{R: 0xee, G: 0xee, B: 0xee, A: 0xff}
+	case ColorNamePrimary:
+		return color.NRGBA{R: 0x29, G: 0x6f, B: 0xf6, A: 0xff}
+	}
+	return nil
+}
+
+func (minimalTheme) Font(fyne.TextStyle) fyne.Resource     { return nil }
+func (minimalTheme) Icon(fyne.ThemeIconName) fyne.Resource { return nil }
+func (minimalTheme) Size(fyne.ThemeSizeName) float32       { return 0 }
+
+func TestWithDerivedColors(t *testing.T) {
+	th := WithDerivedColors(minimalTheme{})
+
+	names := []fyne.ThemeColorName{
+		ColorNameAccent, ColorNameBackground, ColorNameButton, ColorNameDisabled,
+		ColorNameDisabledButton, ColorNameError, ColorNameFocus, ColorNameForeground,
+		ColorNameForegroundOnError, ColorNameForegroundOnPrimary, ColorNameForegroundOnSuccess,
+		ColorNameForegroundOnWarning, ColorNameHeaderBackground, ColorNameHover,
+		ColorNameHyperlink, ColorNameInputBackground, ColorNameInputBorder,
+		ColorNameMenuBackground, ColorNameOverlayBackground, ColorNamePlaceHolder,
+		ColorNamePressed, ColorNamePrimary, ColorNameScrollBar, ColorNameSelection,
+		ColorNameSeparator, ColorNameShadow, ColorNameSuccess, ColorNameWarning,
+	}
+	for _, name := range names {
+		for _, variant := range []fyne.ThemeVariant{VariantLight, VariantDark} {
+			assert.NotNil(t, th.Color(name, variant), "underived color %s", name)
+		}
+	}
+
+	// defined colors pass through unchanged
+	assert.Equal(t, minimalTheme{}.Color(ColorNamePrimary, VariantDark), th.Color(ColorNamePrimary, VariantDark))
+	// derived shades move away from the background in the right direction per variant
+	assert.Greater(t, lightness(th.Color(ColorNameButton, VariantDark)), lightness(th.Color(ColorNameBackground, VariantDark)))
+	assert.Less(t, lightness(th.Color(ColorNameButton, VariantLight)), lightness(th.Color(ColorNameBackground, VariantLight)))
+	// contrasting text lands on the opposite side of its base color
+	assert.Equal(t, color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, th.Color(ColorNameForegroundOnPrimary, VariantDark))
+
+	// fonts and sizes fall back to the default theme
+	assert.Equal(t, DefaultTheme().Size(SizeNameText), th.Size(SizeNameText))
+	assert.Equal(t, DefaultTheme().Font(fyne.TextStyle{}), th.Font(fyne.TextStyle{}))
+	assert.Equal(t, DefaultTheme().Icon(IconNameCancel), th.Icon(IconNameCancel))
+}
+
+func TestLighten_Darken(t *testing.T) {
+	base := color.NRGBA{R: 0x80, G: 0x80, B: 0x80, A: 0xcc}
+
+	lighter := Lighten(base, 0.5)
+	assert.Greater(t, lightness(lighter), lightness(base))
+	assert.Equal(t, uint8(0xcc), lighter.(color.NRGBA).A)
+
+	darker := Darken(base, 0.5)
+	assert.Less(t, lightness(darker), lightness(base))
+
+	assert.Equal(t, 100, lightness(Lighten(base, 1)))
+	assert.Equal(t, 0, lightness(Darken(base, 1)))
+}
+
+func TestWithAlpha(t *testing.T) {
+	c := WithAlpha(color.NRGBA{R: 0x10, G: 0x20, B: 0x30, A: 0xff}, 0x66)
+	assert.Equal(t, color.NRGBA{R: 0x10, G: 0x20, B: 0x30, A: 0x66}, c)
+}
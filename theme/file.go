@@ -0,0 +1,73 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal/build"
+)
+
+// FromFile returns a Theme created from the declarative theme document at the given path.
+// JSON and TOML documents are supported, selected by the file extension.
+// Any values not present in the data will fall back to the default theme.
+// When running a debug build the file is also watched, and the application theme is
+// reloaded whenever it is saved, so themes can be iterated on without recompiling.
+//
+// Since: 2.6
+func FromFile(path string) (fyne.Theme, error) {
+	th, err := loadThemeFile(path)
+	if err != nil {
+		return th, err
+	}
+
+	if build.Mode == fyne.BuildDebug {
+		go watchThemeFile(path)
+	}
+
+	return th, nil
+}
+
+func loadThemeFile(path string) (fyne.Theme, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return DefaultTheme(), err
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		return FromTOMLReader(f)
+	}
+	return FromJSONReader(f)
+}
+
+func watchThemeFile(path string) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		fyne.LogError("Failed to watch theme file for changes: "+path, err)
+		return
+	}
+	if err = watcher.Add(path); err != nil {
+		fyne.LogError("Failed to watch theme file for changes: "+path, err)
+		watcher.Close()
+		return
+	}
+
+	for event := range watcher.Events {
+		if !event.Op.Has(fsnotify.Write) && !event.Op.Has(fsnotify.Create) {
+			continue
+		}
+
+		th, err := loadThemeFile(path)
+		if err != nil {
+			fyne.LogError("Failed to reload theme file: "+path, err)
+			continue
+		}
+		if app := fyne.CurrentApp(); app != nil {
+			app.Settings().SetTheme(th)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"image/color"
 
 	"fyne.io/fyne/v2"
+	internaltheme "fyne.io/fyne/v2/internal/theme"
 )
 
 // DefaultEmojiFont returns the font resource for the built-in emoji font.
@@ -53,6 +54,25 @@ func Font(style fyne.TextStyle) fyne.Resource {
 	return safeFontLookup(style)
 }
 
+// RegisterFont adds a font resource to the runtime fallback chain, consulted when
+// neither the themed fonts nor any script specific font contain a glyph for a rune.
+// Fonts should be registered before the text using them is rendered.
+//
+// Since: 2.6
+func RegisterFont(font fyne.Resource) {
+	internaltheme.AddFallbackFont(font)
+}
+
+// RegisterFontForScript registers a font resource used for runes of the given Unicode
+// script, identified by its four letter ISO 15924 code such as "Hani", "Arab" or "Deva".
+// Script fonts take priority over the general fallback chain registered with RegisterFont.
+// Fonts should be registered before the text using them is rendered.
+//
+// Since: 2.6
+func RegisterFontForScript(font fyne.Resource, script string) {
+	internaltheme.AddScriptFont(script, font)
+}
+
 // TextBoldFont returns the font resource for the bold font style.
 func TextBoldFont() fyne.Resource {
 	return safeFontLookup(fyne.TextStyle{Bold: true})
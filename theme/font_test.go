@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"fyne.io/fyne/v2"
+	internaltheme "fyne.io/fyne/v2/internal/theme"
 	"fyne.io/fyne/v2/theme"
 )
 
@@ -50,6 +51,17 @@ func Test_DefaultTextMonospaceFont(t *testing.T) {
 	assert.Equal(t, expect, result, "wrong default monospace font")
 }
 
+func Test_RegisterFont(t *testing.T) {
+	fallback := fyne.NewStaticResource("Fallback.ttf", []byte{})
+	theme.RegisterFont(fallback)
+	assert.Contains(t, internaltheme.FallbackFonts(), fyne.Resource(fallback))
+
+	han := fyne.NewStaticResource("Han.ttf", []byte{})
+	theme.RegisterFontForScript(han, "Hani")
+	assert.Contains(t, internaltheme.ScriptFonts("Hani"), fyne.Resource(han))
+	assert.NotContains(t, internaltheme.FallbackFonts(), fyne.Resource(han))
+}
+
 func Test_TextBoldFont(t *testing.T) {
 	fyne.CurrentApp().Settings().SetTheme(theme.DarkTheme())
 	expect := "NotoSans-Bold.ttf"
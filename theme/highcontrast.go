@@ -0,0 +1,88 @@
+package theme
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+)
+
+var (
+	colorHighContrastDarkBackground  = color.NRGBA{A: 0xff}
+	colorHighContrastDarkButton      = color.NRGBA{R: 0x10, G: 0x10, B: 0x10, A: 0xff}
+	colorHighContrastDarkDisabled    = color.NRGBA{R: 0x9d, G: 0x9d, B: 0x9d, A: 0xff}
+	colorHighContrastDarkError       = color.NRGBA{R: 0xff, G: 0x80, B: 0x80, A: 0xff}
+	colorHighContrastDarkForeground  = color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	colorHighContrastDarkHover       = color.NRGBA{R: 0x40, G: 0x40, B: 0x40, A: 0xff}
+	colorHighContrastDarkHyperlink   = color.NRGBA{R: 0x00, G: 0xff, B: 0xff, A: 0xff}
+	colorHighContrastDarkPlaceholder = color.NRGBA{R: 0xc0, G: 0xc0, B: 0xc0, A: 0xff}
+	colorHighContrastDarkPressed     = color.NRGBA{R: 0x66, G: 0x66, B: 0x66, A: 0xff}
+	colorHighContrastDarkPrimary     = color.NRGBA{R: 0xff, G: 0xff, B: 0x00, A: 0xff}
+	colorHighContrastDarkSelection   = color.NRGBA{R: 0x00, G: 0x33, B: 0x99, A: 0xff}
+	colorHighContrastDarkSuccess     = color.NRGBA{R: 0x66, G: 0xff, B: 0x66, A: 0xff}
+	colorHighContrastDarkWarning     = color.NRGBA{R: 0xff, G: 0xd7, B: 0x00, A: 0xff}
+
+	colorHighContrastLightBackground  = color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	colorHighContrastLightButton      = color.NRGBA{R: 0xf0, G: 0xf0, B: 0xf0, A: 0xff}
+	colorHighContrastLightDisabled    = color.NRGBA{R: 0x59, G: 0x59, B: 0x59, A: 0xff}
+	colorHighContrastLightError       = color.NRGBA{R: 0xcc, G: 0x00, B: 0x00, A: 0xff}
+	colorHighContrastLightForeground  = color.NRGBA{A: 0xff}
+	colorHighContrastLightHover       = color.NRGBA{R: 0xcc, G: 0xcc, B: 0xcc, A: 0xff}
+	colorHighContrastLightHyperlink   = color.NRGBA{R: 0x00, G: 0x00, B: 0xee, A: 0xff}
+	colorHighContrastLightPlaceholder = color.NRGBA{R: 0x45, G: 0x45, B: 0x45, A: 0xff}
+	colorHighContrastLightPressed     = color.NRGBA{R: 0x99, G: 0x99, B: 0x99, A: 0xff}
+	colorHighContrastLightPrimary     = color.NRGBA{R: 0x00, G: 0x00, B: 0xcc, A: 0xff}
+	colorHighContrastLightSelection   = color.NRGBA{R: 0x99, G: 0xc2, B: 0xff, A: 0xff}
+	colorHighContrastLightSuccess     = color.NRGBA{R: 0x00, G: 0x66, B: 0x00, A: 0xff}
+	colorHighContrastLightWarning     = color.NRGBA{R: 0x99, G: 0x5c, B: 0x00, A: 0xff}
+)
+
+// highContrastPaletteColorNamed returns accessible color values for the high
+// contrast theme variants. Unlike the light and dark palettes, the primary
+// color preference is ignored so contrast ratios cannot be reduced.
+func highContrastPaletteColorNamed(name fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	dark := v == VariantHighContrastDark
+
+	pick := func(d, l color.Color) color.Color {
+		if dark {
+			return d
+		}
+		return l
+	}
+
+	switch name {
+	case ColorNameBackground, ColorNameHeaderBackground, ColorNameInputBackground,
+		ColorNameMenuBackground, ColorNameOverlayBackground:
+		return pick(colorHighContrastDarkBackground, colorHighContrastLightBackground)
+	case ColorNameButton, ColorNameDisabledButton:
+		return pick(colorHighContrastDarkButton, colorHighContrastLightButton)
+	case ColorNameDisabled:
+		return pick(colorHighContrastDarkDisabled, colorHighContrastLightDisabled)
+	case ColorNameError:
+		return pick(colorHighContrastDarkError, colorHighContrastLightError)
+	case ColorNameAccent, ColorNameFocus, ColorNamePrimary:
+		return pick(colorHighContrastDarkPrimary, colorHighContrastLightPrimary)
+	case ColorNameForeground, ColorNameInputBorder, ColorNameScrollBar, ColorNameSeparator:
+		return pick(colorHighContrastDarkForeground, colorHighContrastLightForeground)
+	case ColorNameForegroundOnError, ColorNameForegroundOnPrimary, ColorNameForegroundOnSuccess,
+		ColorNameForegroundOnWarning:
+		return pick(colorHighContrastDarkBackground, colorHighContrastLightBackground)
+	case ColorNameHover:
+		return pick(colorHighContrastDarkHover, colorHighContrastLightHover)
+	case ColorNameHyperlink:
+		return pick(colorHighContrastDarkHyperlink, colorHighContrastLightHyperlink)
+	case ColorNamePlaceHolder:
+		return pick(colorHighContrastDarkPlaceholder, colorHighContrastLightPlaceholder)
+	case ColorNamePressed:
+		return pick(colorHighContrastDarkPressed, colorHighContrastLightPressed)
+	case ColorNameSelection:
+		return pick(colorHighContrastDarkSelection, colorHighContrastLightSelection)
+	case ColorNameShadow:
+		return color.Transparent
+	case ColorNameSuccess:
+		return pick(colorHighContrastDarkSuccess, colorHighContrastLightSuccess)
+	case ColorNameWarning:
+		return pick(colorHighContrastDarkWarning, colorHighContrastLightWarning)
+	}
+
+	return color.Transparent
+}
@@ -1186,13 +1186,21 @@ func MoveUpIcon() fyne.Resource {
 	return safeIconLookup(IconNameMoveUp)
 }
 
-// NavigateBackIcon returns a resource containing the standard backward navigation icon for the current theme
+// NavigateBackIcon returns a resource containing the standard backward navigation icon for the current theme.
+// In a right-to-left layout direction the arrow points the other way.
 func NavigateBackIcon() fyne.Resource {
+	if fyne.CurrentLayoutDirection() == fyne.LayoutDirectionRightToLeft {
+		return safeIconLookup(IconNameNavigateNext)
+	}
 	return safeIconLookup(IconNameNavigateBack)
 }
 
-// NavigateNextIcon returns a resource containing the standard forward navigation icon for the current theme
+// NavigateNextIcon returns a resource containing the standard forward navigation icon for the current theme.
+// In a right-to-left layout direction the arrow points the other way.
 func NavigateNextIcon() fyne.Resource {
+	if fyne.CurrentLayoutDirection() == fyne.LayoutDirectionRightToLeft {
+		return safeIconLookup(IconNameNavigateBack)
+	}
 	return safeIconLookup(IconNameNavigateNext)
 }
 
@@ -1339,7 +1347,41 @@ func WindowMinimizeIcon() fyne.Resource {
 	return safeIconLookup(IconNameWindowMinimize)
 }
 
+// IconProviderKind is the extension point kind under which icon packs are registered
+// using fyne.RegisterProvider. Implementations must satisfy the IconPack interface.
+//
+// Since: 2.6
+const IconProviderKind = "theme.icons"
+
+// IconPack is implemented by providers registered under IconProviderKind to replace
+// standard icons across all themes, returning nil for any icon they do not supply.
+//
+// Since: 2.6
+type IconPack interface {
+	ThemeIcon(fyne.ThemeIconName) fyne.Resource
+}
+
+// providedIcon asks registered icon pack providers for a replacement icon,
+// preferring the most recently registered pack.
+func providedIcon(n fyne.ThemeIconName) fyne.Resource {
+	packs := fyne.Providers(IconProviderKind)
+	for i := len(packs) - 1; i >= 0; i-- {
+		pack, ok := packs[i].(IconPack)
+		if !ok {
+			continue
+		}
+		if icon := pack.ThemeIcon(n); icon != nil {
+			return icon
+		}
+	}
+	return nil
+}
+
 func safeIconLookup(n fyne.ThemeIconName) fyne.Resource {
+	if icon := providedIcon(n); icon != nil {
+		return icon
+	}
+
 	icon := Current().Icon(n)
 	if icon == nil {
 		fyne.LogError("Loaded theme returned nil icon", nil)
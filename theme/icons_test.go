@@ -447,3 +447,27 @@ func Test_GridIcon_FileSource(t *testing.T) {
 	result := theme.GridIcon().Name()
 	assert.Equal(t, "foreground_grid.svg", result)
 }
+
+type testIconPack struct {
+	active bool
+	res    fyne.Resource
+}
+
+func (p *testIconPack) ThemeIcon(n fyne.ThemeIconName) fyne.Resource {
+	if p.active && n == theme.IconNameHome {
+		return p.res
+	}
+	return nil
+}
+
+func Test_IconPackProvider(t *testing.T) {
+	pack := &testIconPack{res: fyne.NewStaticResource("pack_home.svg", []byte{})}
+	fyne.RegisterProvider(theme.IconProviderKind, pack)
+	defer func() { pack.active = false }()
+
+	assert.NotEqual(t, "pack_home.svg", theme.HomeIcon().Name())
+
+	pack.active = true
+	assert.Equal(t, "pack_home.svg", theme.HomeIcon().Name())
+	assert.NotEqual(t, "pack_home.svg", theme.ComputerIcon().Name())
+}
@@ -93,13 +93,13 @@ func (u uriString) resource() fyne.Resource {
 }
 
 type schema struct {
-	Colors      map[string]hexColor `json:"Colors,omitempty"`
-	DarkColors  map[string]hexColor `json:"Colors-dark,omitempty"`
-	LightColors map[string]hexColor `json:"Colors-light,omitempty"`
-	Sizes       map[string]float32  `json:"Sizes,omitempty"`
+	Colors      map[string]hexColor `json:"Colors,omitempty" toml:"Colors,omitempty"`
+	DarkColors  map[string]hexColor `json:"Colors-dark,omitempty" toml:"Colors-dark,omitempty"`
+	LightColors map[string]hexColor `json:"Colors-light,omitempty" toml:"Colors-light,omitempty"`
+	Sizes       map[string]float32  `json:"Sizes,omitempty" toml:"Sizes,omitempty"`
 
-	Fonts map[string]uriString `json:"Fonts,omitempty"`
-	Icons map[string]uriString `json:"Icons,omitempty"`
+	Fonts map[string]uriString `json:"Fonts,omitempty" toml:"Fonts,omitempty"`
+	Icons map[string]uriString `json:"Icons,omitempty" toml:"Icons,omitempty"`
 }
 
 type jsonTheme struct {
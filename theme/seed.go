@@ -0,0 +1,216 @@
+package theme
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	col "fyne.io/fyne/v2/internal/color"
+	internaltheme "fyne.io/fyne/v2/internal/theme"
+)
+
+// FromSeedColor returns a Theme whose light and dark palettes are generated from the
+// hue of the given seed color following tonal palette rules, in the style of dynamic
+// color personalization. The primary, accent and surface tones all derive from the
+// seed, while semantic colors such as error, success and warning keep their built-in
+// values. Fonts, icons and sizes come from the default theme.
+//
+// Since: 2.6
+func FromSeedColor(seed color.Color) fyne.Theme {
+	h, s := seedHSL(seed)
+	return &seedTheme{hue: h, saturation: s, fallback: DefaultTheme()}
+}
+
+type seedTheme struct {
+	hue, saturation int
+	fallback        fyne.Theme
+}
+
+func (t *seedTheme) Color(n fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	if v == internaltheme.VariantNameUserPreference {
+		v = fyne.CurrentApp().Settings().ThemeVariant()
+	}
+	light := v == VariantLight || v == VariantHighContrastLight
+
+	switch n {
+	case ColorNameAccent, ColorNamePrimary:
+		return t.tone(light, 45, 70, 1.0, 0xff)
+	case ColorNameHyperlink:
+		return t.tone(light, 40, 75, 1.0, 0xff)
+	case ColorNameFocus:
+		return t.tone(light, 45, 70, 1.0, 0x66)
+	case ColorNameSelection:
+		return t.tone(light, 45, 70, 1.0, 0x44)
+	case ColorNameForegroundOnPrimary:
+		if light { // the light variant primary tone is dark enough for light text
+			return color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+		}
+		return color.NRGBA{R: 0x17, G: 0x17, B: 0x18, A: 0xff}
+	case ColorNameBackground:
+		return t.tone(light, 98, 10, 0.12, 0xff)
+	case ColorNameHeaderBackground:
+		return t.tone(light, 95, 13, 0.12, 0xff)
+	case ColorNameInputBackground:
+		return t.tone(light, 95, 15, 0.12, 0xff)
+	case ColorNameMenuBackground:
+		return t.tone(light, 97, 15, 0.12, 0xff)
+	case ColorNameOverlayBackground:
+		return t.tone(light, 97, 13, 0.12, 0xff)
+	case ColorNameButton:
+		return t.tone(light, 93, 17, 0.15, 0xff)
+	case ColorNameDisabledButton:
+		return t.tone(light, 90, 13, 0.1, 0xff)
+	case ColorNameHover:
+		return t.tone(light, 90, 25, 0.12, 0xff)
+	case ColorNamePressed:
+		return t.tone(light, 85, 30, 0.12, 0xff)
+	case ColorNameForeground:
+		return t.tone(light, 12, 92, 0.08, 0xff)
+	case ColorNameDisabled:
+		return t.tone(light, 62, 40, 0.08, 0xff)
+	case ColorNamePlaceHolder:
+		return t.tone(light, 45, 60, 0.1, 0xff)
+	case ColorNameScrollBar:
+		return t.tone(light, 55, 45, 0.1, 0xff)
+	case ColorNameInputBorder, ColorNameSeparator:
+		return t.tone(light, 80, 30, 0.15, 0xff)
+	}
+
+	// semantic and remaining colors keep their built-in palette values
+	if light {
+		return lightPaletteColorNamed(n)
+	}
+	return darkPaletteColorNamed(n)
+}
+
+func (t *seedTheme) Font(style fyne.TextStyle) fyne.Resource {
+	return t.fallback.Font(style)
+}
+
+func (t *seedTheme) Icon(n fyne.ThemeIconName) fyne.Resource {
+	return t.fallback.Icon(n)
+}
+
+func (t *seedTheme) Size(s fyne.ThemeSizeName) float32 {
+	return t.fallback.Size(s)
+}
+
+// tone returns a color with the seed hue at the lightness for the requested variant,
+// with the seed saturation scaled down for neutral surface tones.
+func (t *seedTheme) tone(light bool, lightLightness, darkLightness int, satScale float64, alpha uint8) color.Color {
+	l := darkLightness
+	if light {
+		l = lightLightness
+	}
+	s := int(float64(t.saturation) * satScale)
+	if s > 90 {
+		s = 90
+	}
+
+	r, g, b := seedHslToRgb(t.hue, s, l)
+	return color.NRGBA{R: uint8(r), G: uint8(g), B: uint8(b), A: alpha}
+}
+
+func seedHSL(c color.Color) (h, s int) {
+	r, g, b, _ := col.ToNRGBA(c)
+	h, s, _ = seedRgbToHsl(r, g, b)
+	return h, s
+}
+
+// https://www.niwa.nu/2013/05/math-behind-colorspace-conversions-rgb-hsl/
+
+func seedRgbToHsl(r, g, b int) (int, int, int) {
+	red := float64(r) / 255.0
+	green := float64(g) / 255.0
+	blue := float64(b) / 255.0
+
+	min := math.Min(red, math.Min(green, blue))
+	max := math.Max(red, math.Max(green, blue))
+
+	lightness := (max + min) / 2.0
+
+	delta := max - min
+
+	if delta == 0.0 {
+		// Achromatic
+		return 0, 0, int(lightness * 100.0)
+	}
+
+	// Chromatic
+
+	var saturation float64
+
+	if lightness < 0.5 {
+		saturation = (max - min) / (max + min)
+	} else {
+		saturation = (max - min) / (2.0 - max - min)
+	}
+
+	var hue float64
+
+	if red == max {
+		hue = (green - blue) / delta
+	} else if green == max {
+		hue = 2.0 + (blue-red)/delta
+	} else if blue == max {
+		hue = 4.0 + (red-green)/delta
+	}
+
+	h := int(hue * 60.0)
+	for h < 0 {
+		h += 360
+	}
+	s := int(saturation * 100.0)
+	l := int(lightness * 100.0)
+	return h, s, l
+}
+
+func seedHslToRgb(h, s, l int) (int, int, int) {
+	hue := float64(h) / 360.0
+	saturation := float64(s) / 100.0
+	lightness := float64(l) / 100.0
+
+	if saturation == 0.0 {
+		// Greyscale
+		g := int(lightness * 255.0)
+		return g, g, g
+	}
+
+	var v1 float64
+	if lightness < 0.5 {
+		v1 = lightness * (1.0 + saturation)
+	} else {
+		v1 = (lightness + saturation) - (lightness * saturation)
+	}
+
+	v2 := 2.0*lightness - v1
+
+	red := seedHueToChannel(hue+(1.0/3.0), v1, v2)
+	green := seedHueToChannel(hue, v1, v2)
+	blue := seedHueToChannel(hue-(1.0/3.0), v1, v2)
+
+	r := int(math.Round(255.0 * red))
+	g := int(math.Round(255.0 * green))
+	b := int(math.Round(255.0 * blue))
+
+	return r, g, b
+}
+
+func seedHueToChannel(h, v1, v2 float64) float64 {
+	for h < 0.0 {
+		h += 1.0
+	}
+	for h > 1.0 {
+		h -= 1.0
+	}
+	if 6.0*h < 1.0 {
+		return v2 + (v1-v2)*6*h
+	}
+	if 2.0*h < 1.0 {
+		return v1
+	}
+	if 3.0*h < 2.0 {
+		return v2 + (v1-v2)*6*((2.0/3.0)-h)
+	}
+	return v2
+}
@@ -0,0 +1,54 @@
+package theme
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromSeedColor(t *testing.T) {
+	th := FromSeedColor(color.NRGBA{R: 0x29, G: 0x6f, B: 0xf6, A: 0xff}) // a blue seed
+
+	names := []fyne.ThemeColorName{
+		ColorNameAccent, ColorNameBackground, ColorNameButton, ColorNameDisabled,
+		ColorNameDisabledButton, ColorNameError, ColorNameFocus, ColorNameForeground,
+		ColorNameForegroundOnError, ColorNameForegroundOnPrimary, ColorNameForegroundOnSuccess,
+		ColorNameForegroundOnWarning, ColorNameHeaderBackground, ColorNameHover,
+		ColorNameHyperlink, ColorNameInputBackground, ColorNameInputBorder,
+		ColorNameMenuBackground, ColorNameOverlayBackground, ColorNamePlaceHolder,
+		ColorNamePressed, ColorNamePrimary, ColorNameScrollBar, ColorNameSelection,
+		ColorNameSeparator, ColorNameShadow, ColorNameSuccess, ColorNameWarning,
+	}
+	for _, name := range names {
+		for _, variant := range []fyne.ThemeVariant{VariantLight, VariantDark} {
+			assert.NotNil(t, th.Color(name, variant), "undefined color %s", name)
+			assert.NotEqual(t, color.Transparent, th.Color(name, variant), "undefined color %s", name)
+		}
+	}
+
+	// surfaces differ per variant and primary keeps the seed hue
+	assert.NotEqual(t, th.Color(ColorNameBackground, VariantLight), th.Color(ColorNameBackground, VariantDark))
+	primary := th.Color(ColorNamePrimary, VariantLight).(color.NRGBA)
+	h, _, _ := seedRgbToHsl(int(primary.R), int(primary.G), int(primary.B))
+	seedHue, _, _ := seedRgbToHsl(0x29, 0x6f, 0xf6)
+	assert.InDelta(t, seedHue, h, 2)
+
+	// semantic colors keep the built-in palette values
+	assert.Equal(t, colorDarkError, th.Color(ColorNameError, VariantDark))
+	assert.Equal(t, colorLightError, th.Color(ColorNameError, VariantLight))
+
+	// fonts and sizes come from the default theme
+	assert.Equal(t, DefaultTheme().Size(SizeNameText), th.Size(SizeNameText))
+	assert.Equal(t, DefaultTheme().Font(fyne.TextStyle{}), th.Font(fyne.TextStyle{}))
+}
+
+func TestFromSeedColor_Grayscale(t *testing.T) {
+	th := FromSeedColor(color.Gray{Y: 0x80})
+
+	bg := th.Color(ColorNameBackground, VariantDark).(color.NRGBA)
+	assert.Equal(t, bg.R, bg.G) // an achromatic seed yields neutral surfaces
+	assert.Equal(t, bg.G, bg.B)
+}
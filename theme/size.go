@@ -76,9 +76,33 @@ const (
 	// SizeNameScrollBarRadius is the name of theme lookup for the scroll bar corner radius.
 	//
 	// Since: 2.5
-	SizeNameScrollBarRadius = "scrollBarRadius"
+	SizeNameScrollBarRadius fyne.ThemeSizeName = "scrollBarRadius"
 )
 
+// SizeNames returns a list of all the known theme size names, so tools such as a
+// theme editor can enumerate and resolve every size a theme defines.
+//
+// Since: 2.6
+func SizeNames() []fyne.ThemeSizeName {
+	return []fyne.ThemeSizeName{
+		SizeNameCaptionText,
+		SizeNameHeadingText,
+		SizeNameInlineIcon,
+		SizeNameInnerPadding,
+		SizeNameInputBorder,
+		SizeNameInputRadius,
+		SizeNameLineSpacing,
+		SizeNamePadding,
+		SizeNameScrollBar,
+		SizeNameScrollBarRadius,
+		SizeNameScrollBarSmall,
+		SizeNameSelectionRadius,
+		SizeNameSeparatorThickness,
+		SizeNameSubHeadingText,
+		SizeNameText,
+	}
+}
+
 // CaptionTextSize returns the size for caption text.
 func CaptionTextSize() float32 {
 	return Current().Size(SizeNameCaptionText)
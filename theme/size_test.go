@@ -9,6 +9,16 @@ import (
 	"fyne.io/fyne/v2/theme"
 )
 
+func Test_SizeNames(t *testing.T) {
+	names := theme.SizeNames()
+	assert.Contains(t, names, theme.SizeNamePadding)
+	assert.Contains(t, names, theme.SizeNameScrollBarRadius)
+
+	for _, name := range names { // every listed name resolves in the default theme
+		assert.NotZero(t, theme.DefaultTheme().Size(name), "unresolved size %s", name)
+	}
+}
+
 func Test_IconInlineSize(t *testing.T) {
 	fyne.CurrentApp().Settings().SetTheme(theme.DarkTheme())
 	assert.Equal(t, theme.DarkTheme().Size(theme.SizeNameInlineIcon), theme.IconInlineSize(), "wrong inline icon size")
@@ -22,6 +22,24 @@ const (
 	//
 	// Since: 2.0
 	VariantLight = internaltheme.VariantLight
+
+	// VariantHighContrastDark is the version of a theme that satisfies a user preference for
+	// high contrast colors on a dark background, as set by OS accessibility options.
+	//
+	// Since: 2.6
+	VariantHighContrastDark = internaltheme.VariantHighContrastDark
+
+	// VariantHighContrastLight is the version of a theme that satisfies a user preference for
+	// high contrast colors on a light background, as set by OS accessibility options.
+	//
+	// Since: 2.6
+	VariantHighContrastLight = internaltheme.VariantHighContrastLight
+
+	// VariantUserDefined is the first variant value available to applications that define
+	// custom theme variants, guaranteed not to collide with built-in variants.
+	//
+	// Since: 2.6
+	VariantUserDefined = internaltheme.VariantUserDefined
 )
 
 var defaultTheme fyne.Theme
@@ -101,7 +119,17 @@ func (t *builtinTheme) Color(n fyne.ThemeColorName, v fyne.ThemeVariant) color.C
 		v = t.variant
 	}
 
+	if v == VariantHighContrastDark || v == VariantHighContrastLight {
+		return highContrastPaletteColorNamed(n, v) // accessible values override the primary color preference
+	}
+
 	primary := fyne.CurrentApp().Settings().PrimaryColor()
+	if n == ColorNameAccent {
+		if accent, ok := internaltheme.SystemAccentColor(); ok {
+			return accent
+		}
+		return internaltheme.PrimaryColorNamed(primary)
+	}
 	if n == ColorNamePrimary || n == ColorNameHyperlink {
 		return internaltheme.PrimaryColorNamed(primary)
 	} else if n == ColorNameForegroundOnPrimary {
@@ -143,15 +171,15 @@ func (t *builtinTheme) Size(s fyne.ThemeSizeName) float32 {
 	case SizeNameSeparatorThickness:
 		return 1
 	case SizeNameInlineIcon:
-		return 20
+		return 20 * densityScale()
 	case SizeNameInnerPadding:
-		return 8
+		return 8 * densityScale()
 	case SizeNameLineSpacing:
-		return 4
+		return 4 * densityScale()
 	case SizeNamePadding:
-		return 4
+		return 4 * densityScale()
 	case SizeNameScrollBar:
-		return 12
+		return 12 * densityScale()
 	case SizeNameScrollBarSmall:
 		return 3
 	case SizeNameText:
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"fyne.io/fyne/v2"
+	internaltheme "fyne.io/fyne/v2/internal/theme"
 	"fyne.io/fyne/v2/test"
 	"fyne.io/fyne/v2/theme"
 )
@@ -15,6 +16,49 @@ func Test_DefaultTheme_AllColorsDefined(t *testing.T) {
 	test.AssertAllColorNamesDefined(t, theme.DefaultTheme(), "default")
 }
 
+func Test_DefaultTheme_AccentColor(t *testing.T) {
+	defaultTheme := theme.DefaultTheme()
+
+	// without a system accent color the primary color preference is used
+	assert.Equal(t, defaultTheme.Color(theme.ColorNamePrimary, theme.VariantDark),
+		defaultTheme.Color(theme.ColorNameAccent, theme.VariantDark))
+
+	// the accent color persists for the process, matching how the OS reports it
+	accent := color.NRGBA{R: 0x12, G: 0x34, B: 0x56, A: 0xff}
+	internaltheme.SetSystemAccentColor(accent)
+	assert.Equal(t, accent, defaultTheme.Color(theme.ColorNameAccent, theme.VariantDark))
+}
+
+func Test_DefaultTheme_HighContrast(t *testing.T) {
+	defaultTheme := theme.DefaultTheme()
+
+	black := color.NRGBA{A: 0xff}
+	white := color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	assert.Equal(t, black, defaultTheme.Color(theme.ColorNameBackground, theme.VariantHighContrastDark))
+	assert.Equal(t, white, defaultTheme.Color(theme.ColorNameForeground, theme.VariantHighContrastDark))
+	assert.Equal(t, white, defaultTheme.Color(theme.ColorNameBackground, theme.VariantHighContrastLight))
+	assert.Equal(t, black, defaultTheme.Color(theme.ColorNameForeground, theme.VariantHighContrastLight))
+
+	// the primary color preference is ignored so contrast ratios cannot be reduced
+	assert.NotEqual(t, defaultTheme.Color(theme.ColorNamePrimary, theme.VariantDark),
+		defaultTheme.Color(theme.ColorNamePrimary, theme.VariantHighContrastDark))
+
+	names := []fyne.ThemeColorName{
+		theme.ColorNameBackground, theme.ColorNameButton, theme.ColorNameDisabled,
+		theme.ColorNameDisabledButton, theme.ColorNameError, theme.ColorNameFocus,
+		theme.ColorNameForeground, theme.ColorNameForegroundOnError, theme.ColorNameForegroundOnPrimary,
+		theme.ColorNameForegroundOnSuccess, theme.ColorNameForegroundOnWarning, theme.ColorNameHeaderBackground,
+		theme.ColorNameHover, theme.ColorNameHyperlink, theme.ColorNameInputBackground,
+		theme.ColorNameInputBorder, theme.ColorNameMenuBackground, theme.ColorNameOverlayBackground,
+		theme.ColorNamePlaceHolder, theme.ColorNamePressed, theme.ColorNamePrimary,
+		theme.ColorNameScrollBar, theme.ColorNameSelection, theme.ColorNameSeparator,
+		theme.ColorNameSuccess, theme.ColorNameWarning,
+	}
+	for _, name := range names { // shadow is skipped, high contrast intentionally drops it
+		assert.NotEqual(t, color.Transparent, defaultTheme.Color(name, theme.VariantHighContrastDark), "missing high contrast value for %s", name)
+	}
+}
+
 func Test_DefaultTheme_PrimaryForegroundColor(t *testing.T) {
 	darkColor := color.NRGBA{R: 0x17, G: 0x17, B: 0x18, A: 0xff}
 	defaultTheme := theme.DefaultTheme()
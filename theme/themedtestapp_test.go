@@ -104,5 +104,13 @@ func (t *themedApp) ShowAnimations() bool {
 	return true
 }
 
+func (t *themedApp) ReducedMotion() bool {
+	return false
+}
+
+func (t *themedApp) AnimationScale() float32 {
+	return 1
+}
+
 func (t *themedApp) AddChangeListener(chan fyne.Settings) {
 }
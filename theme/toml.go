@@ -0,0 +1,33 @@
+package theme
+
+import (
+	"io"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+
+	"fyne.io/fyne/v2"
+)
+
+// FromTOML returns a Theme created from the given TOML metadata.
+// Any values not present in the data will fall back to the default theme.
+// If a parse error occurs it will be returned along with a default theme.
+//
+// Since: 2.6
+func FromTOML(data string) (fyne.Theme, error) {
+	return FromTOMLReader(strings.NewReader(data))
+}
+
+// FromTOMLReader returns a Theme created from the given TOML metadata through the reader.
+// Any values not present in the data will fall back to the default theme.
+// If a parse error occurs it will be returned along with a default theme.
+//
+// Since: 2.6
+func FromTOMLReader(r io.Reader) (fyne.Theme, error) {
+	th := &schema{}
+	if _, err := toml.NewDecoder(r).Decode(th); err != nil {
+		return DefaultTheme(), err
+	}
+
+	return &jsonTheme{data: th, fallback: DefaultTheme()}, nil
+}
@@ -0,0 +1,60 @@
+package theme
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	intRepo "fyne.io/fyne/v2/internal/repository"
+	"fyne.io/fyne/v2/storage/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromTOML(t *testing.T) {
+	repository.Register("file", intRepo.NewFileRepository()) // file uri resolving (avoid test import loop)
+	th, err := FromTOML(`
+[Colors]
+background = "#c0c0c0ff"
+
+["Colors-light"]
+foreground = "#ffffffff"
+
+[Sizes]
+iconInline = 5.0
+
+[Fonts]
+monospace = "file://./testdata/NotoMono-Regular.ttf"
+
+[Icons]
+cancel = "file://./testdata/cancel_Paths.svg"
+`)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &color.NRGBA{R: 0xc0, G: 0xc0, B: 0xc0, A: 0xff}, th.Color(ColorNameBackground, VariantDark))
+	assert.Equal(t, &color.NRGBA{R: 0xc0, G: 0xc0, B: 0xc0, A: 0xff}, th.Color(ColorNameBackground, VariantLight))
+	assert.Equal(t, &color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, th.Color(ColorNameForeground, VariantLight))
+	assert.Equal(t, float32(5), th.Size(SizeNameInlineIcon))
+	assert.Equal(t, "NotoMono-Regular.ttf", th.Font(fyne.TextStyle{Monospace: true}).Name())
+	assert.Equal(t, "cancel_Paths.svg", th.Icon(IconNameCancel).Name())
+
+	th, err = FromTOML("Colors = not valid")
+	assert.NotNil(t, err)
+	assert.NotNil(t, th.Color(ColorNameForeground, VariantLight))
+}
+
+func TestFromFile(t *testing.T) {
+	th, err := FromFile("./testdata/theme.json")
+	assert.Nil(t, err)
+	assert.Equal(t, &color.NRGBA{R: 0x30, G: 0x30, B: 0x30, A: 0xff}, th.Color(ColorNameBackground, VariantLight))
+	assert.Equal(t, float32(10), th.Size(SizeNameInlineIcon))
+
+	th, err = FromFile("./testdata/theme.toml")
+	assert.Nil(t, err)
+	assert.Equal(t, &color.NRGBA{R: 0x30, G: 0x30, B: 0x30, A: 0xff}, th.Color(ColorNameBackground, VariantLight))
+	assert.Equal(t, &color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, th.Color(ColorNameForeground, VariantDark))
+	assert.Equal(t, float32(10), th.Size(SizeNameInlineIcon))
+
+	_, err = FromFile("./testdata/missing.toml")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,25 @@
+package theme
+
+import (
+	"time"
+
+	internaltheme "fyne.io/fyne/v2/internal/theme"
+)
+
+// SetTransitionDuration configures how long the application cross-fades between themes
+// when the theme or its variant changes, for example following an OS dark mode switch.
+// The default of 0 applies theme changes instantly. Transitions are also skipped when
+// the user has asked for animations to be disabled.
+//
+// Since: 2.6
+func SetTransitionDuration(d time.Duration) {
+	internaltheme.SetTransitionDuration(d)
+}
+
+// TransitionDuration returns how long the application cross-fades between themes when
+// the theme or its variant changes, 0 meaning changes apply instantly.
+//
+// Since: 2.6
+func TransitionDuration() time.Duration {
+	return internaltheme.TransitionDuration()
+}
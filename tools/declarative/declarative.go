@@ -0,0 +1,170 @@
+// Package declarative provides an optional immediate-mode style facade over the
+// retained widget tree. Apps describe their UI by returning a tree of canvas objects
+// from a render function, and Update reconciles that description against the objects
+// currently shown — updating only what changed, so complex dynamic screens need no
+// imperative Refresh bookkeeping.
+package declarative // import "fyne.io/fyne/v2/tools/declarative"
+
+import (
+	"reflect"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// UI manages a region of a canvas that is described by a render function.
+type UI struct {
+	render func() fyne.CanvasObject
+	root   *fyne.Container
+}
+
+// New returns a UI showing the description returned by the passed render function.
+// Place the result of Object in a window or container, then call Update whenever
+// the state the render function reads from has changed.
+func New(render func() fyne.CanvasObject) *UI {
+	u := &UI{render: render, root: container.NewStack(render())}
+	return u
+}
+
+// Object returns the canvas object housing the rendered tree, for placing in a window.
+func (u *UI) Object() fyne.CanvasObject {
+	return u.root
+}
+
+// Update re-runs the render function and reconciles the result against the objects
+// currently shown, refreshing only the parts of the tree that changed.
+func (u *UI) Update() {
+	desired := u.render()
+	updated, changed := reconcile(u.root.Objects[0], desired)
+	if updated != u.root.Objects[0] {
+		u.root.Objects[0] = updated
+		u.root.Refresh()
+	} else if changed {
+		canvas.Refresh(u.root)
+	}
+}
+
+// reconcile updates old to match the desired description where the types allow it,
+// returning the object to show and whether anything visible changed.
+func reconcile(old, desired fyne.CanvasObject) (fyne.CanvasObject, bool) {
+	if old == nil || desired == nil {
+		return desired, old != desired
+	}
+
+	switch o := old.(type) {
+	case *fyne.Container:
+		d, ok := desired.(*fyne.Container)
+		if !ok {
+			return desired, true
+		}
+		return o, reconcileContainer(o, d)
+	case *widget.Label:
+		d, ok := desired.(*widget.Label)
+		if !ok {
+			return desired, true
+		}
+		if o.Text != d.Text || o.Alignment != d.Alignment || o.TextStyle != d.TextStyle ||
+			o.Wrapping != d.Wrapping || o.Importance != d.Importance {
+			o.Text, o.Alignment, o.TextStyle = d.Text, d.Alignment, d.TextStyle
+			o.Wrapping, o.Importance = d.Wrapping, d.Importance
+			o.Refresh()
+			return o, true
+		}
+		return o, false
+	case *widget.Button:
+		d, ok := desired.(*widget.Button)
+		if !ok {
+			return desired, true
+		}
+		o.OnTapped = d.OnTapped // callbacks capture fresh state on every render
+		changed := false
+		if o.Text != d.Text || o.Icon != d.Icon || o.Importance != d.Importance {
+			o.Text, o.Icon, o.Importance = d.Text, d.Icon, d.Importance
+			changed = true
+		}
+		if o.Disabled() != d.Disabled() {
+			if d.Disabled() {
+				o.Disable()
+			} else {
+				o.Enable()
+			}
+			changed = true
+		}
+		if changed {
+			o.Refresh()
+		}
+		return o, changed
+	case *widget.Check:
+		d, ok := desired.(*widget.Check)
+		if !ok {
+			return desired, true
+		}
+		o.OnChanged = d.OnChanged
+		if o.Text != d.Text || o.Checked != d.Checked {
+			o.Text, o.Checked = d.Text, d.Checked
+			o.Refresh()
+			return o, true
+		}
+		return o, false
+	case *canvas.Text:
+		d, ok := desired.(*canvas.Text)
+		if !ok {
+			return desired, true
+		}
+		if o.Text != d.Text || o.Color != d.Color || o.TextSize != d.TextSize || o.TextStyle != d.TextStyle {
+			o.Text, o.Color, o.TextSize, o.TextStyle = d.Text, d.Color, d.TextSize, d.TextStyle
+			o.Refresh()
+			return o, true
+		}
+		return o, false
+	case *canvas.Rectangle:
+		d, ok := desired.(*canvas.Rectangle)
+		if !ok {
+			return desired, true
+		}
+		if o.FillColor != d.FillColor || o.StrokeColor != d.StrokeColor ||
+			o.StrokeWidth != d.StrokeWidth || o.CornerRadius != d.CornerRadius {
+			o.FillColor, o.StrokeColor = d.FillColor, d.StrokeColor
+			o.StrokeWidth, o.CornerRadius = d.StrokeWidth, d.CornerRadius
+			o.Refresh()
+			return o, true
+		}
+		return o, false
+	}
+
+	// other objects carry state we cannot diff generically, keep the new description
+	return desired, true
+}
+
+// reconcileContainer pairs up the children of two containers, reporting any change.
+func reconcileContainer(old, desired *fyne.Container) bool {
+	changed := false
+	if reflect.TypeOf(old.Layout) != reflect.TypeOf(desired.Layout) {
+		old.Layout = desired.Layout
+		changed = true
+	}
+
+	for i, d := range desired.Objects {
+		if i >= len(old.Objects) {
+			old.Objects = append(old.Objects, d)
+			changed = true
+			continue
+		}
+		updated, childChanged := reconcile(old.Objects[i], d)
+		if updated != old.Objects[i] {
+			old.Objects[i] = updated
+		}
+		changed = changed || childChanged
+	}
+	if len(old.Objects) > len(desired.Objects) {
+		old.Objects = old.Objects[:len(desired.Objects)]
+		changed = true
+	}
+
+	if changed {
+		old.Refresh()
+	}
+	return changed
+}
@@ -0,0 +1,78 @@
+package declarative
+
+import (
+	"fmt"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUI_Update(t *testing.T) {
+	test.NewApp()
+	count := 0
+	ui := New(func() fyne.CanvasObject {
+		return container.NewVBox(
+			widget.NewLabel(fmt.Sprintf("count %d", count)),
+			widget.NewButton("increment", func() { count++ }),
+		)
+	})
+
+	box := ui.Object().(*fyne.Container).Objects[0].(*fyne.Container)
+	label := box.Objects[0].(*widget.Label)
+	button := box.Objects[1].(*widget.Button)
+
+	count = 5
+	ui.Update()
+
+	updated := ui.Object().(*fyne.Container).Objects[0].(*fyne.Container)
+	assert.Same(t, box, updated) // existing objects are kept in place
+	assert.Same(t, label, updated.Objects[0])
+	assert.Same(t, button, updated.Objects[1])
+	assert.Equal(t, "count 5", label.Text)
+}
+
+func TestUI_Update_ReplacesChangedTypes(t *testing.T) {
+	test.NewApp()
+	showButton := false
+	ui := New(func() fyne.CanvasObject {
+		if showButton {
+			return widget.NewButton("tap", func() {})
+		}
+		return widget.NewLabel("text")
+	})
+
+	assert.IsType(t, &widget.Label{}, ui.Object().(*fyne.Container).Objects[0])
+
+	showButton = true
+	ui.Update()
+	assert.IsType(t, &widget.Button{}, ui.Object().(*fyne.Container).Objects[0])
+}
+
+func TestUI_Update_ChildCount(t *testing.T) {
+	test.NewApp()
+	items := []string{"a", "b"}
+	ui := New(func() fyne.CanvasObject {
+		box := container.NewVBox()
+		for _, item := range items {
+			box.Add(widget.NewLabel(item))
+		}
+		return box
+	})
+
+	box := ui.Object().(*fyne.Container).Objects[0].(*fyne.Container)
+	assert.Equal(t, 2, len(box.Objects))
+
+	items = append(items, "c")
+	ui.Update()
+	assert.Equal(t, 3, len(box.Objects))
+
+	items = items[:1]
+	ui.Update()
+	assert.Equal(t, 1, len(box.Objects))
+	assert.Equal(t, "a", box.Objects[0].(*widget.Label).Text)
+}
@@ -15,6 +15,17 @@ type Accordion struct {
 	BaseWidget
 	Items     []*AccordionItem
 	MultiOpen bool
+
+	// Animated grows and shrinks the detail view when an item is opened or
+	// closed, instead of snapping. It honours the global animations setting,
+	// so reduced motion preferences are respected.
+	//
+	// Since: 2.6
+	Animated bool
+
+	anim         *fyne.Animation
+	animItem     *AccordionItem
+	animFraction float32
 }
 
 // NewAccordion creates a new accordion widget.
@@ -26,6 +37,16 @@ func NewAccordion(items ...*AccordionItem) *Accordion {
 	return a
 }
 
+// NewMultiOpenAccordion creates a new accordion widget that allows multiple
+// items to be open at the same time.
+//
+// Since: 2.6
+func NewMultiOpenAccordion(items ...*AccordionItem) *Accordion {
+	a := NewAccordion(items...)
+	a.MultiOpen = true
+	return a
+}
+
 // Append adds the given item to this Accordion.
 func (a *Accordion) Append(item *AccordionItem) {
 	a.propertyLock.Lock()
@@ -42,20 +63,37 @@ func (a *Accordion) Close(index int) {
 		a.propertyLock.Unlock()
 		return
 	}
-	a.Items[index].Open = false
+	ai := a.Items[index]
+	wasOpen := ai.Open
+	ai.Open = false
 	a.propertyLock.Unlock()
 
+	if wasOpen {
+		a.animateItem(ai, false)
+		if f := ai.OnOpenChanged; f != nil {
+			f(false)
+		}
+	}
 	a.Refresh()
 }
 
 // CloseAll collapses all items.
 func (a *Accordion) CloseAll() {
+	var closed []*AccordionItem
 	a.propertyLock.Lock()
 	for _, i := range a.Items {
+		if i.Open {
+			closed = append(closed, i)
+		}
 		i.Open = false
 	}
 	a.propertyLock.Unlock()
 
+	for _, i := range closed {
+		if f := i.OnOpenChanged; f != nil {
+			f(false)
+		}
+	}
 	a.Refresh()
 }
 
@@ -82,15 +120,34 @@ func (a *Accordion) Open(index int) {
 		return
 	}
 
+	var opened *AccordionItem
+	var closed []*AccordionItem
 	for i, ai := range a.Items {
 		if i == index {
+			if !ai.Open {
+				opened = ai
+			}
 			ai.Open = true
 		} else if !a.MultiOpen {
+			if ai.Open {
+				closed = append(closed, ai)
+			}
 			ai.Open = false
 		}
 	}
 	a.propertyLock.Unlock()
 
+	a.animateItem(opened, true)
+	if opened != nil {
+		if f := opened.OnOpenChanged; f != nil {
+			f(true)
+		}
+	}
+	for _, ai := range closed {
+		if f := ai.OnOpenChanged; f != nil {
+			f(false)
+		}
+	}
 	a.Refresh()
 }
 
@@ -104,12 +161,21 @@ func (a *Accordion) OpenAll() {
 		return
 	}
 
+	var opened []*AccordionItem
 	a.propertyLock.Lock()
 	for _, i := range a.Items {
+		if !i.Open {
+			opened = append(opened, i)
+		}
 		i.Open = true
 	}
 	a.propertyLock.Unlock()
 
+	for _, i := range opened {
+		if f := i.OnOpenChanged; f != nil {
+			f(true)
+		}
+	}
 	a.Refresh()
 }
 
@@ -139,6 +205,45 @@ func (a *Accordion) RemoveIndex(index int) {
 	a.Refresh()
 }
 
+// animateItem grows or shrinks the detail of the given item. The open state is
+// already final, the transition only interpolates the space the detail takes.
+func (a *Accordion) animateItem(ai *AccordionItem, opening bool) {
+	if ai == nil || !a.Animated || !transitionsEnabled() || a.Size().IsZero() {
+		return
+	}
+	if a.anim != nil {
+		a.anim.Stop()
+	}
+
+	start, end := float32(0), float32(1)
+	if !opening {
+		start, end = 1, 0
+	}
+	a.animItem = ai
+	a.animFraction = start
+	a.anim = fyne.NewAnimation(canvas.DurationShort, func(f float32) {
+		a.animFraction = start + (end-start)*f
+		if f >= 1 {
+			a.anim = nil
+			a.animItem = nil
+		}
+		a.Refresh()
+	})
+	a.anim.Start()
+}
+
+// itemFraction returns how much of its detail height the given item currently
+// takes up, between 0 (closed) and 1 (open).
+func (a *Accordion) itemFraction(ai *AccordionItem) float32 {
+	if ai == a.animItem {
+		return a.animFraction
+	}
+	if ai.Open {
+		return 1
+	}
+	return 0
+}
+
 type accordionRenderer struct {
 	widget.BaseRenderer
 	container *Accordion
@@ -163,7 +268,7 @@ func (r *accordionRenderer) Layout(size fyne.Size) {
 		min := h.MinSize().Height
 		y += min
 
-		if ai.Open {
+		if r.container.itemFraction(ai) > 0 {
 			y += pad
 			hasOpen++
 		}
@@ -189,11 +294,11 @@ func (r *accordionRenderer) Layout(size fyne.Size) {
 		h.Resize(fyne.NewSize(size.Width, min))
 		y += min
 
-		if ai.Open {
+		if f := r.container.itemFraction(ai); f > 0 {
 			d := ai.Detail
 			d.Move(fyne.NewPos(x, y))
-			d.Resize(fyne.NewSize(size.Width, openSize))
-			y += openSize
+			d.Resize(fyne.NewSize(size.Width, openSize*f))
+			y += openSize * f
 		}
 		if i < len(r.container.Items)-1 {
 			y += pad
@@ -218,8 +323,8 @@ func (r *accordionRenderer) MinSize() fyne.Size {
 		size.Height += min.Height
 		min = ai.Detail.MinSize()
 		size.Width = fyne.Max(size.Width, min.Width)
-		if ai.Open {
-			size.Height += min.Height
+		if f := r.container.itemFraction(ai); f > 0 {
+			size.Height += min.Height * f
 			size.Height += pad
 		}
 	}
@@ -268,9 +373,12 @@ func (r *accordionRenderer) updateObjects() {
 		}
 		if ai.Open {
 			h.Icon = th.Icon(theme.IconNameArrowDropUp)
-			ai.Detail.Show()
 		} else {
 			h.Icon = th.Icon(theme.IconNameArrowDropDown)
+		}
+		if ai.Open || ai == r.container.animItem { // stay visible while shrinking
+			ai.Detail.Show()
+		} else {
 			ai.Detail.Hide()
 		}
 		h.Refresh()
@@ -311,6 +419,12 @@ type AccordionItem struct {
 	Title  string
 	Detail fyne.CanvasObject
 	Open   bool
+
+	// OnOpenChanged is called when this item is opened or closed,
+	// with the new open state.
+	//
+	// Since: 2.6
+	OnOpenChanged func(bool) `json:"-"`
 }
 
 // NewAccordionItem creates a new item for an Accordion.
@@ -25,6 +25,39 @@ func TestAccordion(t *testing.T) {
 	})
 }
 
+func TestAccordion_MultiOpenConstructor(t *testing.T) {
+	ac := widget.NewMultiOpenAccordion(
+		widget.NewAccordionItem("foo0", widget.NewLabel("foobar0")),
+		widget.NewAccordionItem("foo1", widget.NewLabel("foobar1")))
+	assert.True(t, ac.MultiOpen)
+
+	ac.Open(0)
+	ac.Open(1)
+	assert.True(t, ac.Items[0].Open)
+	assert.True(t, ac.Items[1].Open)
+}
+
+func TestAccordion_OnOpenChanged(t *testing.T) {
+	states := make(map[int]bool)
+	ac := widget.NewAccordion()
+	for i := 0; i < 2; i++ {
+		index := i // capture
+		item := widget.NewAccordionItem("foo", widget.NewLabel("foobar"))
+		item.OnOpenChanged = func(open bool) { states[index] = open }
+		ac.Append(item)
+	}
+
+	ac.Open(0)
+	assert.Equal(t, map[int]bool{0: true}, states)
+
+	// opening index 1 closes index 0 in single open mode
+	ac.Open(1)
+	assert.Equal(t, map[int]bool{0: false, 1: true}, states)
+
+	ac.CloseAll()
+	assert.Equal(t, map[int]bool{0: false, 1: false}, states)
+}
+
 func TestAccordion_Append(t *testing.T) {
 	ac := widget.NewAccordion()
 	ac.Append(widget.NewAccordionItem("foo", widget.NewLabel("foobar")))
@@ -0,0 +1,129 @@
+package widget
+
+import (
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// NewRichTextFromANSI configures a RichText widget by parsing the provided terminal output.
+// ANSI SGR escape sequences for colour, bold, italic and underline are converted into
+// segment styling, other escape sequences are stripped.
+//
+// Since: 2.6
+func NewRichTextFromANSI(content string) *RichText {
+	return NewRichText(parseANSI(content)...)
+}
+
+// AppendANSI parses the given terminal output and appends the content to the widget,
+// with any ANSI SGR styling applied. Styling state does not carry over from previously
+// appended content, so this is best used to append complete lines of output.
+//
+// Since: 2.6
+func (t *RichText) AppendANSI(content string) {
+	t.Segments = append(t.Segments, parseANSI(content)...)
+	t.Refresh()
+}
+
+// ansiColorNames maps the standard and bright ANSI foreground colours onto the closest
+// theme colour, so converted output follows the current theme variant.
+var ansiColorNames = map[int]fyne.ThemeColorName{
+	30: theme.ColorNameForeground,
+	31: theme.ColorNameError,
+	32: theme.ColorNameSuccess,
+	33: theme.ColorNameWarning,
+	34: theme.ColorNamePrimary,
+	35: theme.ColorNamePrimary,
+	36: theme.ColorNameHyperlink,
+	37: theme.ColorNameForeground,
+}
+
+func parseANSI(content string) []RichTextSegment {
+	var segs []RichTextSegment
+	style := ansiTextStyle()
+	var text strings.Builder
+
+	flush := func() {
+		if text.Len() == 0 {
+			return
+		}
+		segs = append(segs, &TextSegment{Style: style, Text: text.String()})
+		text.Reset()
+	}
+
+	for i := 0; i < len(content); i++ {
+		if content[i] != '\x1b' {
+			text.WriteByte(content[i])
+			continue
+		}
+		if i+1 >= len(content) || content[i+1] != '[' {
+			continue // a lone escape, drop it
+		}
+		end := i + 2
+		for end < len(content) && !isANSIFinal(content[end]) {
+			end++
+		}
+		if end >= len(content) {
+			break
+		}
+		if content[end] == 'm' {
+			flush()
+			style = applySGR(style, content[i+2:end])
+		}
+		i = end
+	}
+	flush()
+	return segs
+}
+
+func ansiTextStyle() RichTextStyle {
+	return RichTextStyle{
+		ColorName: theme.ColorNameForeground,
+		Inline:    true,
+		SizeName:  theme.SizeNameText,
+		TextStyle: fyne.TextStyle{Monospace: true},
+	}
+}
+
+// applySGR updates the given style according to the parameters of an SGR escape sequence.
+func applySGR(style RichTextStyle, params string) RichTextStyle {
+	if params == "" {
+		return ansiTextStyle()
+	}
+	for _, param := range strings.Split(params, ";") {
+		code, err := strconv.Atoi(param)
+		if err != nil {
+			continue
+		}
+		switch {
+		case code == 0:
+			style = ansiTextStyle()
+		case code == 1:
+			style.TextStyle.Bold = true
+		case code == 3:
+			style.TextStyle.Italic = true
+		case code == 4:
+			style.TextStyle.Underline = true
+		case code == 22:
+			style.TextStyle.Bold = false
+		case code == 23:
+			style.TextStyle.Italic = false
+		case code == 24:
+			style.TextStyle.Underline = false
+		case code >= 30 && code <= 37:
+			style.ColorName = ansiColorNames[code]
+		case code == 39:
+			style.ColorName = theme.ColorNameForeground
+		case code >= 90 && code <= 97:
+			style.ColorName = ansiColorNames[code-60]
+		}
+	}
+	return style
+}
+
+// isANSIFinal reports whether the byte terminates a CSI escape sequence.
+func isANSIFinal(b byte) bool {
+	return b >= 0x40 && b <= 0x7e
+}
@@ -0,0 +1,78 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2/theme"
+)
+
+func TestRichTextANSI_Plain(t *testing.T) {
+	r := NewRichTextFromANSI("plain text\n")
+
+	assert.Equal(t, 1, len(r.Segments))
+	if text, ok := r.Segments[0].(*TextSegment); ok {
+		assert.Equal(t, "plain text\n", text.Text)
+		assert.Equal(t, theme.ColorNameForeground, text.Style.ColorName)
+		assert.True(t, text.Style.TextStyle.Monospace)
+	} else {
+		t.Error("Segment should be Text")
+	}
+}
+
+func TestRichTextANSI_Color(t *testing.T) {
+	r := NewRichTextFromANSI("ok \x1b[31mfailed\x1b[0m done")
+
+	assert.Equal(t, 3, len(r.Segments))
+	if text, ok := r.Segments[1].(*TextSegment); ok {
+		assert.Equal(t, "failed", text.Text)
+		assert.Equal(t, theme.ColorNameError, text.Style.ColorName)
+	} else {
+		t.Error("Segment should be Text")
+	}
+	if text, ok := r.Segments[2].(*TextSegment); ok {
+		assert.Equal(t, " done", text.Text)
+		assert.Equal(t, theme.ColorNameForeground, text.Style.ColorName)
+	} else {
+		t.Error("Segment should be Text")
+	}
+}
+
+func TestRichTextANSI_BoldUnderline(t *testing.T) {
+	segs := parseANSI("\x1b[1;4;32mpassed\x1b[m")
+
+	assert.Equal(t, 1, len(segs))
+	if text, ok := segs[0].(*TextSegment); ok {
+		assert.Equal(t, "passed", text.Text)
+		assert.True(t, text.Style.TextStyle.Bold)
+		assert.True(t, text.Style.TextStyle.Underline)
+		assert.Equal(t, theme.ColorNameSuccess, text.Style.ColorName)
+	} else {
+		t.Error("Segment should be Text")
+	}
+}
+
+func TestRichTextANSI_StripsOtherSequences(t *testing.T) {
+	r := NewRichTextFromANSI("a\x1b[2Kb\x1b[1;1Hc")
+
+	assert.Equal(t, 1, len(r.Segments))
+	if text, ok := r.Segments[0].(*TextSegment); ok {
+		assert.Equal(t, "abc", text.Text)
+	} else {
+		t.Error("Segment should be Text")
+	}
+}
+
+func TestRichTextANSI_Append(t *testing.T) {
+	r := NewRichTextFromANSI("line one\n")
+	r.AppendANSI("\x1b[91mline two\x1b[0m\n")
+
+	assert.Equal(t, 3, len(r.Segments))
+	if text, ok := r.Segments[1].(*TextSegment); ok {
+		assert.Equal(t, "line two", text.Text)
+		assert.Equal(t, theme.ColorNameError, text.Style.ColorName)
+	} else {
+		t.Error("Segment should be Text")
+	}
+}
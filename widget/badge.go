@@ -0,0 +1,155 @@
+package widget
+
+import (
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/widget"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*Badge)(nil)
+var _ ToolTipable = (*Badge)(nil)
+
+// Badge wraps any canvas object and overlays a small count or status dot on
+// its top trailing corner, commonly used for unread counts on toolbar icons,
+// tab items or list icons. The decoration is hidden while Count is zero and
+// Dot is false, so a badge can be left in place and updated as state changes.
+//
+// Since: 2.6
+type Badge struct {
+	BaseWidget
+
+	Content fyne.CanvasObject
+
+	// Count is the number shown in the badge, values over 99 show as "99+".
+	Count int
+
+	// Dot shows a plain status dot instead of a count.
+	Dot bool
+
+	// Label describes the badge state to assistive technology,
+	// shown as a tool tip when wrapped in a ToolTipArea.
+	Label string
+}
+
+// NewBadge returns a new badge wrapping the passed object, showing a status
+// dot on its top trailing corner.
+//
+// Since: 2.6
+func NewBadge(content fyne.CanvasObject) *Badge {
+	b := &Badge{Content: content, Dot: true}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// NewBadgeWithCount returns a new badge wrapping the passed object, showing
+// the given count on its top trailing corner.
+//
+// Since: 2.6
+func NewBadgeWithCount(content fyne.CanvasObject, count int) *Badge {
+	b := &Badge{Content: content, Count: count}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer
+func (b *Badge) CreateRenderer() fyne.WidgetRenderer {
+	b.ExtendBaseWidget(b)
+	th := b.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	background := canvas.NewCircle(th.Color(theme.ColorNameError, v))
+	text := canvas.NewText("", th.Color(theme.ColorNameForegroundOnError, v))
+	text.TextSize = th.Size(theme.SizeNameCaptionText)
+	text.Alignment = fyne.TextAlignCenter
+
+	r := &badgeRenderer{background: background, text: text, badge: b}
+	r.SetObjects([]fyne.CanvasObject{b.Content, background, text})
+	r.Refresh()
+	return r
+}
+
+// SetCount updates the number shown in the badge.
+// A zero count hides the decoration unless Dot is set.
+//
+// Since: 2.6
+func (b *Badge) SetCount(count int) {
+	b.Count = count
+	b.Refresh()
+}
+
+// ToolTip returns the accessibility label of this badge so that wrapping it
+// in a ToolTipArea describes the badge state on hover.
+//
+// Since: 2.6
+func (b *Badge) ToolTip() string {
+	return b.Label
+}
+
+var _ fyne.WidgetRenderer = (*badgeRenderer)(nil)
+
+type badgeRenderer struct {
+	widget.BaseRenderer
+	background *canvas.Circle
+	text       *canvas.Text
+
+	badge *Badge
+}
+
+func (r *badgeRenderer) Layout(size fyne.Size) {
+	r.badge.Content.Resize(size)
+	r.badge.Content.Move(fyne.Position{})
+
+	diameter := r.diameter()
+	width := diameter
+	if !r.badge.Dot && r.badge.Count > 0 {
+		width = fyne.Max(width, r.text.MinSize().Width+diameter/2)
+	}
+	// overhang the top trailing corner without leaving the widget bounds
+	r.background.Resize(fyne.NewSize(width, diameter))
+	r.background.Move(fyne.NewPos(size.Width-width, 0))
+	r.text.Resize(fyne.NewSize(width, diameter))
+	r.text.Move(fyne.NewPos(size.Width-width, (diameter-r.text.MinSize().Height)/2))
+}
+
+func (r *badgeRenderer) MinSize() fyne.Size {
+	return r.badge.Content.MinSize()
+}
+
+func (r *badgeRenderer) Refresh() {
+	th := r.badge.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	r.background.FillColor = th.Color(theme.ColorNameError, v)
+	r.text.Color = th.Color(theme.ColorNameForegroundOnError, v)
+	r.text.TextSize = th.Size(theme.SizeNameCaptionText)
+
+	if r.badge.Dot || r.badge.Count <= 0 {
+		r.text.Text = ""
+	} else if r.badge.Count > 99 {
+		r.text.Text = "99+"
+	} else {
+		r.text.Text = strconv.Itoa(r.badge.Count)
+	}
+	if r.badge.Dot || r.badge.Count > 0 {
+		r.background.Show()
+	} else {
+		r.background.Hide()
+	}
+
+	r.badge.Content.Refresh()
+	r.background.Refresh()
+	r.text.Refresh()
+	r.Layout(r.badge.Size())
+}
+
+func (r *badgeRenderer) diameter() float32 {
+	th := r.badge.Theme()
+	if r.badge.Dot {
+		return th.Size(theme.SizeNamePadding) * 2
+	}
+	return th.Size(theme.SizeNameCaptionText) + th.Size(theme.SizeNamePadding)
+}
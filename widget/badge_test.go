@@ -0,0 +1,62 @@
+package widget_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+func badgeText(b *widget.Badge) *canvas.Text {
+	for _, o := range test.WidgetRenderer(b).Objects() {
+		if t, ok := o.(*canvas.Text); ok {
+			return t
+		}
+	}
+	return nil
+}
+
+func badgeDot(b *widget.Badge) *canvas.Circle {
+	for _, o := range test.WidgetRenderer(b).Objects() {
+		if c, ok := o.(*canvas.Circle); ok {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestBadge_Count(t *testing.T) {
+	badge := widget.NewBadgeWithCount(widget.NewIcon(theme.MailComposeIcon()), 3)
+	assert.Equal(t, "3", badgeText(badge).Text)
+	assert.True(t, badgeDot(badge).Visible())
+
+	badge.SetCount(120)
+	assert.Equal(t, "99+", badgeText(badge).Text)
+
+	badge.SetCount(0)
+	assert.False(t, badgeDot(badge).Visible())
+}
+
+func TestBadge_Dot(t *testing.T) {
+	badge := widget.NewBadge(widget.NewIcon(theme.MailComposeIcon()))
+	assert.True(t, badgeDot(badge).Visible())
+	assert.Equal(t, "", badgeText(badge).Text)
+}
+
+func TestBadge_MinSize(t *testing.T) {
+	icon := widget.NewIcon(theme.MailComposeIcon())
+	badge := widget.NewBadgeWithCount(icon, 9)
+	assert.Equal(t, icon.MinSize(), badge.MinSize()) // the decoration takes no extra space
+}
+
+func TestBadge_ToolTip(t *testing.T) {
+	badge := widget.NewBadgeWithCount(widget.NewIcon(theme.MailComposeIcon()), 2)
+	badge.Label = "2 unread messages"
+
+	var _ widget.ToolTipable = badge
+	assert.Equal(t, "2 unread messages", badge.ToolTip())
+}
@@ -0,0 +1,261 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/widget"
+	"fyne.io/fyne/v2/theme"
+)
+
+// BannerAction is a labelled action offered on a banner message.
+//
+// Since: 2.6
+type BannerAction struct {
+	Label    string
+	OnTapped func() `json:"-"`
+}
+
+// BannerMessage is one non-blocking message shown in a Banner, with an
+// optional icon and up to two actions. Tapping an action dismisses it.
+//
+// Since: 2.6
+type BannerMessage struct {
+	Text string
+	Icon fyne.Resource
+
+	// Priority orders queued messages, higher priorities showing first.
+	Priority int
+
+	Actions []*BannerAction
+}
+
+// Declare conformity with Widget interface
+var _ fyne.Widget = (*Banner)(nil)
+
+// Banner is a message bar for non-blocking notices, such as being offline or
+// an update being available, usually placed below a toolbar. Messages slide in
+// one at a time and further messages queue by priority until dismissed.
+//
+// Since: 2.6
+type Banner struct {
+	BaseWidget
+
+	queue []*BannerMessage
+	slide float32 // vertical offset of the content while sliding in
+	anim  *fyne.Animation
+}
+
+// NewBanner creates a new banner message bar. It takes no space until a
+// message is shown.
+//
+// Since: 2.6
+func NewBanner() *Banner {
+	b := &Banner{}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer
+func (b *Banner) CreateRenderer() fyne.WidgetRenderer {
+	b.ExtendBaseWidget(b)
+	th := b.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	r := &bannerRenderer{
+		background: canvas.NewRectangle(th.Color(theme.ColorNameBackground, v)),
+		divider:    NewSeparator(),
+		icon:       NewIcon(nil),
+		message:    NewLabel(""),
+		banner:     b,
+	}
+	r.message.Wrapping = fyne.TextWrapWord
+	r.updateObjects()
+	return r
+}
+
+// Current returns the message being shown, or nil if the banner is idle.
+//
+// Since: 2.6
+func (b *Banner) Current() *BannerMessage {
+	if len(b.queue) == 0 {
+		return nil
+	}
+	return b.queue[0]
+}
+
+// Dismiss removes the current message, sliding in the next queued one if any.
+//
+// Since: 2.6
+func (b *Banner) Dismiss() {
+	if len(b.queue) == 0 {
+		return
+	}
+	b.queue = b.queue[1:]
+	if len(b.queue) > 0 {
+		b.slideIn()
+	}
+	b.Refresh()
+}
+
+// ShowMessage queues the given message, after any queued messages of the same
+// or higher priority. It appears immediately if the banner is idle.
+//
+// Since: 2.6
+func (b *Banner) ShowMessage(message *BannerMessage) {
+	at := len(b.queue)
+	for at > 1 && b.queue[at-1].Priority < message.Priority { // [0] is showing, jump the rest
+		at--
+	}
+	b.queue = append(b.queue[:at], append([]*BannerMessage{message}, b.queue[at:]...)...)
+
+	if at == 0 {
+		b.slideIn()
+	}
+	b.Refresh()
+}
+
+// slideIn animates the banner content down from above its final position.
+func (b *Banner) slideIn() {
+	if b.anim != nil {
+		b.anim.Stop()
+		b.anim = nil
+		b.slide = 0
+	}
+	if !transitionsEnabled() || b.Size().IsZero() {
+		return
+	}
+
+	height := b.MinSize().Height
+	b.anim = fyne.NewAnimation(canvas.DurationShort, func(f float32) {
+		b.slide = (f - 1) * height
+		if f >= 1 {
+			b.anim = nil
+			b.slide = 0
+		}
+		b.Refresh()
+	})
+	b.anim.Curve = fyne.AnimationEaseOut
+	b.anim.Start()
+}
+
+var _ fyne.WidgetRenderer = (*bannerRenderer)(nil)
+
+type bannerRenderer struct {
+	widget.BaseRenderer
+	background *canvas.Rectangle
+	divider    *Separator
+	icon       *Icon
+	message    *Label
+	actions    []*Button
+
+	banner *Banner
+}
+
+func (r *bannerRenderer) Layout(size fyne.Size) {
+	current := r.banner.Current()
+	if current == nil {
+		return
+	}
+	th := r.banner.Theme()
+	pad := th.Size(theme.SizeNamePadding)
+	iconSize := th.Size(theme.SizeNameInlineIcon)
+
+	r.background.Move(fyne.NewPos(0, r.banner.slide))
+	r.background.Resize(size)
+	r.divider.Move(fyne.NewPos(0, r.banner.slide+size.Height-r.divider.MinSize().Height))
+	r.divider.Resize(fyne.NewSize(size.Width, r.divider.MinSize().Height))
+
+	x := pad
+	if current.Icon != nil {
+		r.icon.Move(fyne.NewPos(x, r.banner.slide+(size.Height-iconSize)/2))
+		r.icon.Resize(fyne.NewSquareSize(iconSize))
+		x += iconSize + pad
+	}
+
+	buttonsWidth := float32(0)
+	for _, a := range r.actions {
+		buttonsWidth += a.MinSize().Width + pad
+	}
+	right := size.Width - pad - buttonsWidth
+	for _, a := range r.actions {
+		min := a.MinSize()
+		a.Move(fyne.NewPos(right+pad, r.banner.slide+(size.Height-min.Height)/2))
+		a.Resize(min)
+		right += min.Width + pad
+	}
+
+	messageHeight := r.message.MinSize().Height
+	r.message.Move(fyne.NewPos(x, r.banner.slide+(size.Height-messageHeight)/2))
+	r.message.Resize(fyne.NewSize(size.Width-pad-buttonsWidth-x, messageHeight))
+}
+
+func (r *bannerRenderer) MinSize() fyne.Size {
+	current := r.banner.Current()
+	if current == nil {
+		return fyne.Size{}
+	}
+	th := r.banner.Theme()
+	pad := th.Size(theme.SizeNamePadding)
+
+	min := r.message.MinSize()
+	width := min.Width + pad*2
+	height := min.Height
+	if current.Icon != nil {
+		iconSize := th.Size(theme.SizeNameInlineIcon)
+		width += iconSize + pad
+		height = fyne.Max(height, iconSize)
+	}
+	for _, a := range r.actions {
+		actionMin := a.MinSize()
+		width += actionMin.Width + pad
+		height = fyne.Max(height, actionMin.Height)
+	}
+	return fyne.NewSize(width, height+pad*2)
+}
+
+func (r *bannerRenderer) Refresh() {
+	th := r.banner.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	r.background.FillColor = th.Color(theme.ColorNameBackground, v)
+	r.background.Refresh()
+
+	r.updateObjects()
+	r.Layout(r.banner.Size())
+	canvas.Refresh(r.banner.super())
+}
+
+func (r *bannerRenderer) updateObjects() {
+	current := r.banner.Current()
+	if current == nil {
+		r.SetObjects(nil)
+		return
+	}
+
+	r.message.SetText(current.Text)
+	objects := []fyne.CanvasObject{r.background, r.message}
+	if current.Icon != nil {
+		r.icon.SetResource(current.Icon)
+		objects = append(objects, r.icon)
+	}
+
+	r.actions = r.actions[:0]
+	actions := current.Actions
+	if len(actions) > 2 {
+		actions = actions[:2] // a banner offers at most two actions
+	}
+	for _, action := range actions {
+		tapped := action.OnTapped
+		button := NewButton(action.Label, func() {
+			if tapped != nil {
+				tapped()
+			}
+			r.banner.Dismiss()
+		})
+		button.Importance = HighImportance
+		r.actions = append(r.actions, button)
+		objects = append(objects, button)
+	}
+
+	objects = append(objects, r.divider)
+	r.SetObjects(objects)
+}
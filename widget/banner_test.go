@@ -0,0 +1,71 @@
+package widget_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+func TestBanner_Show(t *testing.T) {
+	banner := widget.NewBanner()
+	assert.Nil(t, banner.Current())
+	assert.True(t, banner.MinSize().IsZero())
+
+	banner.ShowMessage(&widget.BannerMessage{Text: "Offline", Icon: theme.WarningIcon()})
+	assert.Equal(t, "Offline", banner.Current().Text)
+	assert.False(t, banner.MinSize().IsZero())
+}
+
+func TestBanner_Queue(t *testing.T) {
+	banner := widget.NewBanner()
+	banner.ShowMessage(&widget.BannerMessage{Text: "first"})
+	banner.ShowMessage(&widget.BannerMessage{Text: "low", Priority: 1})
+	banner.ShowMessage(&widget.BannerMessage{Text: "high", Priority: 2})
+	banner.ShowMessage(&widget.BannerMessage{Text: "late", Priority: 1})
+
+	assert.Equal(t, "first", banner.Current().Text) // showing messages are not jumped
+	banner.Dismiss()
+	assert.Equal(t, "high", banner.Current().Text)
+	banner.Dismiss()
+	assert.Equal(t, "low", banner.Current().Text) // first in, first out within a priority
+	banner.Dismiss()
+	assert.Equal(t, "late", banner.Current().Text)
+	banner.Dismiss()
+	assert.Nil(t, banner.Current())
+
+	banner.Dismiss() // no-op when idle
+	assert.Nil(t, banner.Current())
+}
+
+func TestBanner_Actions(t *testing.T) {
+	tapped := false
+	banner := widget.NewBanner()
+	banner.ShowMessage(&widget.BannerMessage{
+		Text: "Update available",
+		Actions: []*widget.BannerAction{
+			{Label: "Update", OnTapped: func() { tapped = true }},
+			{Label: "Later"},
+		},
+	})
+
+	w := test.NewWindow(banner)
+	defer w.Close()
+	w.Resize(fyne.NewSize(300, 60))
+
+	var update *widget.Button
+	for _, o := range test.WidgetRenderer(banner).Objects() {
+		if b, ok := o.(*widget.Button); ok && b.Text == "Update" {
+			update = b
+		}
+	}
+	if assert.NotNil(t, update) {
+		test.Tap(update)
+	}
+	assert.True(t, tapped)
+	assert.Nil(t, banner.Current()) // an action dismisses its message
+}
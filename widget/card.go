@@ -1,12 +1,16 @@
 package widget
 
 import (
+	"image/color"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/internal/widget"
 	"fyne.io/fyne/v2/theme"
 )
 
+var _ fyne.Tappable = (*Card)(nil)
+
 // Card widget groups title, subtitle with content and a header image
 //
 // Since: 1.4
@@ -15,6 +19,35 @@ type Card struct {
 	Title, Subtitle string
 	Image           *canvas.Image
 	Content         fyne.CanvasObject
+
+	// ImageOverlay is an optional object drawn over the header image,
+	// such as a caption or gradient scrim.
+	//
+	// Since: 2.6
+	ImageOverlay fyne.CanvasObject
+
+	// Actions is an optional row of items, usually buttons, shown below the content.
+	//
+	// Since: 2.6
+	Actions []fyne.CanvasObject
+
+	// Detail is an optional area revealed below the actions by a disclosure
+	// button that is added to the action row when this is set.
+	//
+	// Since: 2.6
+	Detail fyne.CanvasObject
+
+	// DetailOpen reveals the Detail area when true.
+	//
+	// Since: 2.6
+	DetailOpen bool
+
+	// OnTapped is called when the card is tapped, with a ripple highlight.
+	//
+	// Since: 2.6
+	OnTapped func() `json:"-"`
+
+	tapAnim *fyne.Animation
 }
 
 // NewCard creates a new card widget with the specified title, subtitle and content (all optional).
@@ -41,16 +74,18 @@ func (c *Card) CreateRenderer() fyne.WidgetRenderer {
 	header.TextStyle.Bold = true
 	subHeader := canvas.NewText(c.Subtitle, header.Color)
 
-	objects := []fyne.CanvasObject{header, subHeader}
-	if c.Image != nil {
-		objects = append(objects, c.Image)
-	}
-	if c.Content != nil {
-		objects = append(objects, c.Content)
-	}
-	r := &cardRenderer{widget.NewShadowingRenderer(objects, widget.CardLevel),
-		header, subHeader, c}
+	tapBG := canvas.NewRectangle(color.Transparent)
+	c.tapAnim = newButtonTapAnimation(tapBG, c, th)
+	c.tapAnim.Curve = fyne.AnimationEaseOut
+
+	disclosure := &Button{Importance: LowImportance, OnTapped: func() {
+		c.SetDetailOpen(!c.DetailOpen)
+	}}
+
+	r := &cardRenderer{widget.NewShadowingRenderer(nil, widget.CardLevel),
+		header, subHeader, tapBG, disclosure, c}
 	r.applyTheme()
+	r.updateObjects()
 	return r
 }
 
@@ -60,6 +95,50 @@ func (c *Card) MinSize() fyne.Size {
 	return c.BaseWidget.MinSize()
 }
 
+// SetDetail changes the expandable detail area of this card.
+//
+// Since: 2.6
+func (c *Card) SetDetail(obj fyne.CanvasObject) {
+	c.Detail = obj
+
+	c.Refresh()
+}
+
+// SetDetailOpen expands or collapses the detail area of this card.
+//
+// Since: 2.6
+func (c *Card) SetDetailOpen(open bool) {
+	if c.DetailOpen == open {
+		return
+	}
+	c.DetailOpen = open
+
+	c.Refresh()
+}
+
+// Tapped is called when a pointer tapped event is captured.
+// It runs the ripple highlight and calls OnTapped.
+//
+// Since: 2.6
+func (c *Card) Tapped(*fyne.PointEvent) {
+	c.tapAnimation()
+
+	if onTapped := c.OnTapped; onTapped != nil {
+		onTapped()
+	}
+}
+
+func (c *Card) tapAnimation() {
+	if c.tapAnim == nil {
+		return
+	}
+	c.tapAnim.Stop()
+
+	if fyne.CurrentApp().Settings().ShowAnimations() {
+		c.tapAnim.Start()
+	}
+}
+
 // SetContent changes the body of this card to have the specified content.
 func (c *Card) SetContent(obj fyne.CanvasObject) {
 	c.Content = obj
@@ -92,6 +171,8 @@ type cardRenderer struct {
 	*widget.ShadowingRenderer
 
 	header, subHeader *canvas.Text
+	tapBG             *canvas.Rectangle
+	disclosure        *Button
 
 	card *Card
 }
@@ -107,9 +188,25 @@ func (c *cardRenderer) Layout(size fyne.Size) {
 	size = size.Subtract(fyne.NewSquareSize(padding))
 	c.LayoutShadow(size, pos)
 
+	innerLeft := pos.X
+	innerWidth := size.Width
+	innerBottom := pos.Y + size.Height
+	rowHeight := c.actionRowHeight()
+	bottomUsed := float32(0)
+	if rowHeight > 0 {
+		bottomUsed += rowHeight + padding
+	}
+	if c.card.Detail != nil && c.card.DetailOpen {
+		bottomUsed += c.card.Detail.MinSize().Height + padding
+	}
+
 	if c.card.Image != nil {
 		c.card.Image.Move(pos)
 		c.card.Image.Resize(fyne.NewSize(size.Width, cardMediaHeight))
+		if c.card.ImageOverlay != nil {
+			c.card.ImageOverlay.Move(pos)
+			c.card.ImageOverlay.Resize(fyne.NewSize(size.Width, cardMediaHeight))
+		}
 		pos.Y += cardMediaHeight
 	}
 
@@ -141,7 +238,7 @@ func (c *cardRenderer) Layout(size fyne.Size) {
 	size.Width -= padding * 2
 	pos.X += padding
 	if c.card.Content != nil {
-		height := size.Height - padding*2 - (pos.Y - padding/2) // adjust for content and initial offset
+		height := size.Height - padding*2 - (pos.Y - padding/2) - bottomUsed // adjust for content and initial offset
 		if c.card.Title != "" || c.card.Subtitle != "" {
 			height += padding
 			pos.Y -= padding
@@ -149,6 +246,42 @@ func (c *cardRenderer) Layout(size fyne.Size) {
 		c.card.Content.Move(pos.Add(fyne.NewPos(0, padding)))
 		c.card.Content.Resize(fyne.NewSize(size.Width, height))
 	}
+
+	y := innerBottom
+	if c.card.Detail != nil && c.card.DetailOpen {
+		detailMin := c.card.Detail.MinSize().Height
+		y -= padding + detailMin
+		c.card.Detail.Move(fyne.NewPos(innerLeft+padding, y))
+		c.card.Detail.Resize(fyne.NewSize(innerWidth-padding*2, detailMin))
+	}
+	if rowHeight > 0 {
+		y -= padding + rowHeight
+		x := innerLeft + padding
+		for _, a := range c.card.Actions {
+			width := a.MinSize().Width
+			a.Move(fyne.NewPos(x, y))
+			a.Resize(fyne.NewSize(width, rowHeight))
+			x += width + padding
+		}
+		if c.card.Detail != nil {
+			width := c.disclosure.MinSize().Width
+			c.disclosure.Move(fyne.NewPos(innerLeft+innerWidth-padding-width, y))
+			c.disclosure.Resize(fyne.NewSize(width, rowHeight))
+		}
+	}
+}
+
+// actionRowHeight returns the height of the action button row,
+// or 0 if the card has no actions and no detail disclosure.
+func (c *cardRenderer) actionRowHeight() float32 {
+	height := float32(0)
+	if c.card.Detail != nil {
+		height = c.disclosure.MinSize().Height
+	}
+	for _, a := range c.card.Actions {
+		height = fyne.Max(height, a.MinSize().Height)
+	}
+	return height
 }
 
 // MinSize calculates the minimum size of a card.
@@ -160,7 +293,8 @@ func (c *cardRenderer) MinSize() fyne.Size {
 	hasContent := c.card.Content != nil
 
 	padding := c.card.Theme().Size(theme.SizeNamePadding)
-	if !hasHeader && !hasSubHeader && !hasContent { // just image, or nothing
+	hasBottomRow := len(c.card.Actions) > 0 || c.card.Detail != nil
+	if !hasHeader && !hasSubHeader && !hasContent && !hasBottomRow { // just image, or nothing
 		if c.card.Image == nil {
 			return fyne.NewSize(padding, padding) // empty, just space for border
 		}
@@ -196,6 +330,23 @@ func (c *cardRenderer) MinSize() fyne.Size {
 			min.Height+contentMin.Height+padding*2)
 	}
 
+	if rowHeight := c.actionRowHeight(); rowHeight > 0 {
+		rowWidth := padding
+		for _, a := range c.card.Actions {
+			rowWidth += a.MinSize().Width + padding
+		}
+		if c.card.Detail != nil {
+			rowWidth += c.disclosure.MinSize().Width + padding
+		}
+		min = fyne.NewSize(fyne.Max(min.Width, rowWidth+padding), min.Height+rowHeight+padding)
+	}
+
+	if c.card.Detail != nil && c.card.DetailOpen {
+		detailMin := c.card.Detail.MinSize()
+		min = fyne.NewSize(fyne.Max(min.Width, detailMin.Width+padding*3),
+			min.Height+detailMin.Height+padding)
+	}
+
 	return min
 }
 
@@ -205,19 +356,41 @@ func (c *cardRenderer) Refresh() {
 	c.subHeader.Text = c.card.Subtitle
 	c.subHeader.Refresh()
 
+	c.updateObjects()
+
+	c.applyTheme()
+	c.Layout(c.card.Size())
+	c.ShadowingRenderer.RefreshShadow()
+	canvas.Refresh(c.card.super())
+}
+
+func (c *cardRenderer) updateObjects() {
 	objects := []fyne.CanvasObject{c.header, c.subHeader}
 	if c.card.Image != nil {
 		objects = append(objects, c.card.Image)
+		if c.card.ImageOverlay != nil {
+			objects = append(objects, c.card.ImageOverlay)
+		}
 	}
 	if c.card.Content != nil {
 		objects = append(objects, c.card.Content)
 	}
+	objects = append(objects, c.card.Actions...)
+	if c.card.Detail != nil {
+		if c.card.DetailOpen {
+			c.disclosure.Icon = c.card.Theme().Icon(theme.IconNameArrowDropUp)
+			c.card.Detail.Show()
+		} else {
+			c.disclosure.Icon = c.card.Theme().Icon(theme.IconNameArrowDropDown)
+			c.card.Detail.Hide()
+		}
+		c.disclosure.Refresh()
+		objects = append(objects, c.disclosure, c.card.Detail)
+	}
+	if c.card.OnTapped != nil {
+		objects = append(objects, c.tapBG) // the tap ripple highlights over the content
+	}
 	c.ShadowingRenderer.SetObjects(objects)
-
-	c.applyTheme()
-	c.Layout(c.card.Size())
-	c.ShadowingRenderer.RefreshShadow()
-	canvas.Refresh(c.card.super())
 }
 
 // applyTheme updates this button to match the current theme
@@ -142,3 +142,58 @@ func newContentRect() *canvas.Rectangle {
 
 	return rect
 }
+
+func TestCard_Actions(t *testing.T) {
+	c := widget.NewCard("Title", "sub", widget.NewLabel("Content"))
+	noActions := c.MinSize()
+
+	tapped := false
+	c.Actions = []fyne.CanvasObject{widget.NewButton("Share", func() { tapped = true })}
+	c.Refresh()
+	assert.Greater(t, c.MinSize().Height, noActions.Height)
+
+	w := test.NewTempWindow(t, c)
+	w.Resize(c.MinSize().Add(fyne.NewSize(50, 50)))
+	test.Tap(c.Actions[0].(*widget.Button))
+	assert.True(t, tapped)
+}
+
+func TestCard_Detail(t *testing.T) {
+	detail := widget.NewLabel("More information")
+	c := widget.NewCard("Title", "sub", widget.NewLabel("Content"))
+	c.SetDetail(detail)
+	test.WidgetRenderer(c)
+
+	assert.False(t, detail.Visible())
+	closed := c.MinSize()
+
+	c.SetDetailOpen(true)
+	assert.True(t, detail.Visible())
+	assert.Greater(t, c.MinSize().Height, closed.Height)
+
+	c.SetDetailOpen(false)
+	assert.False(t, detail.Visible())
+}
+
+func TestCard_Tapped(t *testing.T) {
+	tapped := false
+	c := widget.NewCard("Title", "sub", widget.NewLabel("Content"))
+	c.OnTapped = func() { tapped = true }
+
+	w := test.NewTempWindow(t, c)
+	w.Resize(fyne.NewSize(100, 100))
+	test.TapCanvas(w.Canvas(), fyne.NewPos(50, 90))
+	assert.True(t, tapped)
+}
+
+func TestCard_ImageOverlay(t *testing.T) {
+	c := widget.NewCard("Title", "sub", nil)
+	c.Image = canvas.NewImageFromResource(theme.ComputerIcon())
+	c.ImageOverlay = canvas.NewRectangle(color.NRGBA{A: 0x66})
+	r := test.TempWidgetRenderer(t, c)
+	c.Resize(fyne.NewSize(100, 200))
+
+	assert.Contains(t, r.Objects(), c.ImageOverlay)
+	assert.Equal(t, c.Image.Size(), c.ImageOverlay.Size())
+	assert.Equal(t, c.Image.Position(), c.ImageOverlay.Position())
+}
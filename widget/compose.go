@@ -0,0 +1,138 @@
+package widget
+
+import (
+	"fmt"
+	"reflect"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal/cache"
+)
+
+// Composed is a widget assembled from existing canvas objects with optional behaviour
+// overrides. It is extended during construction, so the usual ExtendBaseWidget
+// mistakes of hand written composite widgets cannot happen.
+//
+// Since: 2.6
+type Composed struct {
+	BaseWidget
+
+	content  fyne.CanvasObject
+	minSize  func() fyne.Size
+	onTapped func(*fyne.PointEvent)
+}
+
+// ComposeOption configures the behaviour of a widget built with Compose.
+//
+// Since: 2.6
+type ComposeOption func(*Composed)
+
+// Compose returns a widget that renders the given content, applying any overrides.
+// It offers a safe alternative to extending BaseWidget for simple composite widgets.
+//
+// Since: 2.6
+func Compose(content fyne.CanvasObject, overrides ...ComposeOption) *Composed {
+	c := &Composed{content: content}
+	for _, override := range overrides {
+		override(c)
+	}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+// ComposeMinSize overrides the minimum size of a composed widget.
+//
+// Since: 2.6
+func ComposeMinSize(minSize func() fyne.Size) ComposeOption {
+	return func(c *Composed) {
+		c.minSize = minSize
+	}
+}
+
+// ComposeTapped makes a composed widget tappable with the given handler.
+//
+// Since: 2.6
+func ComposeTapped(onTapped func(*fyne.PointEvent)) ComposeOption {
+	return func(c *Composed) {
+		c.onTapped = onTapped
+	}
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (c *Composed) CreateRenderer() fyne.WidgetRenderer {
+	return NewSimpleRenderer(c.content)
+}
+
+// MinSize returns the size that this widget should not shrink below.
+func (c *Composed) MinSize() fyne.Size {
+	if c.minSize != nil {
+		return c.minSize()
+	}
+	return c.BaseWidget.MinSize()
+}
+
+// Tapped is called when a pointer tapped event is captured and triggers any tap handler.
+func (c *Composed) Tapped(e *fyne.PointEvent) {
+	if c.onTapped != nil {
+		c.onTapped(e)
+	}
+}
+
+// ValidateWidget checks the passed widget for common extension mistakes, returning an
+// error describing the first problem found. It is intended for development and test
+// code, turning silent layout bugs into clear failures. The mistakes detected are a
+// widget embedding BaseWidget without calling ExtendBaseWidget, extending a different
+// widget, and a renderer with no content behind an overridden MinSize.
+//
+// Since: 2.6
+func ValidateWidget(w fyne.Widget) error {
+	if base := baseWidgetOf(w); base != nil {
+		super := base.super()
+		if super == nil {
+			return fmt.Errorf("widget %T has not called ExtendBaseWidget", w)
+		}
+		if super != w {
+			return fmt.Errorf("widget %T called ExtendBaseWidget with a different widget %T", w, super)
+		}
+	}
+
+	r := cache.Renderer(w)
+	if r == nil {
+		return fmt.Errorf("widget %T did not create a renderer", w)
+	}
+	if len(r.Objects()) == 0 && w.MinSize() != (fyne.Size{}) {
+		return fmt.Errorf("widget %T overrides MinSize but its renderer has no objects", w)
+	}
+	return nil
+}
+
+// baseWidgetOf finds a BaseWidget embedded in the widget, directly or through
+// another embedded widget such as DisableableWidget.
+func baseWidgetOf(w fyne.Widget) *BaseWidget {
+	v := reflect.ValueOf(w)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return nil
+	}
+	return baseWidgetIn(v.Elem())
+}
+
+func baseWidgetIn(v reflect.Value) *BaseWidget {
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Type().Field(i).Anonymous {
+			continue
+		}
+		field := v.Field(i)
+		if !field.CanAddr() {
+			continue
+		}
+		if base, ok := field.Addr().Interface().(*BaseWidget); ok {
+			return base
+		}
+		if base := baseWidgetIn(field); base != nil {
+			return base
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/layout"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompose(t *testing.T) {
+	tapped := false
+	box := &fyne.Container{Layout: layout.NewVBoxLayout(),
+		Objects: []fyne.CanvasObject{NewLabel("title"), NewLabel("body")}}
+	c := Compose(box,
+		ComposeMinSize(func() fyne.Size { return fyne.NewSize(200, 100) }),
+		ComposeTapped(func(*fyne.PointEvent) { tapped = true }))
+
+	assert.Equal(t, fyne.NewSize(200, 100), c.MinSize())
+	assert.NoError(t, ValidateWidget(c))
+
+	c.Tapped(&fyne.PointEvent{})
+	assert.True(t, tapped)
+}
+
+func TestValidateWidget(t *testing.T) {
+	assert.NoError(t, ValidateWidget(NewButton("ok", nil)))
+
+	missing := &extendlessWidget{}
+	err := ValidateWidget(missing)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "ExtendBaseWidget")
+
+	wrong := &extendlessWidget{}
+	wrong.ExtendBaseWidget(NewLabel("other"))
+	err = ValidateWidget(wrong)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "different widget")
+}
+
+type extendlessWidget struct {
+	BaseWidget
+}
+
+func (w *extendlessWidget) CreateRenderer() fyne.WidgetRenderer {
+	return NewSimpleRenderer(NewLabel("content"))
+}
@@ -0,0 +1,82 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// ContextMenuable is implemented by widgets that can show a pop-up menu on a
+// secondary tap, such as a right click or a long press on mobile.
+//
+// Since: 2.6
+type ContextMenuable interface {
+	// SetContextMenu sets the menu shown by a secondary tap on this widget.
+	SetContextMenu(*fyne.Menu)
+}
+
+// SetContextMenu attaches the given menu to a widget that supports one, such
+// as Label, Icon or a ContextMenuArea. It returns false if the object has no
+// context menu support, in which case it can be wrapped in a ContextMenuArea.
+//
+// Since: 2.6
+func SetContextMenu(obj fyne.CanvasObject, menu *fyne.Menu) bool {
+	menuable, ok := obj.(ContextMenuable)
+	if !ok {
+		return false
+	}
+	menuable.SetContextMenu(menu)
+	return true
+}
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*ContextMenuArea)(nil)
+var _ fyne.SecondaryTappable = (*ContextMenuArea)(nil)
+
+// ContextMenuArea wraps any canvas object so a secondary tap - a right click,
+// or a long press on mobile - pops up the given menu at the event position.
+//
+// Since: 2.6
+type ContextMenuArea struct {
+	BaseWidget
+	Content fyne.CanvasObject
+	Menu    *fyne.Menu
+}
+
+// NewContextMenuArea wraps the given object so a secondary tap shows the menu.
+//
+// Since: 2.6
+func NewContextMenuArea(content fyne.CanvasObject, menu *fyne.Menu) *ContextMenuArea {
+	a := &ContextMenuArea{Content: content, Menu: menu}
+	a.ExtendBaseWidget(a)
+	return a
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer
+func (a *ContextMenuArea) CreateRenderer() fyne.WidgetRenderer {
+	a.ExtendBaseWidget(a)
+	return NewSimpleRenderer(a.Content)
+}
+
+// SetContextMenu updates the menu shown by a secondary tap on this area.
+//
+// Since: 2.6
+func (a *ContextMenuArea) SetContextMenu(menu *fyne.Menu) {
+	a.Menu = menu
+}
+
+// TappedSecondary is called when a secondary tap is captured, showing the menu.
+func (a *ContextMenuArea) TappedSecondary(e *fyne.PointEvent) {
+	showContextMenu(a.Menu, a.super(), e.AbsolutePosition)
+}
+
+// showContextMenu pops the menu up at the given position on the canvas that
+// renders the object, if any.
+func showContextMenu(menu *fyne.Menu, obj fyne.CanvasObject, pos fyne.Position) {
+	if menu == nil || len(menu.Items) == 0 {
+		return
+	}
+	c := fyne.CurrentApp().Driver().CanvasForObject(obj)
+	if c == nil {
+		return
+	}
+	ShowPopUpMenuAtPosition(menu, c, pos)
+}
@@ -0,0 +1,45 @@
+package widget_test
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetContextMenu(t *testing.T) {
+	menu := fyne.NewMenu("", fyne.NewMenuItem("Copy", nil))
+
+	l := widget.NewLabel("Hello")
+	assert.True(t, widget.SetContextMenu(l, menu))
+	assert.Equal(t, menu, l.ContextMenu)
+
+	assert.False(t, widget.SetContextMenu(widget.NewButton("No menu", nil), menu))
+}
+
+func TestLabel_TappedSecondary(t *testing.T) {
+	menu := fyne.NewMenu("", fyne.NewMenuItem("Copy", nil))
+	l := widget.NewLabel("Hello")
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(100, 100))
+
+	test.TapSecondaryAt(l, fyne.NewPos(10, 10))
+	assert.Empty(t, w.Canvas().Overlays().List()) // no menu set
+
+	l.SetContextMenu(menu)
+	test.TapSecondaryAt(l, fyne.NewPos(10, 10))
+	assert.Len(t, w.Canvas().Overlays().List(), 1)
+}
+
+func TestContextMenuArea(t *testing.T) {
+	menu := fyne.NewMenu("", fyne.NewMenuItem("Open", nil))
+	area := widget.NewContextMenuArea(widget.NewButton("Anything", nil), menu)
+	w := test.NewTempWindow(t, area)
+	w.Resize(fyne.NewSize(100, 100))
+
+	test.TapSecondaryAt(area, fyne.NewPos(10, 10))
+	assert.Len(t, w.Canvas().Overlays().List(), 1)
+}
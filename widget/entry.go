@@ -44,13 +44,66 @@ type Entry struct {
 	// Since: 2.0
 	TextStyle   fyne.TextStyle
 	PlaceHolder string
-	OnChanged   func(string) `json:"-"`
+
+	// PlaceHolderSegments styles the placeholder with rich text segments, such as
+	// italic or coloured text, shown instead of the plain PlaceHolder string.
+	//
+	// Since: 2.6
+	PlaceHolderSegments []RichTextSegment `json:"-"`
+
+	// FloatingPlaceHolder shows the placeholder as a small label above the text
+	// while this entry is focused or filled, instead of hiding it.
+	//
+	// Since: 2.6
+	FloatingPlaceHolder bool
+
+	OnChanged func(string) `json:"-"`
 	// Since: 2.0
 	OnSubmitted func(string) `json:"-"`
 	Password    bool
 	MultiLine   bool
 	Wrapping    fyne.TextWrap
 
+	// ShowLineNumbers adds a gutter to the left of a MultiLine entry with the number
+	// of each line, highlighting the one holding the cursor. Marker icons can be
+	// added to the gutter with SetLineMarker.
+	//
+	// Since: 2.6
+	ShowLineNumbers bool
+
+	// PasswordPolicy sets the rules a Password entry scores strength against, for
+	// OnStrengthChanged and the ShowStrength bar. Plug its Validate method into
+	// Validator to also enforce the rules. A sensible default applies when nil.
+	//
+	// Since: 2.6
+	PasswordPolicy *PasswordPolicy
+
+	// OnStrengthChanged is called with the new password strength, between 0 and 1,
+	// whenever the text of a Password entry changes.
+	//
+	// Since: 2.6
+	OnStrengthChanged func(strength float64) `json:"-"`
+
+	// ShowStrength draws a thin bar along the bottom edge of a Password entry,
+	// filled and coloured to match the strength of the current text.
+	//
+	// Since: 2.6
+	ShowStrength bool
+
+	// OnRequestCompletions returns suggestions for the passed text, shown in a popup
+	// list below the entry as the user types. Return nil to hide the popup. The
+	// suggestions can be navigated with the Up and Down keys, accepted with Return
+	// or a tap, and dismissed with Escape.
+	//
+	// Since: 2.6
+	OnRequestCompletions func(text string) []string `json:"-"`
+
+	// CompletionDebounce is how long after the last keystroke to wait before asking
+	// OnRequestCompletions for suggestions. The zero value requests immediately.
+	//
+	// Since: 2.6
+	CompletionDebounce time.Duration
+
 	// Scroll can be used to turn off the scrolling of our entry when Wrapping is WrapNone.
 	//
 	// Since: 2.4
@@ -88,8 +141,29 @@ type Entry struct {
 	selectKeyDown bool
 
 	// selecting indicates whether the cursor has moved since it was at the selection start location
-	selecting bool
-	popUp     *PopUpMenu
+	selecting  bool
+	popUp      *PopUpMenu
+	completion *entryCompletion
+	inputMask  []rune
+	search     *entrySearch
+
+	// composition is the IME pre-edit text currently spliced into the entry text at
+	// compositionStart, drawn underlined until committed or cancelled.
+	composing        bool
+	compositionStart int
+	composition      []rune
+
+	// textDrag carries selected text being dragged to another position or entry.
+	// deferDeselect keeps a selection alive over a press inside it, so the press can
+	// become such a drag, and ctrlKeyDown switches the drop from move to copy.
+	textDrag                                *entryTextDrag
+	deferDeselect, didTextDrag, ctrlKeyDown bool
+
+	floatLabel   *canvas.Text
+	gutter       *entryGutter
+	lineMarkers  map[int]fyne.Resource
+	strengthBar  *canvas.Rectangle
+	lastStrength float64
 	// TODO: Add OnSelectChanged
 
 	// ActionItem is a small item which is displayed at the outer right of the entry (like a password revealer)
@@ -163,6 +237,26 @@ func (e *Entry) Bind(data binding.String) {
 	}
 }
 
+// CanRedo returns whether a previously undone change can currently be reapplied
+// with Redo.
+//
+// Since: 2.6
+func (e *Entry) CanRedo() bool {
+	e.propertyLock.RLock()
+	defer e.propertyLock.RUnlock()
+	return e.undoStack.CanRedo()
+}
+
+// CanUndo returns whether the most recent change to this entry can currently be
+// reverted with Undo.
+//
+// Since: 2.6
+func (e *Entry) CanUndo() bool {
+	e.propertyLock.RLock()
+	defer e.propertyLock.RUnlock()
+	return e.undoStack.CanUndo()
+}
+
 // CreateRenderer is a private method to Fyne which links this widget to its renderer
 //
 // Implements: fyne.Widget
@@ -215,6 +309,9 @@ func (e *Entry) CreateRenderer() fyne.WidgetRenderer {
 //
 // Implements: desktop.Cursorable
 func (e *Entry) Cursor() desktop.Cursor {
+	if e.textDrag != nil {
+		return desktop.PointerCursor
+	}
 	return desktop.TextCursor
 }
 
@@ -266,6 +363,11 @@ func (e *Entry) isTripleTap(nowMilli int64) bool {
 //
 // Implements: fyne.Draggable
 func (e *Entry) DragEnd() {
+	if e.textDrag != nil {
+		e.finishTextDrag()
+		return
+	}
+
 	e.propertyLock.Lock()
 	if e.CursorColumn == e.selectColumn && e.CursorRow == e.selectRow {
 		e.selecting = false
@@ -282,6 +384,17 @@ func (e *Entry) DragEnd() {
 //
 // Implements: fyne.Draggable
 func (e *Entry) Dragged(d *fyne.DragEvent) {
+	if e.textDrag != nil {
+		e.textDragged(d)
+		return
+	}
+	if e.deferDeselect && e.selecting {
+		// the drag began on the selection, carry the text rather than reselecting
+		e.startTextDrag()
+		e.textDragged(d)
+		return
+	}
+
 	pos := d.Position.Subtract(e.scroll.Offset).Add(fyne.NewPos(0, e.Theme().Size(theme.SizeNameInputBorder)))
 	if !e.selecting {
 		startPos := pos.Subtract(d.Dragged)
@@ -329,6 +442,7 @@ func (e *Entry) FocusGained() {
 //
 // Implements: fyne.Focusable
 func (e *Entry) FocusLost() {
+	e.hideCompletions()
 	e.setFieldsAndRefresh(func() {
 		e.focused = false
 		e.selectKeyDown = false
@@ -346,6 +460,7 @@ func (e *Entry) Hide() {
 		e.popUp.Hide()
 		e.popUp = nil
 	}
+	e.hideCompletions()
 	e.DisableableWidget.Hide()
 }
 
@@ -382,6 +497,9 @@ func (e *Entry) KeyDown(key *fyne.KeyEvent) {
 		}
 		e.selectKeyDown = true
 	}
+	if key.Name == desktop.KeyControlLeft || key.Name == desktop.KeyControlRight {
+		e.ctrlKeyDown = true
+	}
 }
 
 // KeyUp handler for key release events - used to reset shift modifier state for text selection
@@ -396,6 +514,20 @@ func (e *Entry) KeyUp(key *fyne.KeyEvent) {
 	if key.Name == desktop.KeyShiftLeft || key.Name == desktop.KeyShiftRight {
 		e.selectKeyDown = false
 	}
+	if key.Name == desktop.KeyControlLeft || key.Name == desktop.KeyControlRight {
+		e.ctrlKeyDown = false
+	}
+}
+
+// Baseline returns the distance from the top of the entry to the first text
+// baseline, used by layouts that align objects on text baselines.
+//
+// Since: 2.6
+func (e *Entry) Baseline() float32 {
+	th := e.Theme()
+	// the baseline comes from the font metrics so the text itself does not matter
+	_, base := fyne.CurrentApp().Driver().RenderedTextSize("M", th.Size(theme.SizeNameText), e.TextStyle, nil)
+	return th.Size(theme.SizeNameInputBorder) + th.Size(theme.SizeNameInnerPadding) + base
 }
 
 // MinSize returns the size that this widget should not shrink below.
@@ -427,6 +559,14 @@ func (e *Entry) MouseDown(m *desktop.MouseEvent) {
 		e.selectCurrentRow()
 		return
 	}
+	if m.Button == desktop.MouseButtonPrimary && !e.selectKeyDown && e.selectionContains(m.Position) {
+		// keep the selection so it can be dragged; a plain click deselects on mouse up
+		e.deferDeselect = true
+		if !e.Disabled() {
+			e.requestFocus()
+		}
+		return
+	}
 	e.propertyLock.Lock()
 	if e.selectKeyDown {
 		e.selecting = true
@@ -449,6 +589,20 @@ func (e *Entry) MouseDown(m *desktop.MouseEvent) {
 //
 // Implements: desktop.Mouseable
 func (e *Entry) MouseUp(m *desktop.MouseEvent) {
+	if e.deferDeselect {
+		e.deferDeselect = false
+		if !e.didTextDrag {
+			// the press inside the selection never became a drag, treat it as a click
+			e.propertyLock.Lock()
+			e.selecting = false
+			e.propertyLock.Unlock()
+			e.updateMousePointer(m.Position, m.Button == desktop.MouseButtonSecondary)
+			e.Refresh()
+		}
+		e.didTextDrag = false
+		return
+	}
+
 	e.propertyLock.Lock()
 	defer e.propertyLock.Unlock()
 
@@ -523,6 +677,11 @@ func (e *Entry) SetPlaceHolder(text string) {
 
 	e.propertyLock.Lock()
 	e.PlaceHolder = text
+	if len(e.PlaceHolderSegments) > 0 {
+		// return to a plain text placeholder
+		e.PlaceHolderSegments = nil
+		e.placeholder.Segments = nil
+	}
 	e.propertyLock.Unlock()
 
 	e.placeholderProvider().Segments[0].(*TextSegment).Text = text
@@ -564,6 +723,7 @@ func (e *Entry) Append(text string) {
 		if cb != nil {
 			cb(content)
 		}
+		e.reportStrength()
 	}
 	e.Refresh()
 }
@@ -680,6 +840,9 @@ func (e *Entry) TypedKey(key *fyne.KeyEvent) {
 	if e.cursorAnim != nil {
 		e.cursorAnim.interrupt()
 	}
+	if e.completionHandleKey(key) {
+		return
+	}
 	e.propertyLock.RLock()
 	provider := e.textProvider()
 	multiLine := e.MultiLine
@@ -774,6 +937,8 @@ func (e *Entry) TypedKey(key *fyne.KeyEvent) {
 		if cb != nil {
 			cb(content)
 		}
+		e.reportStrength()
+		e.requestCompletions()
 	}
 	e.Refresh()
 }
@@ -935,10 +1100,18 @@ func (e *Entry) TypedRune(r rune) {
 		e.eraseSelection()
 	}
 
-	runes := []rune{r}
 	pos := e.cursorTextPos()
-
 	provider := e.textProvider()
+
+	runes := []rune{r}
+	if e.inputMask != nil {
+		var ok bool
+		pos, runes, ok = e.applyInputMask(pos, r, provider.len())
+		if !ok {
+			e.propertyLock.Unlock()
+			return
+		}
+	}
 	provider.insertAt(pos, runes)
 
 	content := provider.String()
@@ -955,6 +1128,8 @@ func (e *Entry) TypedRune(r rune) {
 	if cb != nil {
 		cb(content)
 	}
+	e.reportStrength()
+	e.requestCompletions()
 	e.Refresh()
 }
 
@@ -1022,6 +1197,7 @@ func (e *Entry) cutToClipboard(clipboard fyne.Clipboard) {
 	if cb != nil {
 		cb(content)
 	}
+	e.reportStrength()
 	e.Refresh()
 }
 
@@ -1110,6 +1286,14 @@ func (e *Entry) pasteFromClipboard(clipboard fyne.Clipboard) {
 	runes := []rune(text)
 	pos := e.cursorTextPos()
 	provider := e.textProvider()
+	if e.inputMask != nil {
+		var ok bool
+		pos, runes, ok = e.maskInsert(pos, runes, provider.len())
+		if !ok {
+			e.propertyLock.Unlock()
+			return
+		}
+	}
 	provider.insertAt(pos, runes)
 
 	e.undoStack.Add(&entryModifyAction{
@@ -1126,6 +1310,7 @@ func (e *Entry) pasteFromClipboard(clipboard fyne.Clipboard) {
 	if cb != nil {
 		cb(content) // We know that the text has changed.
 	}
+	e.reportStrength()
 
 	e.Refresh() // placing the cursor (and refreshing) happens last
 }
@@ -1139,6 +1324,11 @@ func (e *Entry) placeholderProvider() *RichText {
 	e.placeholder.Scroll = widget.ScrollNone
 	e.placeholder.inset = fyne.NewSize(0, e.themeWithLock().Size(theme.SizeNameInputBorder))
 
+	if len(e.PlaceHolderSegments) > 0 {
+		e.placeholder.Segments = e.PlaceHolderSegments
+		return &e.placeholder
+	}
+
 	style := RichTextStyleInline
 	style.ColorName = theme.ColorNamePlaceHolder
 	style.TextStyle = e.TextStyle
@@ -1160,6 +1350,11 @@ func (e *Entry) registerShortcut() {
 	e.shortcut.AddShortcut(&fyne.ShortcutRedo{}, func(se fyne.Shortcut) {
 		e.Redo()
 	})
+	// the common alternative redo binding alongside the platform default
+	e.shortcut.AddShortcut(&desktop.CustomShortcut{KeyName: fyne.KeyZ,
+		Modifier: fyne.KeyModifierShortcutDefault | fyne.KeyModifierShift}, func(se fyne.Shortcut) {
+		e.Redo()
+	})
 	e.shortcut.AddShortcut(&fyne.ShortcutCut{}, func(se fyne.Shortcut) {
 		cut := se.(*fyne.ShortcutCut)
 		e.cutToClipboard(cut.Clipboard)
@@ -1284,6 +1479,23 @@ func (e *Entry) rowColFromTextPos(pos int) (row int, col int) {
 	return
 }
 
+// selectionContains returns whether the passed point, relative to this entry, lies
+// within the currently selected text.
+func (e *Entry) selectionContains(p fyne.Position) bool {
+	e.propertyLock.RLock()
+	start, end := e.selection()
+	e.propertyLock.RUnlock()
+	if start < 0 {
+		return false
+	}
+
+	row, col := e.getRowCol(p)
+	e.propertyLock.RLock()
+	pos := e.textPosFromRowCol(row, col)
+	e.propertyLock.RUnlock()
+	return pos > start && pos < end
+}
+
 // selectAll selects all text in entry
 func (e *Entry) selectAll() {
 	if e.textProvider().len() == 0 {
@@ -1332,6 +1544,7 @@ func (e *Entry) selectingKeyHandler(key *fyne.KeyEvent) bool {
 		if cb != nil {
 			cb(content)
 		}
+		e.reportStrength()
 		e.Refresh()
 		return true
 	case fyne.KeyReturn, fyne.KeyEnter:
@@ -1433,6 +1646,9 @@ func (e *Entry) syncSegments() {
 	placeholder := e.placeholderProvider()
 	placeholder.Wrapping = wrap
 
+	if len(e.PlaceHolderSegments) > 0 {
+		return // custom segments keep their own styling
+	}
 	textSegment = placeholder.Segments[0].(*TextSegment)
 	textSegment.Style.ColorName = colName
 	textSegment.Style.TextStyle = e.TextStyle
@@ -1570,6 +1786,7 @@ func (e *Entry) updateTextAndRefresh(text string, fromBinding bool) {
 	if callback != nil {
 		callback(text)
 	}
+	e.reportStrength()
 	e.Refresh()
 }
 
@@ -1660,6 +1877,9 @@ type entryRenderer struct {
 }
 
 func (r *entryRenderer) Destroy() {
+	if r.entry.Password {
+		r.entry.wipeBuffers()
+	}
 }
 
 func (r *entryRenderer) trailingInset() float32 {
@@ -1722,6 +1942,20 @@ func (r *entryRenderer) Layout(size fyne.Size) {
 	r.entry.placeholderProvider().inset = fyne.NewSize(0, inputBorder)
 	entrySize := size.Subtract(fyne.NewSize(r.trailingInset(), inputBorder*2))
 	entryPos := fyne.NewPos(0, inputBorder)
+	if inset := r.floatInset(); inset > 0 {
+		entryPos.Y += inset
+		entrySize.Height -= inset
+		r.ensureFloatSetup()
+	}
+	if gutterWidth := r.gutterWidth(); gutterWidth > 0 {
+		r.ensureGutterSetup()
+		r.entry.gutter.Resize(fyne.NewSize(gutterWidth, entrySize.Height))
+		r.entry.gutter.Move(entryPos)
+		entryPos.X += gutterWidth
+		entrySize.Width -= gutterWidth
+	} else if r.entry.gutter != nil {
+		r.entry.gutter.Hide()
+	}
 
 	r.entry.propertyLock.Lock()
 	textPos := r.entry.textPosFromRowCol(r.entry.CursorRow, r.entry.CursorColumn)
@@ -1787,6 +2021,8 @@ func (r *entryRenderer) MinSize() fyne.Size {
 	if r.entry.Validator != nil {
 		minSize.Width += iconSpace
 	}
+	minSize.Height += r.floatInset()
+	minSize.Width += r.gutterWidth()
 
 	return minSize
 }
@@ -1817,11 +2053,13 @@ func (r *entryRenderer) Refresh() {
 	inputBorder := th.Size(theme.SizeNameInputBorder)
 
 	// correct our scroll wrappers if the wrap mode changed
-	entrySize := r.entry.size.Load().Subtract(fyne.NewSize(r.trailingInset(), inputBorder*2))
+	floatInset := r.floatInset()
+	gutterWidth := r.gutterWidth()
+	entrySize := r.entry.size.Load().Subtract(fyne.NewSize(r.trailingInset()+gutterWidth, inputBorder*2+floatInset))
 	if wrapping == fyne.TextWrapOff && scroll == widget.ScrollNone && r.scroll.Content != nil {
 		r.scroll.Hide()
 		r.scroll.Content = nil
-		content.Move(fyne.NewPos(0, inputBorder))
+		content.Move(fyne.NewPos(gutterWidth, inputBorder+floatInset))
 		content.Resize(entrySize)
 
 		for i, o := range r.objects {
@@ -1833,7 +2071,7 @@ func (r *entryRenderer) Refresh() {
 	} else if (wrapping != fyne.TextWrapOff || scroll != widget.ScrollNone) && r.scroll.Content == nil {
 		r.scroll.Content = content
 		content.Move(fyne.NewPos(0, 0))
-		r.scroll.Move(fyne.NewPos(0, inputBorder))
+		r.scroll.Move(fyne.NewPos(gutterWidth, inputBorder+floatInset))
 		r.scroll.Resize(entrySize)
 		r.scroll.Show()
 
@@ -1872,6 +2110,15 @@ func (r *entryRenderer) Refresh() {
 		r.entry.validationStatus.Hide()
 	}
 
+	r.refreshFloatLabel(th, v, focusedAppearance)
+	r.refreshStrengthBar(th, v)
+	if r.gutterWidth() > 0 {
+		r.ensureGutterSetup()
+		r.entry.gutter.Refresh()
+	} else if r.entry.gutter != nil {
+		r.entry.gutter.Hide()
+	}
+
 	cache.Renderer(r.entry.content).Refresh()
 	canvas.Refresh(r.entry.super())
 }
@@ -1908,7 +2155,7 @@ func (e *entryContent) CreateRenderer() fyne.WidgetRenderer {
 	}
 	objects := []fyne.CanvasObject{placeholder, provider, e.entry.cursorAnim.cursor}
 
-	r := &entryContentRenderer{e.entry.cursorAnim.cursor, []fyne.CanvasObject{}, objects,
+	r := &entryContentRenderer{e.entry.cursorAnim.cursor, []fyne.CanvasObject{}, nil, objects,
 		provider, placeholder, e}
 	r.updateScrollDirections()
 	r.Layout(e.size.Load())
@@ -1936,9 +2183,10 @@ func (e *entryContent) Dragged(d *fyne.DragEvent) {
 var _ fyne.WidgetRenderer = (*entryContentRenderer)(nil)
 
 type entryContentRenderer struct {
-	cursor    *canvas.Rectangle
-	selection []fyne.CanvasObject
-	objects   []fyne.CanvasObject
+	cursor      *canvas.Rectangle
+	selection   []fyne.CanvasObject
+	composition []fyne.CanvasObject
+	objects     []fyne.CanvasObject
 
 	provider, placeholder *RichText
 	content               *entryContent
@@ -1968,9 +2216,12 @@ func (r *entryContentRenderer) Objects() []fyne.CanvasObject {
 	r.content.entry.propertyLock.RLock()
 	defer r.content.entry.propertyLock.RUnlock()
 	// Objects are generated dynamically force selection rectangles to appear underneath the text
-	if r.content.entry.selecting {
-		objs := make([]fyne.CanvasObject, 0, len(r.selection)+len(r.objects))
-		objs = append(objs, r.selection...)
+	if r.content.entry.selecting || r.content.entry.composing {
+		objs := make([]fyne.CanvasObject, 0, len(r.selection)+len(r.composition)+len(r.objects))
+		if r.content.entry.selecting {
+			objs = append(objs, r.selection...)
+		}
+		objs = append(objs, r.composition...)
 		return append(objs, r.objects...)
 	}
 	return r.objects
@@ -1986,7 +2237,7 @@ func (r *entryContentRenderer) Refresh() {
 	r.updateScrollDirections()
 	r.content.entry.propertyLock.RUnlock()
 
-	if provider.len() == 0 {
+	if provider.len() == 0 && !r.content.entry.floatPlaceholderActive(focused) {
 		placeholder.Show()
 	} else if placeholder.Visible() {
 		placeholder.Hide()
@@ -2148,6 +2399,7 @@ func (r *entryContentRenderer) ensureCursorVisible() {
 func (r *entryContentRenderer) moveCursor() {
 	// build r.selection[] if the user has made a selection
 	r.buildSelection()
+	r.buildComposition()
 
 	th := r.content.entry.Theme()
 	textSize := th.Size(theme.SizeNameText)
@@ -2400,11 +2652,21 @@ func (u *entryUndoStack) CanRedo() bool {
 	return u.index != len(u.items)
 }
 
+// entryUndoLimit caps how many history items an entry keeps, dropping the oldest
+// actions once it is reached.
+const entryUndoLimit = 1000
+
 // Adds the action to the stack, which can later be undone by calling Undo()
 func (u *entryUndoStack) Add(a entryUndoAction) {
 	u.items = u.items[:u.index]
 	u.items = append(u.items, a)
 	u.index++
+
+	if len(u.items) > entryUndoLimit {
+		over := len(u.items) - entryUndoLimit
+		u.items = u.items[over:]
+		u.index -= over
+	}
 }
 
 // Tries to merge the action with the last item on the undo stack.
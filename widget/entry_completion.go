@@ -0,0 +1,214 @@
+package widget
+
+import (
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// maxCompletionRows limits how many suggestions are visible before the popup scrolls.
+const maxCompletionRows = 5
+
+// entryCompletion manages the popup of suggestions shown below an Entry with an
+// OnRequestCompletions callback set.
+type entryCompletion struct {
+	entry *Entry
+
+	mu      sync.Mutex // guards the fields below, the debounce timer fires off the UI goroutine
+	popUp   *PopUp
+	list    *List
+	options []string
+
+	selected   int
+	navigating bool // ignore list selection callbacks caused by keyboard navigation
+	pending    *time.Timer
+}
+
+// requestCompletions asks the OnRequestCompletions callback for suggestions matching
+// the current text and shows them, honouring any CompletionDebounce delay.
+func (e *Entry) requestCompletions() {
+	if e.OnRequestCompletions == nil {
+		return
+	}
+	if e.completion == nil {
+		e.completion = &entryCompletion{entry: e}
+	}
+
+	c := e.completion
+	c.cancelPending()
+	if e.CompletionDebounce <= 0 {
+		c.show(e.OnRequestCompletions(e.Text))
+		return
+	}
+
+	text := e.Text // read on the UI goroutine, not when the timer fires
+	timer := time.AfterFunc(e.CompletionDebounce, func() {
+		c.show(e.OnRequestCompletions(text))
+	})
+	c.mu.Lock()
+	c.pending = timer
+	c.mu.Unlock()
+}
+
+// completionHandleKey intercepts navigation keys while the suggestion popup is open,
+// returning true when the key was consumed.
+func (e *Entry) completionHandleKey(key *fyne.KeyEvent) bool {
+	c := e.completion
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	popUp := c.popUp
+	c.mu.Unlock()
+	if popUp == nil || !popUp.Visible() {
+		return false
+	}
+
+	switch key.Name {
+	case fyne.KeyDown:
+		c.move(1)
+	case fyne.KeyUp:
+		c.move(-1)
+	case fyne.KeyReturn, fyne.KeyEnter:
+		c.accept()
+	case fyne.KeyEscape:
+		c.hide()
+	default:
+		return false
+	}
+	return true
+}
+
+// hideCompletions dismisses the suggestion popup and cancels any pending request.
+func (e *Entry) hideCompletions() {
+	if e.completion == nil {
+		return
+	}
+	e.completion.cancelPending()
+	e.completion.hide()
+}
+
+func (c *entryCompletion) accept() {
+	c.mu.Lock()
+	text := ""
+	ok := c.selected >= 0 && c.selected < len(c.options)
+	if ok {
+		text = c.options[c.selected]
+	}
+	c.mu.Unlock()
+
+	c.hide()
+	if !ok {
+		return
+	}
+	c.entry.SetText(text)
+	c.entry.CursorRow, c.entry.CursorColumn = c.entry.rowColFromTextPos(len([]rune(text)))
+	c.entry.Refresh()
+}
+
+func (c *entryCompletion) cancelPending() {
+	c.mu.Lock()
+	if c.pending != nil {
+		c.pending.Stop()
+		c.pending = nil
+	}
+	c.mu.Unlock()
+}
+
+func (c *entryCompletion) hide() {
+	c.mu.Lock()
+	popUp := c.popUp
+	c.mu.Unlock()
+
+	if popUp != nil {
+		popUp.Hide()
+	}
+}
+
+func (c *entryCompletion) move(delta int) {
+	c.mu.Lock()
+	c.selected += delta
+	if c.selected < 0 {
+		c.selected = 0
+	}
+	if max := len(c.options) - 1; c.selected > max {
+		c.selected = max
+	}
+	c.navigating = true
+	selected := c.selected
+	list := c.list
+	c.mu.Unlock()
+
+	list.Select(selected)
+
+	c.mu.Lock()
+	c.navigating = false
+	c.mu.Unlock()
+}
+
+func (c *entryCompletion) show(options []string) {
+	if len(options) == 0 {
+		c.hide()
+		return
+	}
+
+	entry := c.entry.super()
+	canvas := fyne.CurrentApp().Driver().CanvasForObject(entry)
+	if canvas == nil {
+		return
+	}
+
+	c.mu.Lock()
+	c.options = options
+	c.selected = -1
+	if c.list == nil {
+		c.list = NewList(
+			func() int {
+				c.mu.Lock()
+				defer c.mu.Unlock()
+				return len(c.options)
+			},
+			func() fyne.CanvasObject {
+				return NewLabel("template")
+			},
+			func(id ListItemID, item fyne.CanvasObject) {
+				c.mu.Lock()
+				text := ""
+				if id >= 0 && id < len(c.options) {
+					text = c.options[id]
+				}
+				c.mu.Unlock()
+				item.(*Label).SetText(text)
+			})
+		c.list.OnSelected = func(id ListItemID) {
+			c.mu.Lock()
+			c.selected = id
+			navigating := c.navigating
+			c.mu.Unlock()
+			if !navigating {
+				c.accept()
+			}
+		}
+	}
+	list := c.list
+	if c.popUp == nil {
+		c.popUp = NewPopUp(list, canvas)
+	}
+	popUp := c.popUp
+	c.mu.Unlock()
+
+	list.UnselectAll()
+	list.Refresh()
+	list.ScrollToTop()
+
+	rows := len(options)
+	if rows > maxCompletionRows {
+		rows = maxCompletionRows
+	}
+	pad := c.entry.Theme().Size(theme.SizeNamePadding)
+	rowHeight := NewLabel("template").MinSize().Height + pad
+	popUp.ShowAtRelativePosition(fyne.NewPos(0, c.entry.Size().Height), entry)
+	popUp.Resize(fyne.NewSize(c.entry.Size().Width, float32(rows)*rowHeight+pad*2))
+}
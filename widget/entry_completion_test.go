@@ -0,0 +1,107 @@
+package widget
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func completionEntry() (*Entry, fyne.Window) {
+	entry := NewEntry()
+	entry.OnRequestCompletions = func(text string) []string {
+		options := []string{}
+		for _, o := range []string{"apple", "apricot", "banana"} {
+			if strings.HasPrefix(o, text) {
+				options = append(options, o)
+			}
+		}
+		return options
+	}
+
+	w := test.NewWindow(entry)
+	w.Resize(fyne.NewSize(150, 200))
+	entry.Resize(entry.MinSize().Max(fyne.NewSize(130, 0)))
+	w.Canvas().Focus(entry)
+	return entry, w
+}
+
+func TestEntry_Completions(t *testing.T) {
+	entry, w := completionEntry()
+	defer w.Close()
+
+	test.Type(entry, "a")
+	assert.NotNil(t, entry.completion)
+	assert.True(t, entry.completion.popUp.Visible())
+	assert.Equal(t, []string{"apple", "apricot"}, entry.completion.options)
+
+	test.Type(entry, "pp")
+	assert.Equal(t, []string{"apple"}, entry.completion.options)
+
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, "apple", entry.Text)
+	assert.False(t, entry.completion.popUp.Visible())
+	assert.Equal(t, 5, entry.CursorColumn)
+}
+
+func TestEntry_Completions_Keyboard(t *testing.T) {
+	entry, w := completionEntry()
+	defer w.Close()
+
+	test.Type(entry, "a")
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	assert.Equal(t, 1, entry.completion.selected)
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown}) // clamped to the last option
+	assert.Equal(t, 1, entry.completion.selected)
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	assert.Equal(t, 0, entry.completion.selected)
+
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEscape})
+	assert.False(t, entry.completion.popUp.Visible())
+	assert.Equal(t, "a", entry.Text)
+}
+
+func TestEntry_Completions_Hide(t *testing.T) {
+	entry, w := completionEntry()
+	defer w.Close()
+
+	test.Type(entry, "a")
+	assert.True(t, entry.completion.popUp.Visible())
+
+	test.Type(entry, "z") // no matches
+	assert.False(t, entry.completion.popUp.Visible())
+
+	test.Type(entry, "") // nothing typed, popup stays hidden
+	entry.FocusLost()
+	assert.False(t, entry.completion.popUp.Visible())
+}
+
+func TestEntry_Completions_Debounce(t *testing.T) {
+	entry, w := completionEntry()
+	defer w.Close()
+	entry.CompletionDebounce = 30 * time.Millisecond
+
+	popUp := func() *PopUp {
+		c := entry.completion
+		if c == nil {
+			return nil
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return c.popUp
+	}
+
+	test.Type(entry, "a")
+	assert.Nil(t, popUp())
+
+	assert.Eventually(t, func() bool {
+		p := popUp()
+		return p != nil && p.Visible()
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,124 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal/cache"
+)
+
+// entryTextDrag tracks a selection being dragged out of an Entry, recording where
+// the pointer is so the drop target can be resolved when the drag ends.
+type entryTextDrag struct {
+	text     string
+	position fyne.Position // relative to the canvas
+}
+
+// startTextDrag begins dragging the selected text. It is called when a drag starts
+// from a press inside the current selection.
+func (e *Entry) startTextDrag() {
+	e.textDrag = &entryTextDrag{text: e.SelectedText()}
+	e.didTextDrag = true
+}
+
+// textDragged keeps the canvas position of the pointer while dragging text.
+func (e *Entry) textDragged(d *fyne.DragEvent) {
+	e.textDrag.position = fyne.CurrentApp().Driver().AbsolutePositionForObject(e.super()).Add(d.Position)
+}
+
+// finishTextDrag drops the dragged text into the entry under the pointer, moving it
+// out of this entry unless the control key is held to copy.
+func (e *Entry) finishTextDrag() {
+	drag := e.textDrag
+	e.textDrag = nil
+
+	c := fyne.CurrentApp().Driver().CanvasForObject(e.super())
+	if c == nil {
+		return
+	}
+	target := entryAtPosition(c, drag.position)
+	if target == nil || target.Disabled() {
+		e.Refresh()
+		return
+	}
+
+	local := drag.position.Subtract(fyne.CurrentApp().Driver().AbsolutePositionForObject(target.super()))
+	row, col := target.getRowCol(local)
+	target.propertyLock.RLock()
+	row, col = target.truncatePosition(row, col)
+	dropPos := target.textPosFromRowCol(row, col)
+	target.propertyLock.RUnlock()
+
+	e.propertyLock.RLock()
+	selStart, selEnd := e.selection()
+	e.propertyLock.RUnlock()
+	if selStart < 0 {
+		return
+	}
+	move := !e.ctrlKeyDown
+
+	if target == e {
+		if dropPos >= selStart && dropPos <= selEnd {
+			e.Refresh() // dropped back onto the selection
+			return
+		}
+		if move {
+			if dropPos > selEnd {
+				dropPos -= selEnd - selStart
+			}
+			e.replaceRange(selStart, selEnd, "")
+		}
+		e.replaceRange(dropPos, dropPos, drag.text)
+		return
+	}
+
+	target.replaceRange(dropPos, dropPos, drag.text)
+	if move {
+		e.replaceRange(selStart, selEnd, "")
+	} else {
+		e.setFieldsAndRefresh(func() {
+			e.selecting = false
+		})
+	}
+	c.Focus(target.super().(fyne.Focusable))
+}
+
+// dragDropEntry allows widgets embedding Entry to be found as text drop targets.
+func (e *Entry) dragDropEntry() *Entry {
+	return e
+}
+
+// entryAtPosition walks the rendered content of the canvas to find the entry under
+// the passed position, if any.
+func entryAtPosition(c fyne.Canvas, pos fyne.Position) *Entry {
+	var found *Entry
+	var walk func(obj fyne.CanvasObject, offset fyne.Position)
+	walk = func(obj fyne.CanvasObject, offset fyne.Position) {
+		if obj == nil || !obj.Visible() {
+			return
+		}
+		topLeft := obj.Position().Add(offset)
+		if container, ok := obj.(*fyne.Container); ok {
+			for _, child := range container.Objects {
+				walk(child, topLeft)
+			}
+			return
+		}
+		if o, ok := obj.(interface{ dragDropEntry() *Entry }); ok {
+			size := obj.Size()
+			if pos.X >= topLeft.X && pos.Y >= topLeft.Y &&
+				pos.X <= topLeft.X+size.Width && pos.Y <= topLeft.Y+size.Height {
+				found = o.dragDropEntry()
+			}
+			return
+		}
+		if wid, ok := obj.(fyne.Widget); ok && cache.IsRendered(wid) {
+			for _, child := range cache.Renderer(wid).Objects() {
+				walk(child, topLeft)
+			}
+		}
+	}
+	walk(c.Content(), fyne.NewPos(0, 0))
+	for _, overlay := range c.Overlays().List() {
+		walk(overlay, fyne.NewPos(0, 0))
+	}
+	return found
+}
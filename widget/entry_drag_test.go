@@ -0,0 +1,91 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func textDragEntries(t *testing.T) (source, target *Entry, w fyne.Window) {
+	source = NewEntry()
+	source.SetText("hello world")
+	target = NewEntry()
+
+	box := &fyne.Container{Layout: layout.NewVBoxLayout(),
+		Objects: []fyne.CanvasObject{source, target}}
+	w = test.NewTempWindow(t, box)
+	w.Resize(fyne.NewSize(200, 150))
+
+	// select "hello"
+	source.selectRow, source.selectColumn = 0, 0
+	source.CursorRow, source.CursorColumn = 0, 5
+	source.selecting = true
+	return source, target, w
+}
+
+// dragBetween sends the events of a pointer drag from inside the source selection
+// to the passed position on the target entry.
+func dragBetween(source *Entry, target *Entry, at fyne.Position) {
+	press := &desktop.MouseEvent{Button: desktop.MouseButtonPrimary,
+		PointEvent: fyne.PointEvent{Position: getClickPosition("he", 0).Position}}
+	source.MouseDown(press)
+
+	driver := fyne.CurrentApp().Driver()
+	drop := driver.AbsolutePositionForObject(target).Add(at).Subtract(driver.AbsolutePositionForObject(source))
+	source.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: drop}, Dragged: fyne.NewDelta(0, 40)})
+	source.DragEnd()
+	source.MouseUp(press)
+}
+
+func TestEntry_TextDrag_Move(t *testing.T) {
+	source, target, w := textDragEntries(t)
+
+	dragBetween(source, target, fyne.NewPos(5, 10))
+	assert.Equal(t, "hello", target.Text)
+	assert.Equal(t, " world", source.Text)
+	assert.Equal(t, target, w.Canvas().Focused())
+}
+
+func TestEntry_TextDrag_Copy(t *testing.T) {
+	source, target, _ := textDragEntries(t)
+
+	source.KeyDown(&fyne.KeyEvent{Name: desktop.KeyControlLeft})
+	dragBetween(source, target, fyne.NewPos(5, 10))
+	source.KeyUp(&fyne.KeyEvent{Name: desktop.KeyControlLeft})
+
+	assert.Equal(t, "hello", target.Text)
+	assert.Equal(t, "hello world", source.Text, "copy keeps the source text")
+}
+
+func TestEntry_TextDrag_SameEntry(t *testing.T) {
+	source, _, _ := textDragEntries(t)
+
+	end := getClickPosition("hello world", 0)
+	press := &desktop.MouseEvent{Button: desktop.MouseButtonPrimary,
+		PointEvent: fyne.PointEvent{Position: getClickPosition("he", 0).Position}}
+	source.MouseDown(press)
+	source.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: end.Position}, Dragged: fyne.NewDelta(40, 0)})
+	assert.NotNil(t, source.textDrag)
+	source.DragEnd()
+	source.MouseUp(press)
+
+	assert.Equal(t, " worldhello", source.Text)
+}
+
+func TestEntry_ClickInSelection_Deselects(t *testing.T) {
+	source, _, _ := textDragEntries(t)
+
+	press := &desktop.MouseEvent{Button: desktop.MouseButtonPrimary,
+		PointEvent: fyne.PointEvent{Position: getClickPosition("he", 0).Position}}
+	source.MouseDown(press)
+	assert.True(t, source.selecting, "selection kept in case a drag starts")
+
+	source.MouseUp(press)
+	assert.False(t, source.selecting)
+	assert.Equal(t, "hello world", source.Text)
+}
@@ -0,0 +1,200 @@
+package widget
+
+import (
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// SetLineMarker places a marker icon, such as a breakpoint dot, in the gutter next
+// to the passed line, counted from 0. A nil resource removes the marker. Markers
+// are only visible while ShowLineNumbers is set.
+//
+// Since: 2.6
+func (e *Entry) SetLineMarker(line int, marker fyne.Resource) {
+	if e.lineMarkers == nil {
+		e.lineMarkers = make(map[int]fyne.Resource)
+	}
+	if marker == nil {
+		delete(e.lineMarkers, line)
+	} else {
+		e.lineMarkers[line] = marker
+	}
+	if e.gutter != nil {
+		e.gutter.Refresh()
+	}
+}
+
+// LineMarker returns the marker icon shown next to the passed line, or nil.
+//
+// Since: 2.6
+func (e *Entry) LineMarker(line int) fyne.Resource {
+	return e.lineMarkers[line]
+}
+
+// lineCount returns how many logical lines the entry text holds.
+func (e *Entry) lineCount() int {
+	count := 1
+	for _, r := range e.Text {
+		if r == '\n' {
+			count++
+		}
+	}
+	return count
+}
+
+// gutterWidth returns the horizontal space reserved to the left of the text for
+// the line number gutter, zero when it is not shown.
+func (r *entryRenderer) gutterWidth() float32 {
+	if !r.entry.ShowLineNumbers || !r.entry.MultiLine {
+		return 0
+	}
+	return entryGutterWidth(r.entry)
+}
+
+// ensureGutterSetup lazily creates the line number gutter and refreshes it as the
+// entry content scrolls.
+func (r *entryRenderer) ensureGutterSetup() {
+	if r.entry.gutter != nil {
+		r.entry.gutter.Show()
+		return
+	}
+	gutter := newEntryGutter(r.entry)
+	r.entry.gutter = gutter
+	r.scroll.OnScrolled = func(fyne.Position) {
+		gutter.Refresh()
+	}
+	r.objects = append(r.objects, gutter)
+}
+
+func entryGutterWidth(e *Entry) float32 {
+	th := e.Theme()
+	width := fyne.MeasureText(strconv.Itoa(e.lineCount()), th.Size(theme.SizeNameText),
+		fyne.TextStyle{Monospace: true}).Width
+	if len(e.lineMarkers) > 0 {
+		width += th.Size(theme.SizeNameInlineIcon)
+	}
+	return width + th.Size(theme.SizeNameInnerPadding)*2
+}
+
+// entryGutter draws line numbers and markers beside the text of a multiline Entry,
+// highlighting the line holding the cursor.
+type entryGutter struct {
+	BaseWidget
+
+	entry *Entry
+}
+
+func newEntryGutter(e *Entry) *entryGutter {
+	g := &entryGutter{entry: e}
+	g.ExtendBaseWidget(g)
+	return g
+}
+
+func (g *entryGutter) CreateRenderer() fyne.WidgetRenderer {
+	return &entryGutterRenderer{gutter: g}
+}
+
+var _ fyne.WidgetRenderer = (*entryGutterRenderer)(nil)
+
+type entryGutterRenderer struct {
+	gutter  *entryGutter
+	objects []fyne.CanvasObject
+}
+
+func (r *entryGutterRenderer) Destroy() {
+}
+
+func (r *entryGutterRenderer) Layout(fyne.Size) {
+}
+
+func (r *entryGutterRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(entryGutterWidth(r.gutter.entry), 0)
+}
+
+func (r *entryGutterRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+func (r *entryGutterRenderer) Refresh() {
+	e := r.gutter.entry
+	th := e.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	textSize := th.Size(theme.SizeNameText)
+	pad := th.Size(theme.SizeNameInnerPadding)
+	iconSize := th.Size(theme.SizeNameInlineIcon)
+	size := r.gutter.Size()
+
+	e.propertyLock.RLock()
+	provider := e.textProvider()
+	rows := provider.rows()
+	rowHeight := provider.charMinSize(e.Password, e.TextStyle, textSize).Height
+	text := []rune(e.Text)
+	currentLine := e.lineForRow(e.CursorRow, text)
+	e.propertyLock.RUnlock()
+	offset := e.scroll.Offset
+
+	objects := []fyne.CanvasObject{}
+	line := 0
+	for row := 0; row < rows; row++ {
+		e.propertyLock.RLock()
+		pos := e.textPosFromRowCol(row, 0)
+		e.propertyLock.RUnlock()
+		if pos != 0 && (pos > len(text) || text[pos-1] != '\n') {
+			continue // a wrapped continuation of the previous line
+		}
+		line++
+
+		y := th.Size(theme.SizeNameLineSpacing) + float32(row)*rowHeight - offset.Y
+		if y+rowHeight < 0 || y > size.Height {
+			continue
+		}
+
+		current := line-1 == currentLine
+		if current {
+			highlight := canvas.NewRectangle(th.Color(theme.ColorNameHover, v))
+			highlight.Resize(fyne.NewSize(size.Width, rowHeight))
+			highlight.Move(fyne.NewPos(0, y))
+			objects = append(objects, highlight)
+		}
+
+		number := canvas.NewText(strconv.Itoa(line), th.Color(theme.ColorNamePlaceHolder, v))
+		if current {
+			number.Color = th.Color(theme.ColorNameForeground, v)
+		}
+		number.TextSize = textSize
+		number.TextStyle = fyne.TextStyle{Monospace: true}
+		number.Alignment = fyne.TextAlignTrailing
+		number.Resize(fyne.NewSize(size.Width-pad, rowHeight))
+		number.Move(fyne.NewPos(0, y))
+		objects = append(objects, number)
+
+		if marker := e.lineMarkers[line-1]; marker != nil {
+			icon := canvas.NewImageFromResource(marker)
+			icon.FillMode = canvas.ImageFillContain
+			icon.Resize(fyne.NewSquareSize(iconSize))
+			icon.Move(fyne.NewPos(0, y+(rowHeight-iconSize)/2))
+			objects = append(objects, icon)
+		}
+	}
+
+	r.objects = objects
+	canvas.Refresh(r.gutter)
+}
+
+// lineForRow returns the logical line, counted from 0, that holds the passed display row.
+func (e *Entry) lineForRow(row int, text []rune) int {
+	pos := e.textPosFromRowCol(row, 0)
+	if pos > len(text) {
+		pos = len(text)
+	}
+	line := 0
+	for _, r := range text[:pos] {
+		if r == '\n' {
+			line++
+		}
+	}
+	return line
+}
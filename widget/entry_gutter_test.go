@@ -0,0 +1,79 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func gutterNumbers(t *testing.T, e *Entry) (numbers []string) {
+	renderer := test.TempWidgetRenderer(t, e.gutter).(*entryGutterRenderer)
+	for _, o := range renderer.Objects() {
+		if text, ok := o.(*canvas.Text); ok {
+			numbers = append(numbers, text.Text)
+		}
+	}
+	return numbers
+}
+
+func TestEntry_ShowLineNumbers(t *testing.T) {
+	plain := NewMultiLineEntry()
+	entry := NewMultiLineEntry()
+	entry.ShowLineNumbers = true
+
+	w := test.NewTempWindow(t, entry)
+	w.Resize(fyne.NewSize(150, 100))
+	assert.Greater(t, entry.MinSize().Width, plain.MinSize().Width,
+		"space is reserved for the gutter")
+	assert.NotNil(t, entry.gutter)
+
+	entry.SetText("one\ntwo\nthree")
+	assert.Equal(t, []string{"1", "2", "3"}, gutterNumbers(t, entry))
+}
+
+func TestEntry_ShowLineNumbers_Wrapping(t *testing.T) {
+	entry := NewMultiLineEntry()
+	entry.ShowLineNumbers = true
+	entry.Wrapping = fyne.TextWrapWord
+
+	w := test.NewTempWindow(t, entry)
+	w.Resize(fyne.NewSize(120, 100))
+
+	entry.SetText("a wrapping line of text\nshort")
+	if assert.Greater(t, entry.textProvider().rows(), 2, "first line wraps over several rows") {
+		assert.Equal(t, []string{"1", "2"}, gutterNumbers(t, entry),
+			"wrapped continuations are not numbered")
+	}
+}
+
+func TestEntry_SetLineMarker(t *testing.T) {
+	entry := NewMultiLineEntry()
+	entry.ShowLineNumbers = true
+	entry.SetText("one\ntwo")
+
+	w := test.NewTempWindow(t, entry)
+	w.Resize(fyne.NewSize(150, 100))
+	width := entry.MinSize().Width
+
+	entry.SetLineMarker(1, theme.MediaRecordIcon())
+	entry.Refresh()
+	assert.Equal(t, theme.MediaRecordIcon(), entry.LineMarker(1))
+	assert.Greater(t, entry.MinSize().Width, width, "markers widen the gutter")
+
+	renderer := test.TempWidgetRenderer(t, entry.gutter).(*entryGutterRenderer)
+	icons := 0
+	for _, o := range renderer.Objects() {
+		if _, ok := o.(*canvas.Image); ok {
+			icons++
+		}
+	}
+	assert.Equal(t, 1, icons)
+
+	entry.SetLineMarker(1, nil)
+	assert.Nil(t, entry.LineMarker(1))
+}
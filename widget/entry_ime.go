@@ -0,0 +1,121 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+var _ fyne.TextComposable = (*Entry)(nil)
+
+// SetComposingText replaces the input method (IME) pre-edit text shown at the caret,
+// drawn underlined until the composition is committed or cancelled with an empty
+// string. Composing text is not reported through OnChanged and cannot be undone.
+//
+// Implements: fyne.TextComposable
+//
+// Since: 2.6
+func (e *Entry) SetComposingText(text string) {
+	e.propertyLock.Lock()
+	provider := e.textProvider()
+	if e.composing {
+		provider.deleteFromTo(e.compositionStart, e.compositionStart+len(e.composition))
+	} else if text != "" {
+		e.compositionStart = e.cursorTextPos()
+	}
+
+	e.composition = []rune(text)
+	e.composing = text != ""
+	if e.composing {
+		provider.insertAt(e.compositionStart, e.composition)
+	}
+
+	e.Text = provider.String()
+	provider.updateRowBounds()
+	e.CursorRow, e.CursorColumn = e.rowColFromTextPos(e.compositionStart + len(e.composition))
+	e.selecting = false
+	e.propertyLock.Unlock()
+
+	e.Refresh()
+}
+
+// CaretArea returns the position and size of the text caret, relative to the entry,
+// used by drivers to place the IME candidate window.
+//
+// Implements: fyne.TextComposable
+//
+// Since: 2.6
+func (e *Entry) CaretArea() (fyne.Position, fyne.Size) {
+	th := e.Theme()
+	textSize := th.Size(theme.SizeNameText)
+	innerPad := th.Size(theme.SizeNameInnerPadding)
+	inputBorder := th.Size(theme.SizeNameInputBorder)
+
+	e.propertyLock.RLock()
+	provider := e.textProvider()
+	size := provider.lineSizeToColumn(e.CursorColumn, e.CursorRow, textSize, innerPad)
+	lineHeight := provider.charMinSize(e.Password, e.TextStyle, textSize).Height
+	row := e.CursorRow
+	e.propertyLock.RUnlock()
+
+	pos := fyne.NewPos(size.Width-inputBorder/2, size.Height*float32(row)+innerPad-inputBorder)
+	if e.scroll.Content != nil {
+		pos = pos.Add(e.scroll.Position()).Subtract(e.scroll.Offset)
+	} else {
+		pos = pos.Add(e.content.Position())
+	}
+	return pos, fyne.NewSize(inputBorder, lineHeight)
+}
+
+// buildComposition lays out the underline rectangles drawn below the pre-edit text,
+// one per row it spans, mirroring how buildSelection covers the selected range.
+func (r *entryContentRenderer) buildComposition() {
+	e := r.content.entry
+
+	e.propertyLock.RLock()
+	composing := e.composing
+	startRow, startCol := e.rowColFromTextPos(e.compositionStart)
+	endRow, endCol := e.rowColFromTextPos(e.compositionStart + len(e.composition))
+	e.propertyLock.RUnlock()
+
+	if !composing {
+		r.composition = r.composition[:0]
+		return
+	}
+
+	th := e.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	textSize := th.Size(theme.SizeNameText)
+	innerPad := th.Size(theme.SizeNameInnerPadding)
+	inputBorder := th.Size(theme.SizeNameInputBorder)
+	provider := e.textProvider()
+	lineHeight := provider.charMinSize(e.Password, e.TextStyle, textSize).Height
+
+	rowCount := endRow - startRow + 1
+	if len(r.composition) > rowCount {
+		r.composition = r.composition[:rowCount]
+	}
+	for i := 0; i < rowCount; i++ {
+		if len(r.composition) <= i {
+			r.composition = append(r.composition, canvas.NewRectangle(th.Color(theme.ColorNameForeground, v)))
+		}
+
+		row := startRow + i
+		fromCol, toCol := startCol, endCol
+		if row > startRow {
+			fromCol = 0
+		}
+		if row < endRow {
+			toCol = provider.rowLength(row)
+		}
+
+		x1 := provider.lineSizeToColumn(fromCol, row, textSize, innerPad).Width
+		x2 := provider.lineSizeToColumn(toCol, row, textSize, innerPad).Width
+		y := lineHeight*float32(row) - inputBorder + innerPad
+
+		line := r.composition[i].(*canvas.Rectangle)
+		line.FillColor = th.Color(theme.ColorNameForeground, v)
+		line.Resize(fyne.NewSize(x2-x1, inputBorder))
+		line.Move(fyne.NewPos(x1, y+lineHeight-inputBorder))
+	}
+}
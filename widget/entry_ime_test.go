@@ -0,0 +1,62 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntry_SetComposingText(t *testing.T) {
+	entry := NewEntry()
+	entry.SetText("ab")
+	changed := 0
+	entry.OnChanged = func(string) { changed++ }
+
+	w := test.NewTempWindow(t, entry)
+	w.Canvas().Focus(entry)
+	entry.CursorColumn = 1
+
+	entry.SetComposingText("に")
+	assert.Equal(t, "aにb", entry.Text)
+	assert.Equal(t, 2, entry.CursorColumn)
+
+	entry.SetComposingText("にほ")
+	assert.Equal(t, "aにほb", entry.Text)
+	assert.Equal(t, 3, entry.CursorColumn)
+	assert.Zero(t, changed, "pre-edit text is not reported as a change")
+
+	renderer := test.TempWidgetRenderer(t, entry.content).(*entryContentRenderer)
+	assert.Len(t, renderer.composition, 1, "the pre-edit range is underlined")
+
+	// the user commits, so the driver ends the composition and sends the text
+	entry.SetComposingText("")
+	assert.Equal(t, "ab", entry.Text)
+	assert.Equal(t, 1, entry.CursorColumn)
+	test.Type(entry, "日本")
+	assert.Equal(t, "a日本b", entry.Text)
+
+	entry.SetComposingText("x")
+	entry.SetComposingText("")
+	assert.Empty(t, renderer.composition)
+}
+
+func TestEntry_CaretArea(t *testing.T) {
+	entry := NewMultiLineEntry()
+	entry.SetText("hello\nworld")
+
+	w := test.NewTempWindow(t, entry)
+	w.Resize(fyne.NewSize(150, 100))
+	w.Canvas().Focus(entry)
+
+	pos1, size := entry.CaretArea()
+	assert.Positive(t, size.Height)
+
+	entry.CursorRow, entry.CursorColumn = 1, 3
+	entry.Refresh()
+	pos2, _ := entry.CaretArea()
+	assert.Greater(t, pos2.Y, pos1.Y, "the caret area follows the cursor")
+	assert.Greater(t, pos2.X, pos1.X)
+}
@@ -469,6 +469,18 @@ func TestEntry_ShiftSelection_ResetOnFocusLost(t *testing.T) {
 	assert.False(t, e.selectKeyDown)
 }
 
+func TestEntryUndoStack_Limit(t *testing.T) {
+	u := &entryUndoStack{}
+	for i := 0; i < entryUndoLimit+10; i++ {
+		u.Add(&entryModifyAction{Position: i, Text: []rune{' '}})
+	}
+
+	assert.Len(t, u.items, entryUndoLimit)
+	assert.Equal(t, entryUndoLimit, u.index)
+	// the oldest items were dropped
+	assert.Equal(t, 10, u.items[0].(*entryModifyAction).Position)
+}
+
 func getClickPosition(str string, row int) *fyne.PointEvent {
 	x := fyne.MeasureText(str, theme.TextSize(), fyne.TextStyle{}).Width + theme.Padding()
 
@@ -0,0 +1,119 @@
+package widget
+
+import "unicode"
+
+// Prebuilt input masks for SetInputMask covering common formats.
+//
+// Since: 2.6
+const (
+	InputMaskDate  = "9999-99-99"
+	InputMaskTime  = "99:99"
+	InputMaskIPv4  = "999.999.999.999"
+	InputMaskPhone = "(999) 999-9999"
+)
+
+// SetInputMask constrains what can be typed into this entry to the passed format.
+// In the mask '9' accepts a digit, 'a' a letter, 'A' a letter converted to upper
+// case and '*' a letter or digit; any other character is a literal that is inserted
+// automatically as the user types past it. Runes that do not match the mask, or
+// that would extend the text beyond it, are rejected; pasted or dropped text keeps
+// only the prefix the mask accepts. Unlike Validator this checks each edit rather
+// than the completed text. Pass an empty mask to remove the constraint.
+//
+// Since: 2.6
+func (e *Entry) SetInputMask(mask string) {
+	if mask == "" {
+		e.inputMask = nil
+		return
+	}
+	e.inputMask = []rune(mask)
+}
+
+// applyInputMask maps a typed rune to the runes to insert at the cursor, skipping
+// over mask literals, or reports that the rune cannot be accepted.
+func (e *Entry) applyInputMask(pos int, r rune, textLen int) (int, []rune, bool) {
+	mask := e.inputMask
+
+	// step over literals already present at the cursor
+	for pos < len(mask) && pos < textLen && maskIsLiteral(mask[pos]) {
+		pos++
+	}
+
+	// collect the literals the mask requires before the next input position
+	var insert []rune
+	for pos+len(insert) < len(mask) && maskIsLiteral(mask[pos+len(insert)]) {
+		insert = append(insert, mask[pos+len(insert)])
+	}
+
+	inputPos := pos + len(insert)
+	if inputPos >= len(mask) {
+		return pos, nil, false // the text is already as long as the mask allows
+	}
+	out, ok := maskAccepts(mask[inputPos], r)
+	if !ok {
+		return pos, nil, false
+	}
+	insert = append(insert, out)
+	if textLen+len(insert) > len(mask) {
+		return pos, nil, false
+	}
+	return pos, insert, true
+}
+
+// maskInsert filters text inserted in bulk, such as a paste or drop, through the
+// input mask at the given position. The longest prefix the mask accepts is kept,
+// including pasted runes that spell out the mask literals, and the remainder is
+// dropped. It reports false when none of the text fits.
+func (e *Entry) maskInsert(pos int, text []rune, textLen int) (int, []rune, bool) {
+	mask := e.inputMask
+
+	var out []rune
+	for i, r := range text {
+		at := pos + len(out)
+		p, insert, ok := e.applyInputMask(at, r, textLen+len(out))
+		if !ok {
+			if p < len(mask) && maskIsLiteral(mask[p]) && r == mask[p] && textLen+len(out) < len(mask) {
+				out = append(out, r) // the pasted text includes the literal itself
+				continue
+			}
+			break
+		}
+		if i == 0 {
+			pos = p
+		} else if p != at {
+			break // the mask skipped ahead, stop at the matched prefix
+		}
+		out = append(out, insert...)
+	}
+
+	if len(out) == 0 {
+		return pos, nil, false
+	}
+	return pos, out, true
+}
+
+// maskAccepts reports whether the rune matches the mask character, returning the
+// rune to insert in its place.
+func maskAccepts(m, r rune) (rune, bool) {
+	switch m {
+	case '9':
+		return r, unicode.IsDigit(r)
+	case 'a':
+		return r, unicode.IsLetter(r)
+	case 'A':
+		return unicode.ToUpper(r), unicode.IsLetter(r)
+	case '*':
+		return r, unicode.IsLetter(r) || unicode.IsDigit(r)
+	}
+	return m, false
+}
+
+// maskIsLiteral reports whether the mask character is inserted verbatim rather than
+// matched against typed input.
+func maskIsLiteral(m rune) bool {
+	switch m {
+	case '9', 'a', 'A', '*':
+		return false
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntry_SetInputMask(t *testing.T) {
+	entry := NewEntry()
+	entry.SetInputMask(InputMaskPhone)
+
+	test.Type(entry, "5551234567")
+	assert.Equal(t, "(555) 123-4567", entry.Text)
+
+	test.Type(entry, "8") // mask is full
+	assert.Equal(t, "(555) 123-4567", entry.Text)
+
+	entry.SetInputMask("")
+	test.Type(entry, "x")
+	assert.Equal(t, "(555) 123-4567x", entry.Text)
+}
+
+func TestEntry_SetInputMask_Reject(t *testing.T) {
+	entry := NewEntry()
+	entry.SetInputMask(InputMaskDate)
+
+	test.Type(entry, "2a0b26")
+	assert.Equal(t, "2026", entry.Text)
+
+	test.Type(entry, "0830")
+	assert.Equal(t, "2026-08-30", entry.Text)
+	assert.Equal(t, 10, entry.CursorColumn)
+}
+
+func TestEntry_SetInputMask_Paste(t *testing.T) {
+	entry := NewEntry()
+	entry.SetInputMask(InputMaskDate)
+
+	cb := &fakeClipboard{content: "2026-08-30"}
+	entry.pasteFromClipboard(cb) // literals in the pasted text are accepted
+	assert.Equal(t, "2026-08-30", entry.Text)
+
+	entry.SetText("")
+	cb.content = "20260830"
+	entry.pasteFromClipboard(cb) // bare digits pick up the literals from the mask
+	assert.Equal(t, "2026-08-30", entry.Text)
+
+	entry.SetText("")
+	cb.content = "2026-08-30 leftover"
+	entry.pasteFromClipboard(cb) // only the matching prefix is kept
+	assert.Equal(t, "2026-08-30", entry.Text)
+
+	entry.SetText("")
+	cb.content = "not a date"
+	entry.pasteFromClipboard(cb)
+	assert.Equal(t, "", entry.Text)
+}
+
+func TestEntry_SetInputMask_Replace(t *testing.T) {
+	entry := NewEntry()
+	entry.SetInputMask(InputMaskTime)
+
+	entry.replaceRange(0, 0, "1234")
+	assert.Equal(t, "12:34", entry.Text)
+
+	entry.replaceRange(0, 5, "oops")
+	assert.Equal(t, "", entry.Text)
+}
+
+func TestEntry_SetInputMask_Classes(t *testing.T) {
+	entry := NewEntry()
+	entry.SetInputMask("AA-99-**")
+
+	test.Type(entry, "ab12c3")
+	assert.Equal(t, "AB-12-c3", entry.Text)
+}
@@ -0,0 +1,76 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// floatInset returns the vertical space reserved above the text for the floating
+// placeholder label, zero when the mode is off.
+func (r *entryRenderer) floatInset() float32 {
+	if !r.entry.FloatingPlaceHolder {
+		return 0
+	}
+	th := r.entry.Theme()
+	return fyne.MeasureText("M", th.Size(theme.SizeNameCaptionText), fyne.TextStyle{}).Height
+}
+
+// ensureFloatSetup lazily creates the floating placeholder label.
+func (r *entryRenderer) ensureFloatSetup() {
+	if r.entry.floatLabel != nil {
+		return
+	}
+	th := r.entry.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	label := canvas.NewText(r.entry.PlaceHolder, th.Color(theme.ColorNamePlaceHolder, v))
+	label.TextSize = th.Size(theme.SizeNameCaptionText)
+	label.Hide()
+	r.entry.floatLabel = label
+	r.objects = append(r.objects, label)
+}
+
+// refreshFloatLabel shows, hides and restyles the floating placeholder to match the
+// entry state, animating it up from the text when it first appears.
+func (r *entryRenderer) refreshFloatLabel(th fyne.Theme, v fyne.ThemeVariant, focused bool) {
+	if !r.entry.FloatingPlaceHolder {
+		if r.entry.floatLabel != nil {
+			r.entry.floatLabel.Hide()
+		}
+		return
+	}
+	r.ensureFloatSetup()
+
+	label := r.entry.floatLabel
+	label.Text = r.entry.PlaceHolder
+	label.TextSize = th.Size(theme.SizeNameCaptionText)
+	if focused {
+		label.Color = th.Color(theme.ColorNamePrimary, v)
+	} else {
+		label.Color = th.Color(theme.ColorNamePlaceHolder, v)
+	}
+
+	active := focused || r.entry.textProvider().len() > 0
+	target := fyne.NewPos(th.Size(theme.SizeNameInnerPadding), th.Size(theme.SizeNameInputBorder))
+	if active && !label.Visible() {
+		label.Show()
+		if fyne.CurrentApp().Settings().ShowAnimations() {
+			from := target.AddXY(0, r.floatInset())
+			canvas.NewPositionAnimation(from, target, canvas.DurationShort, label.Move).Start()
+		} else {
+			label.Move(target)
+		}
+	} else if !active && label.Visible() {
+		label.Hide()
+	} else {
+		label.Move(target)
+	}
+	label.Refresh()
+}
+
+// floatPlaceholderActive returns whether the floating label currently replaces the
+// in-field placeholder.
+func (e *Entry) floatPlaceholderActive(focused bool) bool {
+	return e.FloatingPlaceHolder && focused
+}
@@ -0,0 +1,63 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntry_PlaceHolderSegments(t *testing.T) {
+	entry := NewEntry()
+	style := RichTextStyleInline
+	style.TextStyle.Italic = true
+	entry.PlaceHolderSegments = []RichTextSegment{
+		&TextSegment{Style: style, Text: "Search…"},
+	}
+
+	w := test.NewTempWindow(t, entry)
+	w.Resize(fyne.NewSize(150, 50))
+
+	segments := entry.placeholderProvider().Segments
+	assert.Len(t, segments, 1)
+	assert.True(t, segments[0].(*TextSegment).Style.TextStyle.Italic)
+
+	entry.SetPlaceHolder("plain")
+	assert.Nil(t, entry.PlaceHolderSegments)
+	assert.Equal(t, "plain", entry.placeholderProvider().Segments[0].(*TextSegment).Text)
+}
+
+func TestEntry_FloatingPlaceHolder(t *testing.T) {
+	plain := NewEntry()
+	plain.PlaceHolder = "Name"
+
+	entry := NewEntry()
+	entry.PlaceHolder = "Name"
+	entry.FloatingPlaceHolder = true
+
+	w := test.NewTempWindow(t, entry)
+	w.Resize(fyne.NewSize(150, 80))
+	assert.Greater(t, entry.MinSize().Height, plain.MinSize().Height,
+		"space is reserved for the floating label")
+
+	assert.True(t, entry.placeholderProvider().Visible())
+
+	w.Canvas().Focus(entry)
+	assert.NotNil(t, entry.floatLabel)
+	assert.True(t, entry.floatLabel.Visible())
+	assert.False(t, entry.placeholderProvider().Visible())
+
+	test.Type(entry, "abc")
+	w.Canvas().Unfocus()
+	entry.Refresh()
+	assert.True(t, entry.floatLabel.Visible(), "label stays while the entry is filled")
+
+	entry.SetText("")
+	w.Canvas().Focus(entry)
+	w.Canvas().Unfocus()
+	entry.Refresh()
+	assert.False(t, entry.floatLabel.Visible())
+	assert.True(t, entry.placeholderProvider().Visible())
+}
@@ -0,0 +1,225 @@
+package widget
+
+import (
+	"regexp"
+	"unicode/utf8"
+)
+
+// entrySearch holds the active find query of an Entry, recompiled by Search and
+// re-run against the current text whenever a match is needed.
+type entrySearch struct {
+	pattern *regexp.Regexp
+}
+
+// Search sets the text to find in this entry and selects the first match at or after
+// the cursor. It returns how many matches the current text contains. The query is
+// matched ignoring case unless caseSensitive is set, and is treated as a regular
+// expression when useRegex is set, returning any compile error. An empty query
+// clears the search.
+//
+// Since: 2.6
+func (e *Entry) Search(query string, caseSensitive, useRegex bool) (int, error) {
+	if query == "" {
+		e.ClearSearch()
+		return 0, nil
+	}
+
+	if !useRegex {
+		query = regexp.QuoteMeta(query)
+	}
+	if !caseSensitive {
+		query = "(?i)" + query
+	}
+	pattern, err := regexp.Compile(query)
+	if err != nil {
+		return 0, err
+	}
+
+	e.search = &entrySearch{pattern: pattern}
+	matches := e.searchMatches()
+	if len(matches) > 0 {
+		e.selectSearchMatch(e.nextSearchMatch(matches, e.searchCursorOffset(), false))
+	}
+	return len(matches), nil
+}
+
+// ClearSearch removes any active search from this entry, leaving the text and
+// selection as they are.
+//
+// Since: 2.6
+func (e *Entry) ClearSearch() {
+	e.search = nil
+}
+
+// SearchNext selects the next match of the active search after the cursor, wrapping
+// around to the first match at the end of the text. It returns false when there is
+// no active search or the text has no matches.
+//
+// Since: 2.6
+func (e *Entry) SearchNext() bool {
+	return e.searchStep(false)
+}
+
+// SearchPrevious selects the closest match of the active search before the cursor,
+// wrapping around to the last match at the start of the text. It returns false when
+// there is no active search or the text has no matches.
+//
+// Since: 2.6
+func (e *Entry) SearchPrevious() bool {
+	return e.searchStep(true)
+}
+
+// ReplaceOne replaces the currently selected match of the active search with the
+// passed text and selects the next match. It returns false when there is no match
+// to replace.
+//
+// Since: 2.6
+func (e *Entry) ReplaceOne(replacement string) bool {
+	if e.search == nil {
+		return false
+	}
+	matches := e.searchMatches()
+	if len(matches) == 0 {
+		return false
+	}
+
+	sel, cur := e.selection()
+	match := e.nextSearchMatch(matches, e.searchCursorOffset(), false)
+	if sel >= 0 {
+		for _, m := range matches {
+			if e.runeOffset(m[0]) == sel && e.runeOffset(m[1]) == cur {
+				match = m
+				break
+			}
+		}
+	}
+
+	e.replaceRange(e.runeOffset(match[0]), e.runeOffset(match[1]), replacement)
+	e.SearchNext()
+	return true
+}
+
+// ReplaceAll replaces every match of the active search with the passed text,
+// returning how many replacements were made.
+//
+// Since: 2.6
+func (e *Entry) ReplaceAll(replacement string) int {
+	if e.search == nil {
+		return 0
+	}
+	matches := e.searchMatches()
+	for i := len(matches) - 1; i >= 0; i-- { // last first, keeping earlier offsets valid
+		e.replaceRange(e.runeOffset(matches[i][0]), e.runeOffset(matches[i][1]), replacement)
+	}
+	return len(matches)
+}
+
+// searchMatches returns the byte offset ranges at which the active search matches
+// the current text.
+func (e *Entry) searchMatches() [][]int {
+	if e.search == nil {
+		return nil
+	}
+	return e.search.pattern.FindAllStringIndex(e.Text, -1)
+}
+
+// nextSearchMatch returns the closest match after (or before, when reversed) the
+// passed byte offset, wrapping around at the ends of the text.
+func (e *Entry) nextSearchMatch(matches [][]int, offset int, previous bool) []int {
+	if previous {
+		for i := len(matches) - 1; i >= 0; i-- {
+			if matches[i][1] <= offset {
+				return matches[i]
+			}
+		}
+		return matches[len(matches)-1]
+	}
+
+	for _, m := range matches {
+		if m[0] >= offset {
+			return m
+		}
+	}
+	return matches[0]
+}
+
+func (e *Entry) searchStep(previous bool) bool {
+	if e.search == nil {
+		return false
+	}
+	matches := e.searchMatches()
+	if len(matches) == 0 {
+		return false
+	}
+
+	offset := e.searchCursorOffset()
+	if sel, _ := e.selection(); sel >= 0 && !previous {
+		offset++ // step past the selected match rather than finding it again
+	}
+	e.selectSearchMatch(e.nextSearchMatch(matches, offset, previous))
+	return true
+}
+
+// searchCursorOffset returns the byte offset of the selection start, or the cursor
+// when nothing is selected.
+func (e *Entry) searchCursorOffset() int {
+	pos, _ := e.selection()
+	if pos < 0 {
+		pos = e.cursorTextPos()
+	}
+	return len(string([]rune(e.Text)[:pos]))
+}
+
+// selectSearchMatch highlights the passed byte offset range and moves the cursor to
+// its end.
+func (e *Entry) selectSearchMatch(match []int) {
+	start, end := e.runeOffset(match[0]), e.runeOffset(match[1])
+	e.setFieldsAndRefresh(func() {
+		e.selectRow, e.selectColumn = e.rowColFromTextPos(start)
+		e.CursorRow, e.CursorColumn = e.rowColFromTextPos(end)
+		e.selecting = true
+	})
+}
+
+// replaceRange swaps the runes between the passed offsets for the replacement text,
+// recording the change on the undo stack.
+func (e *Entry) replaceRange(start, end int, replacement string) {
+	e.propertyLock.Lock()
+	provider := e.textProvider()
+	if deleted := provider.deleteFromTo(start, end); len(deleted) > 0 {
+		e.undoStack.Add(&entryModifyAction{Delete: true, Position: start, Text: deleted})
+	}
+
+	runes := []rune(replacement)
+	if e.inputMask != nil && len(runes) > 0 {
+		var ok bool
+		start, runes, ok = e.maskInsert(start, runes, provider.len())
+		if !ok {
+			runes = nil // the deletion stands but nothing fits the mask
+		}
+	}
+	if len(runes) > 0 {
+		provider.insertAt(start, runes)
+		e.undoStack.Add(&entryModifyAction{Position: start, Text: runes})
+	}
+
+	content := provider.String()
+	changed := e.updateText(content, false)
+	e.CursorRow, e.CursorColumn = e.rowColFromTextPos(start + len(runes))
+	e.selecting = false
+	cb := e.OnChanged
+	e.propertyLock.Unlock()
+
+	if changed {
+		e.validate()
+		if cb != nil {
+			cb(content)
+		}
+	}
+	e.Refresh()
+}
+
+// runeOffset converts a byte offset within the current text to a rune offset.
+func (e *Entry) runeOffset(b int) int {
+	return utf8.RuneCountInString(e.Text[:b])
+}
@@ -0,0 +1,115 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntry_Search(t *testing.T) {
+	entry := NewMultiLineEntry()
+	entry.SetText("Hello world\nhello World")
+
+	count, err := entry.Search("hello", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, "Hello", entry.SelectedText())
+
+	assert.True(t, entry.SearchNext())
+	assert.Equal(t, "hello", entry.SelectedText())
+	assert.Equal(t, 1, entry.CursorRow)
+
+	assert.True(t, entry.SearchNext()) // wraps around
+	assert.Equal(t, "Hello", entry.SelectedText())
+	assert.Equal(t, 0, entry.CursorRow)
+
+	assert.True(t, entry.SearchPrevious())
+	assert.Equal(t, 1, entry.CursorRow)
+
+	count, err = entry.Search("hello", true, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	entry.ClearSearch()
+	assert.False(t, entry.SearchNext())
+}
+
+func TestEntry_Search_Regex(t *testing.T) {
+	entry := NewMultiLineEntry()
+	entry.SetText("cat cot cut")
+
+	count, err := entry.Search("c.t", false, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	_, err = entry.Search("c(", false, true)
+	assert.Error(t, err)
+
+	count, err = entry.Search("c.t", false, false) // literal, not regex
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestEntry_Replace(t *testing.T) {
+	entry := NewMultiLineEntry()
+	entry.SetText("one two one two one")
+
+	_, err := entry.Search("one", false, false)
+	assert.NoError(t, err)
+
+	assert.True(t, entry.ReplaceOne("1"))
+	assert.Equal(t, "1 two one two one", entry.Text)
+	assert.Equal(t, "one", entry.SelectedText())
+
+	assert.Equal(t, 2, entry.ReplaceAll("1"))
+	assert.Equal(t, "1 two 1 two 1", entry.Text)
+
+	// replacements can be undone; ReplaceAll works backwards through the text so
+	// the most recent change is the earliest remaining match
+	entry.TypedShortcut(&fyne.ShortcutUndo{})
+	entry.TypedShortcut(&fyne.ShortcutUndo{})
+	assert.Equal(t, "1 two one two 1", entry.Text)
+}
+
+func TestFindReplaceBar(t *testing.T) {
+	entry := NewMultiLineEntry()
+	entry.SetText("alpha beta alpha")
+
+	bar := NewFindReplaceBar(entry)
+	w := test.NewTempWindow(t, bar)
+	w.Resize(fyne.NewSize(400, 100))
+
+	test.Type(bar.find, "alpha")
+	assert.Equal(t, "2", bar.matchLabel.Text)
+	assert.Equal(t, "alpha", entry.SelectedText())
+
+	test.Type(bar.replace, "omega")
+	test.Tap(findBarButton(t, bar, "Replace all"))
+	assert.Equal(t, "omega beta omega", entry.Text)
+	assert.Equal(t, "0", bar.matchLabel.Text)
+}
+
+// findBarButton digs the named button out of the bar's renderer for tests.
+func findBarButton(t *testing.T, bar *FindReplaceBar, label string) *Button {
+	var found *Button
+	var walk func(obj fyne.CanvasObject)
+	walk = func(obj fyne.CanvasObject) {
+		switch o := obj.(type) {
+		case *fyne.Container:
+			for _, child := range o.Objects {
+				walk(child)
+			}
+		case *Button:
+			if o.Text == label {
+				found = o
+			}
+		}
+	}
+	for _, obj := range test.TempWidgetRenderer(t, bar).Objects() {
+		walk(obj)
+	}
+	return found
+}
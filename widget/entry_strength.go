@@ -0,0 +1,175 @@
+package widget
+
+import (
+	"errors"
+	"image/color"
+	"unicode"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// PasswordPolicy describes the rules a password entry scores strength against.
+// Plug the Validate method into the Validator field of an Entry to also enforce them.
+//
+// Since: 2.6
+type PasswordPolicy struct {
+	// MinLength is the smallest acceptable password length, 8 when zero.
+	MinLength int
+
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+}
+
+// Validate returns an error describing the first rule the passed password breaks,
+// or nil when it satisfies this policy. It can be used as an Entry Validator.
+//
+// Since: 2.6
+func (p *PasswordPolicy) Validate(password string) error {
+	upper, lower, digit, symbol := passwordClasses(password)
+	if len([]rune(password)) < p.minLength() {
+		return errors.New("password is too short")
+	}
+	if p.RequireUpper && !upper {
+		return errors.New("password needs an upper case letter")
+	}
+	if p.RequireLower && !lower {
+		return errors.New("password needs a lower case letter")
+	}
+	if p.RequireDigit && !digit {
+		return errors.New("password needs a digit")
+	}
+	if p.RequireSymbol && !symbol {
+		return errors.New("password needs a symbol")
+	}
+	return nil
+}
+
+// Strength scores the passed password between 0 and 1, weighing its length against
+// MinLength and the variety of character classes it draws from.
+//
+// Since: 2.6
+func (p *PasswordPolicy) Strength(password string) float64 {
+	length := len([]rune(password))
+	if length == 0 {
+		return 0
+	}
+
+	lengthScore := float64(length) / float64(p.minLength()*2)
+	if lengthScore > 1 {
+		lengthScore = 1
+	}
+
+	classes := 0
+	upper, lower, digit, symbol := passwordClasses(password)
+	for _, present := range []bool{upper, lower, digit, symbol} {
+		if present {
+			classes++
+		}
+	}
+
+	return lengthScore*0.5 + float64(classes)*0.125
+}
+
+func (p *PasswordPolicy) minLength() int {
+	if p.MinLength <= 0 {
+		return 8
+	}
+	return p.MinLength
+}
+
+func passwordClasses(password string) (upper, lower, digit, symbol bool) {
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+	return upper, lower, digit, symbol
+}
+
+// passwordStrength scores the current text against PasswordPolicy, or a default
+// policy when none is set.
+func (e *Entry) passwordStrength() float64 {
+	policy := e.PasswordPolicy
+	if policy == nil {
+		policy = &PasswordPolicy{}
+	}
+	return policy.Strength(e.Text)
+}
+
+// reportStrength calls OnStrengthChanged when the strength of a password entry has
+// changed since it was last reported.
+func (e *Entry) reportStrength() {
+	if !e.Password || e.OnStrengthChanged == nil {
+		return
+	}
+	strength := e.passwordStrength()
+	if strength == e.lastStrength {
+		return
+	}
+	e.lastStrength = strength
+	e.OnStrengthChanged(strength)
+}
+
+// refreshStrengthBar fills and colours the thin strength bar along the bottom edge
+// of a password entry, creating it the first time it is needed.
+func (r *entryRenderer) refreshStrengthBar(th fyne.Theme, v fyne.ThemeVariant) {
+	if !r.entry.Password || !r.entry.ShowStrength {
+		if r.entry.strengthBar != nil {
+			r.entry.strengthBar.Hide()
+		}
+		return
+	}
+	if r.entry.strengthBar == nil {
+		r.entry.strengthBar = canvas.NewRectangle(color.Transparent)
+		r.objects = append(r.objects, r.entry.strengthBar)
+	}
+
+	strength := r.entry.passwordStrength()
+	name := theme.ColorNameError
+	if strength >= 0.75 {
+		name = theme.ColorNameSuccess
+	} else if strength >= 0.4 {
+		name = theme.ColorNameWarning
+	}
+
+	bar := r.entry.strengthBar
+	bar.FillColor = th.Color(name, v)
+	height := th.Size(theme.SizeNameInputBorder) * 2
+	size := r.entry.size.Load()
+	bar.Resize(fyne.NewSize(size.Width*float32(strength), height))
+	bar.Move(fyne.NewPos(0, size.Height-height))
+	bar.Show()
+	bar.Refresh()
+}
+
+// wipeBuffers zeroes the undo history and composition buffers so password text does
+// not linger in memory once the entry is destroyed.
+func (e *Entry) wipeBuffers() {
+	e.propertyLock.Lock()
+	defer e.propertyLock.Unlock()
+
+	for _, item := range e.undoStack.items {
+		if action, ok := item.(*entryModifyAction); ok {
+			for i := range action.Text {
+				action.Text[i] = 0
+			}
+		}
+	}
+	e.undoStack.Clear()
+
+	for i := range e.composition {
+		e.composition[i] = 0
+	}
+	e.composition = nil
+}
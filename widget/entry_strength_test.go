@@ -0,0 +1,69 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	policy := &PasswordPolicy{MinLength: 4, RequireUpper: true, RequireDigit: true}
+
+	assert.Error(t, policy.Validate("aB1"))
+	assert.Error(t, policy.Validate("abcd1"))
+	assert.Error(t, policy.Validate("abcD"))
+	assert.NoError(t, policy.Validate("abcD1"))
+}
+
+func TestPasswordPolicy_Strength(t *testing.T) {
+	policy := &PasswordPolicy{}
+
+	assert.Zero(t, policy.Strength(""))
+	weak := policy.Strength("abc")
+	strong := policy.Strength("correct Horse battery 5taple!")
+	assert.Greater(t, strong, weak)
+	assert.LessOrEqual(t, strong, 1.0)
+}
+
+func TestEntry_OnStrengthChanged(t *testing.T) {
+	entry := NewPasswordEntry()
+	strength := -1.0
+	entry.OnStrengthChanged = func(s float64) { strength = s }
+
+	test.Type(entry, "aB3$efgh")
+	assert.Greater(t, strength, 0.5)
+
+	entry.SetText("")
+	assert.Zero(t, strength)
+}
+
+func TestEntry_ShowStrength(t *testing.T) {
+	entry := NewPasswordEntry()
+	entry.ShowStrength = true
+
+	w := test.NewTempWindow(t, entry)
+	w.Canvas().Focus(entry)
+
+	test.Type(entry, "abc")
+	assert.NotNil(t, entry.strengthBar)
+	assert.True(t, entry.strengthBar.Visible())
+	weakWidth := entry.strengthBar.Size().Width
+
+	test.Type(entry, "D4! longer now")
+	assert.Greater(t, entry.strengthBar.Size().Width, weakWidth)
+}
+
+func TestEntry_WipeBuffers(t *testing.T) {
+	entry := NewPasswordEntry()
+	test.Type(entry, "secret")
+	assert.True(t, entry.CanUndo())
+
+	first := entry.undoStack.items[0].(*entryModifyAction)
+	entry.wipeBuffers()
+	assert.False(t, entry.CanUndo())
+	for _, r := range first.Text {
+		assert.Zero(t, r)
+	}
+}
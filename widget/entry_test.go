@@ -2029,6 +2029,27 @@ func TestEntry_UndoRedo_TypeRune(t *testing.T) {
 	assert.Equal(t, "abc éàè 123", entry.Text)
 }
 
+func TestEntry_CanUndoRedo(t *testing.T) {
+	entry := widget.NewEntry()
+	assert.False(t, entry.CanUndo())
+	assert.False(t, entry.CanRedo())
+
+	test.Type(entry, "abc")
+	assert.True(t, entry.CanUndo())
+	assert.False(t, entry.CanRedo())
+
+	entry.TypedShortcut(&fyne.ShortcutUndo{})
+	assert.Equal(t, "", entry.Text)
+	assert.False(t, entry.CanUndo())
+	assert.True(t, entry.CanRedo())
+
+	// redo is also available on shift+shortcut+Z
+	entry.TypedShortcut(&desktop.CustomShortcut{KeyName: fyne.KeyZ,
+		Modifier: fyne.KeyModifierShortcutDefault | fyne.KeyModifierShift})
+	assert.Equal(t, "abc", entry.Text)
+	assert.False(t, entry.CanRedo())
+}
+
 func TestEntry_UndoRedo_Delete(t *testing.T) {
 	entry := widget.NewEntry()
 
@@ -0,0 +1,107 @@
+package widget
+
+import (
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/lang"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+)
+
+// FindReplaceBar is a compact find and replace toolbar driving the search API of an
+// Entry, typically shown above a multiline editor. It offers next and previous
+// navigation, replace-one and replace-all, and case sensitive and regular
+// expression toggles. Editors that bind their own shortcuts can call the Search,
+// SearchNext, SearchPrevious, ReplaceOne and ReplaceAll methods of Entry directly.
+//
+// Since: 2.6
+type FindReplaceBar struct {
+	BaseWidget
+
+	entry *Entry
+
+	find, replace         *Entry
+	caseCheck, regexCheck *Check
+	matchLabel            *Label
+}
+
+// NewFindReplaceBar returns a find and replace bar operating on the passed entry.
+//
+// Since: 2.6
+func NewFindReplaceBar(entry *Entry) *FindReplaceBar {
+	f := &FindReplaceBar{entry: entry}
+	f.ExtendBaseWidget(f)
+	return f
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (f *FindReplaceBar) CreateRenderer() fyne.WidgetRenderer {
+	f.find = NewEntry()
+	f.find.PlaceHolder = lang.L("Find")
+	f.find.OnChanged = func(string) {
+		f.runSearch()
+	}
+	f.find.OnSubmitted = func(string) {
+		f.entry.SearchNext()
+	}
+	f.replace = NewEntry()
+	f.replace.PlaceHolder = lang.L("Replace")
+
+	f.caseCheck = NewCheck("Aa", func(bool) {
+		f.runSearch()
+	})
+	f.regexCheck = NewCheck(".*", func(bool) {
+		f.runSearch()
+	})
+	f.matchLabel = NewLabel("")
+
+	previous := NewButtonWithIcon("", theme.MoveUpIcon(), func() {
+		f.entry.SearchPrevious()
+	})
+	next := NewButtonWithIcon("", theme.MoveDownIcon(), func() {
+		f.entry.SearchNext()
+	})
+	replaceOne := NewButton(lang.L("Replace"), func() {
+		f.entry.ReplaceOne(f.replace.Text)
+		f.updateMatches(len(f.entry.searchMatches()))
+	})
+	replaceAll := NewButton(lang.L("Replace all"), func() {
+		f.entry.ReplaceAll(f.replace.Text)
+		f.updateMatches(len(f.entry.searchMatches()))
+	})
+
+	findTrailing := &fyne.Container{Layout: layout.NewHBoxLayout(),
+		Objects: []fyne.CanvasObject{previous, next, f.caseCheck, f.regexCheck, f.matchLabel}}
+	findRow := &fyne.Container{Layout: layout.NewBorderLayout(nil, nil, nil, findTrailing),
+		Objects: []fyne.CanvasObject{f.find, findTrailing}}
+
+	replaceTrailing := &fyne.Container{Layout: layout.NewHBoxLayout(),
+		Objects: []fyne.CanvasObject{replaceOne, replaceAll}}
+	replaceRow := &fyne.Container{Layout: layout.NewBorderLayout(nil, nil, nil, replaceTrailing),
+		Objects: []fyne.CanvasObject{f.replace, replaceTrailing}}
+
+	bar := &fyne.Container{Layout: layout.NewVBoxLayout(),
+		Objects: []fyne.CanvasObject{findRow, replaceRow}}
+	return NewSimpleRenderer(bar)
+}
+
+// runSearch re-runs the current query against the target entry and updates the
+// match indicator.
+func (f *FindReplaceBar) runSearch() {
+	count, err := f.entry.Search(f.find.Text, f.caseCheck.Checked, f.regexCheck.Checked)
+	if err != nil {
+		f.matchLabel.SetText("!")
+		return
+	}
+	f.updateMatches(count)
+}
+
+func (f *FindReplaceBar) updateMatches(count int) {
+	if f.find.Text == "" {
+		f.matchLabel.SetText("")
+		return
+	}
+	f.matchLabel.SetText(strconv.Itoa(count))
+}
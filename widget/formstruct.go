@@ -0,0 +1,150 @@
+package widget
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/data/validation"
+)
+
+// NewFormFromStruct generates a Form with one item per exported field of the
+// struct pointed to by data, each two-way bound so edits update the struct.
+// Bool fields become checks, strings entries and numbers numeric entries.
+//
+// The `form` struct tag customises an item, its first element being the label
+// (or "-" to skip the field) followed by comma separated options:
+//
+//   - "multiline" and "password" select the entry style for a string field
+//   - "slider" with "min=" and "max=" shows a float field as a slider
+//   - "order=" reorders items, lower values first, untagged fields are 0
+//   - "hint=" sets the hint text shown below the item
+//
+// A `validate` tag sets a regular expression validator on entry based items.
+// Fields renamed or skipped by a `binding` tag are honoured, see BindStruct.
+//
+// Since: 2.6
+func NewFormFromStruct(data any) *Form {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		fyne.LogError("Invalid type passed to NewFormFromStruct, must be pointer to struct", nil)
+		return NewForm()
+	}
+
+	bound := binding.BindStruct(data)
+	type ordered struct {
+		order int
+		item  *FormItem
+	}
+	var items []ordered
+
+	t := v.Elem().Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !v.Elem().Field(i).CanSet() {
+			continue
+		}
+		label, opts := parseFormTag(field)
+		if label == "-" {
+			continue
+		}
+
+		key := field.Name
+		if tag := field.Tag.Get("binding"); tag != "" {
+			key = tag
+		}
+		source, err := bound.GetItem(key)
+		if err != nil {
+			continue
+		}
+
+		input := newFormInput(field, source, opts)
+		if input == nil {
+			continue
+		}
+		if pattern := field.Tag.Get("validate"); pattern != "" {
+			if entry, ok := input.(*Entry); ok {
+				entry.Validator = validation.NewRegexp(pattern, label+" is not valid")
+			}
+		}
+
+		item := NewFormItem(label, input)
+		item.HintText = opts["hint"]
+		order, _ := strconv.Atoi(opts["order"])
+		items = append(items, ordered{order: order, item: item})
+	}
+
+	sort.SliceStable(items, func(a, b int) bool {
+		return items[a].order < items[b].order
+	})
+	form := NewForm()
+	for _, i := range items {
+		form.Items = append(form.Items, i.item)
+	}
+	return form
+}
+
+// parseFormTag splits a `form` struct tag into the item label and its options,
+// falling back to the field name when no label is tagged.
+func parseFormTag(f reflect.StructField) (label string, opts map[string]string) {
+	opts = map[string]string{}
+	parts := strings.Split(f.Tag.Get("form"), ",")
+	label = parts[0]
+	if label == "" {
+		label = f.Name
+	}
+
+	for _, opt := range parts[1:] {
+		if key, val, ok := strings.Cut(opt, "="); ok {
+			opts[key] = val
+		} else {
+			opts[opt] = ""
+		}
+	}
+	return label, opts
+}
+
+// newFormInput returns a widget bound to the passed data item, chosen from the
+// field type and any widget options in the `form` tag.
+func newFormInput(f reflect.StructField, source binding.DataItem, opts map[string]string) fyne.CanvasObject {
+	switch f.Type.Kind() {
+	case reflect.Bool:
+		if b, ok := source.(binding.Bool); ok {
+			return NewCheckWithData("", b)
+		}
+	case reflect.String:
+		if s, ok := source.(binding.String); ok {
+			entry := NewEntryWithData(s)
+			if _, ok := opts["multiline"]; ok {
+				entry.MultiLine = true
+				entry.Wrapping = fyne.TextWrapWord
+			}
+			if _, ok := opts["password"]; ok {
+				entry.Password = true
+			}
+			return entry
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if i, ok := source.(binding.Int); ok {
+			return NewEntryWithData(binding.IntToString(i))
+		}
+	case reflect.Float32, reflect.Float64:
+		fl, ok := source.(binding.Float)
+		if !ok {
+			return nil
+		}
+		if _, slider := opts["slider"]; slider {
+			min, _ := strconv.ParseFloat(opts["min"], 64)
+			max, err := strconv.ParseFloat(opts["max"], 64)
+			if err != nil {
+				max = 1
+			}
+			return NewSliderWithData(min, max, fl)
+		}
+		return NewEntryWithData(binding.FloatToString(fl))
+	}
+	return nil
+}
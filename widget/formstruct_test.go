@@ -0,0 +1,60 @@
+package widget_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2/widget"
+)
+
+type formPerson struct {
+	Name     string `form:"Full name" validate:"^[a-zA-Z ]+$"`
+	Bio      string `form:"Bio,multiline"`
+	Age      int
+	Rating   float64 `form:"Rating,slider,min=0,max=5"`
+	Admin    bool    `form:"Administrator,order=-1"`
+	Internal string  `form:"-"`
+	hidden   string
+}
+
+func TestNewFormFromStruct(t *testing.T) {
+	person := &formPerson{Name: "John Doe", Age: 40, Admin: true, hidden: "x"}
+	form := widget.NewFormFromStruct(person)
+	waitForBinding()
+
+	assert.Len(t, form.Items, 5) // unexported and "-" fields are skipped
+	assert.Equal(t, "Administrator", form.Items[0].Text)
+	assert.Equal(t, "Full name", form.Items[1].Text)
+	assert.Equal(t, "Age", form.Items[3].Text)
+
+	check := form.Items[0].Widget.(*widget.Check)
+	assert.True(t, check.Checked)
+	entry := form.Items[1].Widget.(*widget.Entry)
+	assert.Equal(t, "John Doe", entry.Text)
+	assert.NotNil(t, entry.Validator)
+	bio := form.Items[2].Widget.(*widget.Entry)
+	assert.True(t, bio.MultiLine)
+	slider := form.Items[4].Widget.(*widget.Slider)
+	assert.Equal(t, 5.0, slider.Max)
+}
+
+func TestNewFormFromStruct_TwoWay(t *testing.T) {
+	person := &formPerson{Name: "Jane"}
+	form := widget.NewFormFromStruct(person)
+
+	entry := form.Items[1].Widget.(*widget.Entry)
+	entry.SetText("Janet")
+	waitForBinding()
+	assert.Equal(t, "Janet", person.Name)
+
+	age := form.Items[3].Widget.(*widget.Entry)
+	age.SetText("21")
+	waitForBinding()
+	assert.Equal(t, 21, person.Age)
+}
+
+func TestNewFormFromStruct_Invalid(t *testing.T) {
+	form := widget.NewFormFromStruct(formPerson{})
+	assert.Empty(t, form.Items)
+}
@@ -38,10 +38,27 @@ type GridWrap struct {
 	OnSelected   func(id GridWrapItemID)                         `json:"-"`
 	OnUnselected func(id GridWrapItemID)                         `json:"-"`
 
+	// ItemSize returns the size of an individual item, overriding the uniform
+	// cell size measured from the template. Items flow left to right and wrap,
+	// each row taking the height of its tallest item. Return a zero size to
+	// fall back to the template size for that item.
+	//
+	// Since: 2.6
+	ItemSize func(id GridWrapItemID) fyne.Size `json:"-"`
+
+	// Masonry packs items into columns of the template width instead of uniform
+	// rows, placing each item below the shortest column so far. Item heights come
+	// from ItemSize, scaled to preserve the aspect ratio at the column width, so
+	// galleries of mixed aspect ratios render without letterboxing.
+	//
+	// Since: 2.6
+	Masonry bool
+
 	currentFocus  ListItemID
 	focused       bool
 	scroller      *widget.Scroll
 	selected      []GridWrapItemID
+	selection     *SelectionModel
 	itemMin       fyne.Size
 	offsetY       float32
 	offsetUpdated func(fyne.Position)
@@ -122,6 +139,10 @@ func (l *GridWrap) scrollTo(id GridWrapItemID) {
 	if l.scroller == nil {
 		return
 	}
+	if l.variableSize() {
+		l.scrollToVariable(id)
+		return
+	}
 
 	pad := l.Theme().Size(theme.SizeNamePadding)
 	row := math.Floor(float64(id) / float64(l.ColumnCount()))
@@ -161,7 +182,7 @@ func (l *GridWrap) Resize(s fyne.Size) {
 
 // Select adds the item identified by the given ID to the selection.
 func (l *GridWrap) Select(id GridWrapItemID) {
-	if len(l.selected) > 0 && id == l.selected[0] {
+	if len(l.selected) == 1 && id == l.selected[0] {
 		return
 	}
 	length := 0
@@ -171,18 +192,32 @@ func (l *GridWrap) Select(id GridWrapItemID) {
 	if id < 0 || id >= length {
 		return
 	}
-	old := l.selected
-	l.selected = []GridWrapItemID{id}
-	defer func() {
-		if f := l.OnUnselected; f != nil && len(old) > 0 {
-			f(old[0])
-		}
-		if f := l.OnSelected; f != nil {
-			f(id)
-		}
-	}()
 	l.scrollTo(id)
-	l.Refresh()
+	l.Selection().Select(id)
+}
+
+// Selection returns the model that tracks which items of this grid are selected.
+// Use its SetMode method to allow more than one item to be selected at a time.
+//
+// Since: 2.6
+func (l *GridWrap) Selection() *SelectionModel {
+	if l.selection == nil {
+		l.selection = newSelectionModel(func(selected, unselected []int) {
+			l.selected = l.Selection().Selected()
+			l.Refresh()
+			if f := l.OnUnselected; f != nil {
+				for _, id := range unselected {
+					f(id)
+				}
+			}
+			if f := l.OnSelected; f != nil {
+				for _, id := range selected {
+					f(id)
+				}
+			}
+		})
+	}
+	return l.selection
 }
 
 // ScrollTo scrolls to the item represented by id
@@ -308,15 +343,11 @@ func (l *GridWrap) GetScrollOffset() float32 {
 
 // Unselect removes the item identified by the given ID from the selection.
 func (l *GridWrap) Unselect(id GridWrapItemID) {
-	if len(l.selected) == 0 || l.selected[0] != id {
+	if !l.Selection().IsSelected(id) {
 		return
 	}
 
-	l.selected = nil
-	l.Refresh()
-	if f := l.OnUnselected; f != nil {
-		f(id)
-	}
+	l.Selection().Unselect(id)
 }
 
 // UnselectAll removes all items from the selection.
@@ -325,14 +356,7 @@ func (l *GridWrap) UnselectAll() {
 		return
 	}
 
-	selected := l.selected
-	l.selected = nil
-	l.Refresh()
-	if f := l.OnUnselected; f != nil {
-		for _, id := range selected {
-			f(id)
-		}
-	}
+	l.Selection().UnselectAll()
 }
 
 func (l *GridWrap) contentMinSize() fyne.Size {
@@ -340,6 +364,10 @@ func (l *GridWrap) contentMinSize() fyne.Size {
 	if l.Length == nil {
 		return fyne.NewSize(0, 0)
 	}
+	if l.variableSize() {
+		_, height := l.layoutCells(l.Length())
+		return fyne.NewSize(l.itemMin.Width, height)
+	}
 
 	cols := l.ColumnCount()
 	rows := float32(math.Ceil(float64(l.Length()) / float64(cols)))
@@ -394,17 +422,19 @@ func (l *gridWrapRenderer) Objects() []fyne.CanvasObject {
 var _ fyne.Widget = (*gridWrapItem)(nil)
 var _ fyne.Tappable = (*gridWrapItem)(nil)
 var _ desktop.Hoverable = (*gridWrapItem)(nil)
+var _ desktop.Mouseable = (*gridWrapItem)(nil)
 
 type gridWrapItem struct {
 	BaseWidget
 
-	onTapped          func()
+	onTapped          func(mod fyne.KeyModifier)
 	background        *canvas.Rectangle
 	child             fyne.CanvasObject
 	hovered, selected bool
+	tapModifier       fyne.KeyModifier
 }
 
-func newGridWrapItem(child fyne.CanvasObject, tapped func()) *gridWrapItem {
+func newGridWrapItem(child fyne.CanvasObject, tapped func(mod fyne.KeyModifier)) *gridWrapItem {
 	gw := &gridWrapItem{
 		child:    child,
 		onTapped: tapped,
@@ -451,12 +481,21 @@ func (gw *gridWrapItem) MouseOut() {
 	gw.Refresh()
 }
 
+// MouseDown records any held modifier keys so a following tap can honour them.
+func (gw *gridWrapItem) MouseDown(ev *desktop.MouseEvent) {
+	gw.tapModifier = ev.Modifier
+}
+
+// MouseUp is called when a desktop pointer is released over the widget.
+func (gw *gridWrapItem) MouseUp(*desktop.MouseEvent) {
+}
+
 // Tapped is called when a pointer tapped event is captured and triggers any tap handler.
 func (gw *gridWrapItem) Tapped(*fyne.PointEvent) {
 	if gw.onTapped != nil {
 		gw.selected = true
 		gw.Refresh()
-		gw.onTapped()
+		gw.onTapped(gw.tapModifier)
 	}
 }
 
@@ -573,7 +612,7 @@ func (l *gridWrapLayout) setupGridItem(li *gridWrapItem, id GridWrapItemID, focu
 	if f := l.list.UpdateItem; f != nil {
 		f(id, li.child)
 	}
-	li.onTapped = func() {
+	li.onTapped = func(mod fyne.KeyModifier) {
 		if !fyne.CurrentDevice().IsMobile() {
 			l.list.RefreshItem(l.list.currentFocus)
 			canvas := fyne.CurrentApp().Driver().CanvasForObject(l.list)
@@ -584,7 +623,11 @@ func (l *gridWrapLayout) setupGridItem(li *gridWrapItem, id GridWrapItemID, focu
 			l.list.currentFocus = id
 		}
 
-		l.list.Select(id)
+		if l.list.Selection().Mode() == SelectionModeMulti && mod != 0 {
+			l.list.Selection().tap(id, mod)
+		} else {
+			l.list.Select(id)
+		}
 	}
 }
 
@@ -605,6 +648,11 @@ func (l *GridWrap) ColumnCount() int {
 }
 
 func (l *gridWrapLayout) updateGrid(refresh bool) {
+	if l.list.variableSize() {
+		l.updateGridVariable(refresh)
+		return
+	}
+
 	// code here is a mashup of listLayout.updateList and gridWrapLayout.Layout
 	padding := l.list.Theme().Size(theme.SizeNamePadding)
 
@@ -0,0 +1,195 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// gridWrapCell is the computed rectangle of one item in a grid with
+// variable item sizes.
+type gridWrapCell struct {
+	id   GridWrapItemID
+	pos  fyne.Position
+	size fyne.Size
+}
+
+// variableSize reports whether this grid lays items out individually rather
+// than in uniform rows and columns.
+func (l *GridWrap) variableSize() bool {
+	return l.Masonry || l.ItemSize != nil
+}
+
+// itemSize returns the size of the given item, falling back to the template
+// size when ItemSize is unset or returns a zero dimension.
+func (l *GridWrap) itemSize(id GridWrapItemID) fyne.Size {
+	if f := l.ItemSize; f != nil {
+		size := f(id)
+		if size.Width > 0 && size.Height > 0 {
+			return size
+		}
+	}
+	return l.itemMin
+}
+
+// layoutCells computes the rectangle of every item along with the total content
+// height. Masonry packs fixed-width columns, otherwise items flow into rows of
+// varying heights.
+func (l *GridWrap) layoutCells(length int) ([]gridWrapCell, float32) {
+	pad := l.Theme().Size(theme.SizeNamePadding)
+	width := l.Size().Width
+	if width <= 0 {
+		width = l.itemMin.Width
+	}
+	cells := make([]gridWrapCell, 0, length)
+
+	if l.Masonry {
+		colWidth := l.itemMin.Width
+		cols := 1
+		if width > colWidth {
+			cols = int((width + pad) / (colWidth + pad))
+		}
+		heights := make([]float32, cols)
+		for id := 0; id < length; id++ {
+			size := l.itemSize(id)
+			height := size.Height
+			if size.Width > 0 && size.Width != colWidth {
+				height = size.Height * colWidth / size.Width // keep the aspect ratio
+			}
+
+			col := 0
+			for c := 1; c < cols; c++ {
+				if heights[c] < heights[col] {
+					col = c
+				}
+			}
+			y := heights[col]
+			cells = append(cells, gridWrapCell{
+				id:   id,
+				pos:  fyne.NewPos(float32(col)*(colWidth+pad), y),
+				size: fyne.NewSize(colWidth, height),
+			})
+			heights[col] = y + height + pad
+		}
+
+		bottom := float32(0)
+		for _, h := range heights {
+			bottom = fyne.Max(bottom, h)
+		}
+		if bottom > 0 {
+			bottom -= pad
+		}
+		return cells, bottom
+	}
+
+	x, y, rowHeight := float32(0), float32(0), float32(0)
+	for id := 0; id < length; id++ {
+		size := l.itemSize(id)
+		if x > 0 && x+size.Width > width {
+			x = 0
+			y += rowHeight + pad
+			rowHeight = 0
+		}
+		cells = append(cells, gridWrapCell{id: id, pos: fyne.NewPos(x, y), size: size})
+		x += size.Width + pad
+		rowHeight = fyne.Max(rowHeight, size.Height)
+	}
+	return cells, y + rowHeight
+}
+
+// scrollToVariable scrolls to the given item of a grid with variable item sizes.
+func (l *GridWrap) scrollToVariable(id GridWrapItemID) {
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+	if id < 0 || id >= length {
+		return
+	}
+
+	cells, _ := l.layoutCells(length)
+	y, height := cells[id].pos.Y, cells[id].size.Height
+	if y < l.scroller.Offset.Y {
+		l.scroller.Offset.Y = y
+	} else if size := l.scroller.Size(); y+height > l.scroller.Offset.Y+size.Height {
+		l.scroller.Offset.Y = y + height - size.Height
+	}
+	l.offsetUpdated(l.scroller.Offset)
+}
+
+// updateGridVariable is the updateGrid path for grids with variable item sizes,
+// showing the cells that intersect the viewport.
+func (l *gridWrapLayout) updateGridVariable(refresh bool) {
+	l.renderLock.Lock()
+	length := 0
+	if f := l.list.Length; f != nil {
+		length = f()
+	}
+	if l.list.UpdateItem == nil {
+		fyne.LogError("Missing UpdateCell callback required for GridWrap", nil)
+	}
+
+	cells, _ := l.list.layoutCells(length)
+	viewTop := l.list.offsetY
+	viewBottom := viewTop + l.list.scroller.Size().Height
+
+	// Keep pointer reference for copying slice header when returning to the pool
+	wasVisiblePtr := l.slicePool.Get()
+	wasVisible := (*wasVisiblePtr)[:0]
+	wasVisible = append(wasVisible, l.visible...)
+
+	oldVisibleLen := len(l.visible)
+	l.visible = l.visible[:0]
+
+	c := l.list.scroller.Content.(*fyne.Container)
+	oldObjLen := len(c.Objects)
+	c.Objects = c.Objects[:0]
+	for _, cell := range cells {
+		if cell.pos.Y+cell.size.Height < viewTop || cell.pos.Y > viewBottom {
+			continue
+		}
+
+		item, ok := l.searchVisible(wasVisible, cell.id)
+		if !ok {
+			item = l.getItem()
+			if item == nil {
+				continue
+			}
+		}
+		item.Move(cell.pos)
+		item.Resize(cell.size)
+
+		l.visible = append(l.visible, gridItemAndID{item: item, id: cell.id})
+		c.Objects = append(c.Objects, item)
+	}
+	l.nilOldSliceData(c.Objects, len(c.Objects), oldObjLen)
+	l.nilOldVisibleSliceData(l.visible, len(l.visible), oldVisibleLen)
+
+	for _, old := range wasVisible {
+		if _, ok := l.searchVisible(l.visible, old.id); !ok {
+			l.itemPool.Put(old.item)
+		}
+	}
+
+	// make a local deep copy of l.visible since rest of this function is unlocked
+	// and cannot safely access l.visible
+	visiblePtr := l.slicePool.Get()
+	visible := (*visiblePtr)[:0]
+	visible = append(visible, l.visible...)
+	l.renderLock.Unlock() // user code should not be locked
+
+	for _, obj := range visible {
+		l.setupGridItem(obj.item, obj.id, l.list.focused && l.list.currentFocus == obj.id)
+	}
+
+	// nil out all references before returning slices to pool
+	for i := 0; i < len(wasVisible); i++ {
+		wasVisible[i].item = nil
+	}
+	for i := 0; i < len(visible); i++ {
+		visible[i].item = nil
+	}
+	*wasVisiblePtr = wasVisible // Copy the slice header over to the heap
+	*visiblePtr = visible
+	l.slicePool.Put(wasVisiblePtr)
+	l.slicePool.Put(visiblePtr)
+}
@@ -0,0 +1,135 @@
+package widget
+
+import (
+	"fmt"
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMasonryTemplate() fyne.CanvasObject {
+	rect := canvas.NewRectangle(color.Black)
+	rect.SetMinSize(fyne.NewSize(50, 50))
+	return rect
+}
+
+func createVariableGridWrap(items int, sizes []fyne.Size) *GridWrap {
+	g := NewGridWrap(
+		func() int { return items },
+		newMasonryTemplate,
+		func(id GridWrapItemID, item fyne.CanvasObject) {})
+	g.ItemSize = func(id GridWrapItemID) fyne.Size {
+		return sizes[id%len(sizes)]
+	}
+	return g
+}
+
+func TestGridWrap_ItemSize(t *testing.T) {
+	sizes := []fyne.Size{
+		fyne.NewSize(60, 40),
+		fyne.NewSize(100, 70),
+		fyne.NewSize(80, 30),
+	}
+	g := createVariableGridWrap(3, sizes)
+	w := test.NewTempWindow(t, g)
+	w.Resize(fyne.NewSize(200, 300))
+	g.Resize(fyne.NewSize(200, 300))
+
+	pad := theme.Padding()
+	cells, height := g.layoutCells(3)
+	assert.Len(t, cells, 3)
+	assert.Equal(t, fyne.NewPos(0, 0), cells[0].pos)
+	assert.Equal(t, sizes[0], cells[0].size)
+	assert.Equal(t, fyne.NewPos(60+pad, 0), cells[1].pos)
+
+	// third item no longer fits, so it wraps below the tallest item of the row
+	assert.Equal(t, fyne.NewPos(0, 70+pad), cells[2].pos)
+	assert.Equal(t, 70+pad+30, height)
+	assert.Equal(t, height, g.contentMinSize().Height)
+
+	objects := g.scroller.Content.(*fyne.Container).Objects
+	assert.Len(t, objects, 3)
+	assert.Equal(t, cells[1].pos, objects[1].Position())
+	assert.Equal(t, sizes[1], objects[1].Size())
+}
+
+func TestGridWrap_Masonry(t *testing.T) {
+	heights := []float32{100, 40, 60, 30}
+	g := NewGridWrap(
+		func() int { return 4 },
+		newMasonryTemplate,
+		func(id GridWrapItemID, item fyne.CanvasObject) {})
+	g.Masonry = true
+	g.ItemSize = func(id GridWrapItemID) fyne.Size {
+		return fyne.NewSize(50, heights[id])
+	}
+	w := test.NewTempWindow(t, g)
+	w.Resize(fyne.NewSize(104+theme.Padding(), 300))
+	g.Resize(fyne.NewSize(104+theme.Padding(), 300))
+
+	pad := theme.Padding()
+	cells, height := g.layoutCells(4)
+	colWidth := g.itemMin.Width
+
+	// two columns; each item lands in the shortest column so far
+	assert.Equal(t, fyne.NewPos(0, 0), cells[0].pos)
+	assert.Equal(t, fyne.NewPos(colWidth+pad, 0), cells[1].pos)
+	assert.Equal(t, fyne.NewPos(colWidth+pad, 40+pad), cells[2].pos)
+	assert.Equal(t, fyne.NewPos(0, 100+pad), cells[3].pos)
+
+	for _, cell := range cells {
+		assert.Equal(t, colWidth, cell.size.Width)
+	}
+	assert.Equal(t, 100+pad+30, height)
+}
+
+func TestGridWrap_Masonry_AspectRatio(t *testing.T) {
+	g := NewGridWrap(
+		func() int { return 1 },
+		newMasonryTemplate,
+		func(id GridWrapItemID, item fyne.CanvasObject) {})
+	g.Masonry = true
+	g.ItemSize = func(id GridWrapItemID) fyne.Size {
+		return fyne.NewSize(200, 100) // 2:1 landscape
+	}
+	test.NewTempWindow(t, g)
+
+	cells, _ := g.layoutCells(1)
+	assert.Equal(t, g.itemMin.Width, cells[0].size.Width)
+	assert.Equal(t, g.itemMin.Width/2, cells[0].size.Height)
+}
+
+func TestGridWrap_Masonry_Virtualization(t *testing.T) {
+	g := NewGridWrap(
+		func() int { return 1000 },
+		func() fyne.CanvasObject {
+			label := NewLabel("template")
+			return label
+		},
+		func(id GridWrapItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText(fmt.Sprintf("%d", id))
+		})
+	g.Masonry = true
+	g.ItemSize = func(id GridWrapItemID) fyne.Size {
+		return fyne.NewSize(100, float32(40+id%3*20))
+	}
+	w := test.NewTempWindow(t, g)
+	w.Resize(fyne.NewSize(400, 300))
+	g.Resize(fyne.NewSize(400, 300))
+
+	visible := len(g.scroller.Content.(*fyne.Container).Objects)
+	assert.Greater(t, visible, 0)
+	assert.Less(t, visible, 100, "offscreen items should not be rendered")
+
+	g.ScrollToBottom()
+	layout := g.scroller.Content.(*fyne.Container).Layout.(*gridWrapLayout)
+	assert.Equal(t, 999, layout.visible[len(layout.visible)-1].id)
+
+	g.ScrollToTop()
+	assert.Equal(t, 0, layout.visible[0].id)
+}
@@ -0,0 +1,184 @@
+package widget
+
+import (
+	"io"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/storage"
+)
+
+// NewRichTextFromHTML configures a RichText widget by parsing the provided HTML content.
+// A sane subset of HTML is understood - paragraphs, headings, bold/italic/code text,
+// lists, tables, images, links, blockquotes and horizontal rules.
+// Unknown elements are ignored, though their content will still be rendered.
+//
+// Since: 2.6
+func NewRichTextFromHTML(r io.Reader) (*RichText, error) {
+	segs, err := parseHTML(r)
+	if err != nil {
+		return nil, err
+	}
+	return NewRichText(segs...), nil
+}
+
+func parseHTML(r io.Reader) ([]RichTextSegment, error) {
+	root, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return renderHTMLNode(root, false), nil
+}
+
+func renderHTMLNode(n *html.Node, blockquote bool) []RichTextSegment {
+	switch n.Type {
+	case html.TextNode:
+		text := collapseHTMLSpace(n.Data)
+		if strings.TrimSpace(text) == "" {
+			return nil
+		}
+		if blockquote {
+			return []RichTextSegment{&TextSegment{Style: RichTextStyleBlockquote, Text: text}}
+		}
+		return []RichTextSegment{&TextSegment{Style: RichTextStyleInline, Text: text}}
+	case html.DocumentNode:
+		return renderHTMLChildren(n, blockquote)
+	case html.ElementNode:
+	default:
+		return nil
+	}
+
+	switch n.Data {
+	case "head", "script", "style":
+		return nil
+	case "p", "div":
+		children := renderHTMLChildren(n, blockquote)
+		if !blockquote {
+			children = append(children, &TextSegment{Style: RichTextStyleParagraph})
+		}
+		return children
+	case "h1":
+		return []RichTextSegment{&TextSegment{Style: RichTextStyleHeading, Text: htmlInnerText(n)}}
+	case "h2":
+		return []RichTextSegment{&TextSegment{Style: RichTextStyleSubHeading, Text: htmlInnerText(n)}}
+	case "h3", "h4", "h5", "h6":
+		textSegment := TextSegment{Style: RichTextStyleParagraph, Text: htmlInnerText(n)}
+		textSegment.Style.TextStyle.Bold = true
+		return []RichTextSegment{&textSegment}
+	case "b", "strong":
+		return []RichTextSegment{&TextSegment{Style: RichTextStyleStrong, Text: htmlInnerText(n)}}
+	case "i", "em":
+		return []RichTextSegment{&TextSegment{Style: RichTextStyleEmphasis, Text: htmlInnerText(n)}}
+	case "code":
+		return []RichTextSegment{&TextSegment{Style: RichTextStyleCodeInline, Text: htmlInnerText(n)}}
+	case "pre":
+		return []RichTextSegment{&TextSegment{Style: RichTextStyleCodeBlock, Text: strings.Trim(rawHTMLText(n), "\n")}}
+	case "blockquote":
+		return renderHTMLChildren(n, true)
+	case "hr":
+		return []RichTextSegment{&SeparatorSegment{}}
+	case "br":
+		return []RichTextSegment{&TextSegment{Style: RichTextStyleInline, Text: "\n"}}
+	case "a":
+		link, _ := url.Parse(htmlAttr(n, "href"))
+		return []RichTextSegment{&HyperlinkSegment{Alignment: fyne.TextAlignLeading, Text: htmlInnerText(n), URL: link}}
+	case "img":
+		dest := htmlAttr(n, "src")
+		u, err := storage.ParseURI(dest)
+		if err != nil {
+			u = storage.NewFileURI(dest)
+		}
+		return []RichTextSegment{&ImageSegment{Source: u, Title: htmlAttr(n, "alt"), Alignment: fyne.TextAlignCenter}}
+	case "ul", "ol":
+		var items []RichTextSegment
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type != html.ElementNode || child.Data != "li" {
+				continue
+			}
+			items = append(items, &ParagraphSegment{Texts: renderHTMLChildren(child, blockquote)})
+		}
+		return []RichTextSegment{&ListSegment{Items: items, Ordered: n.Data == "ol"}}
+	case "table":
+		rows := renderHTMLTableRows(n, blockquote)
+		if len(rows) == 0 {
+			return nil
+		}
+		return []RichTextSegment{NewTableSegment(rows)}
+	}
+	return renderHTMLChildren(n, blockquote)
+}
+
+func renderHTMLChildren(n *html.Node, blockquote bool) []RichTextSegment {
+	var children []RichTextSegment
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		children = append(children, renderHTMLNode(child, blockquote)...)
+	}
+	return children
+}
+
+// renderHTMLTableRows walks a table element, through any thead/tbody wrappers,
+// converting each tr into a TableRow of TableCells.
+func renderHTMLTableRows(n *html.Node, blockquote bool) []*TableRow {
+	var rows []*TableRow
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type != html.ElementNode {
+			continue
+		}
+		switch child.Data {
+		case "thead", "tbody", "tfoot":
+			rows = append(rows, renderHTMLTableRows(child, blockquote)...)
+		case "tr":
+			row := &TableRow{}
+			for cell := child.FirstChild; cell != nil; cell = cell.NextSibling {
+				if cell.Type != html.ElementNode || (cell.Data != "td" && cell.Data != "th") {
+					continue
+				}
+				tableCell := NewTableCell(NewRichText(renderHTMLNode(cell, blockquote)...))
+				if cell.Data == "th" {
+					tableCell.setHeader()
+					row.header = true
+				}
+				row.cells = append(row.cells, tableCell)
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func htmlAttr(n *html.Node, name string) string {
+	for _, attr := range n.Attr {
+		if attr.Key == name {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+func htmlInnerText(n *html.Node) string {
+	return strings.TrimSpace(collapseHTMLSpace(rawHTMLText(n)))
+}
+
+func rawHTMLText(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var text strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		text.WriteString(rawHTMLText(child))
+	}
+	return text.String()
+}
+
+// collapseHTMLSpace reduces runs of whitespace to a single space, as an HTML renderer would.
+// A single trailing space is kept so words separate correctly around inline elements.
+func collapseHTMLSpace(text string) string {
+	collapsed := strings.Join(strings.Fields(text), " ")
+	if collapsed != "" && strings.TrimRight(text, " \t\n\r") != text {
+		collapsed += " "
+	}
+	return collapsed
+}
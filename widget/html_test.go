@@ -0,0 +1,155 @@
+package widget
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2/storage"
+)
+
+func richTextFromHTMLString(t *testing.T, content string) *RichText {
+	r, err := NewRichTextFromHTML(strings.NewReader(content))
+	assert.NoError(t, err)
+	return r
+}
+
+func TestRichTextHTML_Paragraph(t *testing.T) {
+	r := richTextFromHTMLString(t, "<p>text</p>")
+
+	assert.Equal(t, 2, len(r.Segments))
+	if text, ok := r.Segments[0].(*TextSegment); ok {
+		assert.Equal(t, "text", text.Text)
+		assert.Equal(t, RichTextStyleInline, text.Style)
+	} else {
+		t.Error("Segment should be Text")
+	}
+}
+
+func TestRichTextHTML_Heading(t *testing.T) {
+	r := richTextFromHTMLString(t, "<h1>Head</h1><h2>Sub</h2><h3>Small</h3>")
+
+	assert.Equal(t, 3, len(r.Segments))
+	if text, ok := r.Segments[0].(*TextSegment); ok {
+		assert.Equal(t, "Head", text.Text)
+		assert.Equal(t, RichTextStyleHeading, text.Style)
+	} else {
+		t.Error("Segment should be Text")
+	}
+	if text, ok := r.Segments[1].(*TextSegment); ok {
+		assert.Equal(t, "Sub", text.Text)
+		assert.Equal(t, RichTextStyleSubHeading, text.Style)
+	} else {
+		t.Error("Segment should be Text")
+	}
+	if text, ok := r.Segments[2].(*TextSegment); ok {
+		assert.Equal(t, "Small", text.Text)
+		assert.True(t, text.Style.TextStyle.Bold)
+	} else {
+		t.Error("Segment should be Text")
+	}
+}
+
+func TestRichTextHTML_Emphasis(t *testing.T) {
+	r := richTextFromHTMLString(t, "<p>a <b>bold</b> and <em>italic</em></p>")
+
+	assert.Equal(t, 5, len(r.Segments))
+	if text, ok := r.Segments[1].(*TextSegment); ok {
+		assert.Equal(t, "bold", text.Text)
+		assert.Equal(t, RichTextStyleStrong, text.Style)
+	} else {
+		t.Error("Segment should be Text")
+	}
+	if text, ok := r.Segments[3].(*TextSegment); ok {
+		assert.Equal(t, "italic", text.Text)
+		assert.Equal(t, RichTextStyleEmphasis, text.Style)
+	} else {
+		t.Error("Segment should be Text")
+	}
+}
+
+func TestRichTextHTML_Code(t *testing.T) {
+	r := richTextFromHTMLString(t, "<p><code>inline</code></p><pre>code\nblock</pre>")
+
+	if text, ok := r.Segments[0].(*TextSegment); ok {
+		assert.Equal(t, "inline", text.Text)
+		assert.Equal(t, RichTextStyleCodeInline, text.Style)
+	} else {
+		t.Error("Segment should be Text")
+	}
+	if text, ok := r.Segments[2].(*TextSegment); ok {
+		assert.Equal(t, "code\nblock", text.Text)
+		assert.Equal(t, RichTextStyleCodeBlock, text.Style)
+	} else {
+		t.Error("Segment should be Text")
+	}
+}
+
+func TestRichTextHTML_Blockquote(t *testing.T) {
+	r := richTextFromHTMLString(t, "<blockquote>quote</blockquote>")
+
+	assert.Equal(t, 1, len(r.Segments))
+	if text, ok := r.Segments[0].(*TextSegment); ok {
+		assert.Equal(t, "quote", text.Text)
+		assert.Equal(t, RichTextStyleBlockquote, text.Style)
+	} else {
+		t.Error("Segment should be Text")
+	}
+}
+
+func TestRichTextHTML_List(t *testing.T) {
+	r := richTextFromHTMLString(t, "<ul><li>one</li><li>two</li></ul>")
+
+	assert.Equal(t, 1, len(r.Segments))
+	list, ok := r.Segments[0].(*ListSegment)
+	if !ok {
+		t.Fatal("Segment should be a List")
+	}
+	assert.False(t, list.Ordered)
+	assert.Equal(t, 2, len(list.Items))
+
+	r = richTextFromHTMLString(t, "<ol><li>one</li></ol>")
+	if list, ok := r.Segments[0].(*ListSegment); ok {
+		assert.True(t, list.Ordered)
+	} else {
+		t.Error("Segment should be a List")
+	}
+}
+
+func TestRichTextHTML_Hyperlink(t *testing.T) {
+	r := richTextFromHTMLString(t, "<p><a href=\"https://fyne.io/\">site</a></p>")
+
+	if link, ok := r.Segments[0].(*HyperlinkSegment); ok {
+		assert.Equal(t, "site", link.Text)
+		assert.Equal(t, "https://fyne.io/", link.URL.String())
+	} else {
+		t.Error("Segment should be a Hyperlink")
+	}
+}
+
+func TestRichTextHTML_Image(t *testing.T) {
+	r := richTextFromHTMLString(t, "<img src=\"../../theme/icons/fyne.png\" alt=\"logo\"/>")
+
+	assert.Equal(t, 1, len(r.Segments))
+	if img, ok := r.Segments[0].(*ImageSegment); ok {
+		assert.Equal(t, storage.NewFileURI("../../theme/icons/fyne.png"), img.Source)
+		assert.Equal(t, "logo", img.Title)
+	} else {
+		t.Error("Segment should be an Image")
+	}
+}
+
+func TestRichTextHTML_Table(t *testing.T) {
+	r := richTextFromHTMLString(t, "<table><tr><th>a</th><th>b</th></tr><tr><td>1</td><td>2</td></tr></table>")
+
+	assert.Equal(t, 1, len(r.Segments))
+	table, ok := r.Segments[0].(*TableSegment)
+	if !ok {
+		t.Fatal("Segment should be a TableSegment")
+	}
+	assert.Equal(t, 2, len(table.rows))
+	assert.True(t, table.rows[0].header)
+	assert.Equal(t, 2, len(table.rows[0].cells))
+	assert.False(t, table.rows[1].header)
+}
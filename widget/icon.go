@@ -49,14 +49,37 @@ type Icon struct {
 
 	Resource  fyne.Resource // The resource for this icon
 	cachedRes fyne.Resource
+
+	// ContextMenu is an optional menu shown by a secondary tap on this icon,
+	// such as a right click or a long press on mobile.
+	//
+	// Since: 2.6
+	ContextMenu *fyne.Menu `json:"-"`
 }
 
+var _ fyne.SecondaryTappable = (*Icon)(nil)
+
 // SetResource updates the resource rendered in this icon widget
 func (i *Icon) SetResource(res fyne.Resource) {
 	i.Resource = res
 	i.Refresh()
 }
 
+// SetContextMenu sets the menu shown by a secondary tap on this icon.
+//
+// Since: 2.6
+func (i *Icon) SetContextMenu(menu *fyne.Menu) {
+	i.ContextMenu = menu
+}
+
+// TappedSecondary is called when a secondary tap is captured.
+// It shows the ContextMenu, if one is set.
+//
+// Since: 2.6
+func (i *Icon) TappedSecondary(e *fyne.PointEvent) {
+	showContextMenu(i.ContextMenu, i.super(), e.AbsolutePosition)
+}
+
 // MinSize returns the size that this widget should not shrink below
 func (i *Icon) MinSize() fyne.Size {
 	i.ExtendBaseWidget(i)
@@ -23,10 +23,18 @@ type Label struct {
 	// Since: 2.4
 	Importance Importance
 
+	// ContextMenu is an optional menu shown by a secondary tap on this label,
+	// such as a right click or a long press on mobile.
+	//
+	// Since: 2.6
+	ContextMenu *fyne.Menu `json:"-"`
+
 	provider *RichText
 	binder   basicBinder
 }
 
+var _ fyne.SecondaryTappable = (*Label)(nil)
+
 // NewLabel creates a new label widget with the set text content
 func NewLabel(text string) *Label {
 	return NewLabelWithStyle(text, fyne.TextAlignLeading, fyne.TextStyle{})
@@ -63,6 +71,17 @@ func (l *Label) Bind(data binding.String) {
 	l.binder.Bind(data)
 }
 
+// Baseline returns the distance from the top of the label to its first text
+// baseline, used by layouts that align objects on text baselines.
+//
+// Since: 2.6
+func (l *Label) Baseline() float32 {
+	th := l.Theme()
+	// the baseline comes from the font metrics so the text itself does not matter
+	_, base := fyne.CurrentApp().Driver().RenderedTextSize("M", th.Size(theme.SizeNameText), l.TextStyle, nil)
+	return th.Size(theme.SizeNameInnerPadding) + base
+}
+
 // CreateRenderer is a private method to Fyne which links this widget to its renderer
 func (l *Label) CreateRenderer() fyne.WidgetRenderer {
 	l.provider = NewRichTextWithText(l.Text)
@@ -103,6 +122,21 @@ func (l *Label) Resize(s fyne.Size) {
 	}
 }
 
+// SetContextMenu sets the menu shown by a secondary tap on this label.
+//
+// Since: 2.6
+func (l *Label) SetContextMenu(menu *fyne.Menu) {
+	l.ContextMenu = menu
+}
+
+// TappedSecondary is called when a secondary tap is captured.
+// It shows the ContextMenu, if one is set.
+//
+// Since: 2.6
+func (l *Label) TappedSecondary(e *fyne.PointEvent) {
+	showContextMenu(l.ContextMenu, l.super(), e.AbsolutePosition)
+}
+
 // SetText sets the text of the label
 func (l *Label) SetText(text string) {
 	l.propertyLock.Lock()
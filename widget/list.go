@@ -43,14 +43,103 @@ type List struct {
 	// Since: 2.5
 	HideSeparators bool
 
+	// OnReorder is called after the user drags a row to a new position, with the
+	// row's old and new indexes, so the data backing the list can be remapped.
+	// Setting it makes rows draggable.
+	//
+	// Since: 2.6
+	OnReorder func(from, to ListItemID) `json:"-"`
+
+	// ReorderHandles shows a drag handle on the trailing edge of each row and limits
+	// reorder drags to it, leaving the rest of the row free for other gestures.
+	//
+	// Since: 2.6
+	ReorderHandles bool
+
+	// LeadingSwipeActions are revealed when a row is swiped towards the trailing
+	// edge. Swiping past the threshold triggers the first action directly.
+	//
+	// Since: 2.6
+	LeadingSwipeActions []SwipeAction `json:"-"`
+
+	// TrailingSwipeActions are revealed when a row is swiped towards the leading
+	// edge. Swiping past the threshold triggers the first action directly.
+	//
+	// Since: 2.6
+	TrailingSwipeActions []SwipeAction `json:"-"`
+
+	// ItemMenu returns a context menu for the given row, shown by a secondary
+	// tap such as a right click or a long press on mobile.
+	//
+	// Since: 2.6
+	ItemMenu func(id ListItemID) *fyne.Menu `json:"-"`
+
+	// OnReachEnd is called once when the user scrolls within PrefetchMargin rows of
+	// the end of the list, so more items can be fetched from a paged source. A
+	// loading row is shown below the last item until Length reports more items.
+	//
+	// Since: 2.6
+	OnReachEnd func() `json:"-"`
+
+	// PrefetchMargin is how many rows before the end of the list OnReachEnd fires,
+	// zero meaning the last row has to become visible.
+	//
+	// Since: 2.6
+	PrefetchMargin int
+
+	// DropTree accepts rows of this list dragged onto the nodes of the given
+	// tree, taking precedence over row reordering. OnDroppedToTree must also be
+	// set for these drags to begin.
+	//
+	// Since: 2.6
+	DropTree *Tree `json:"-"`
+
+	// OnDroppedToTree is called when a row of this list is dropped onto a node
+	// of DropTree.
+	//
+	// Since: 2.6
+	OnDroppedToTree func(id ListItemID, target TreeNodeID) `json:"-"`
+
+	// Animated slides visible rows to their new positions when a refresh moves
+	// them, such as rows shifting after an insertion, removal or height change,
+	// instead of snapping. It honours the global animations setting, so reduced
+	// motion preferences are respected.
+	//
+	// Since: 2.6
+	Animated bool
+
 	currentFocus  ListItemID
 	focused       bool
 	scroller      *widget.Scroll
 	selected      []ListItemID
+	selection     *SelectionModel
 	itemMin       fyne.Size
 	itemHeights   map[ListItemID]float32
+	pageSize      int
+	page          int
+	pageSrcLength func() int
+	pageSrcUpdate func(id ListItemID, item fyne.CanvasObject)
 	offsetY       float32
 	offsetUpdated func(fyne.Position)
+
+	reordering       bool
+	reorderFrom      ListItemID
+	reorderSlot      int
+	reorderIndicator *canvas.Rectangle
+
+	loading      bool
+	loadedLength int
+	loadingRow   *Activity
+
+	sections         *ListSections
+	sectionSrcLength func() int
+	sectionSrcUpdate func(id ListItemID, item fyne.CanvasObject)
+	headerMin        fyne.Size
+	stickyHeader     *fyne.Container
+	stickyBG         *canvas.Rectangle
+	stickyChild      fyne.CanvasObject
+	stickySection    ListSectionID
+	indexBar         *listIndexBar
 }
 
 // NewList creates and returns a list widget for displaying items in
@@ -169,14 +258,11 @@ func (l *List) scrollTo(id ListItemID) {
 	separatorThickness := l.Theme().Size(theme.SizeNamePadding)
 	y := float32(0)
 	lastItemHeight := l.itemMin.Height
-	if l.itemHeights == nil || len(l.itemHeights) == 0 {
+	if (l.itemHeights == nil || len(l.itemHeights) == 0) && l.sections == nil {
 		y = (float32(id) * l.itemMin.Height) + (float32(id) * separatorThickness)
 	} else {
 		for i := 0; i < id; i++ {
-			height := l.itemMin.Height
-			if h, ok := l.itemHeights[i]; ok {
-				height = h
-			}
+			height := l.rowHeight(i)
 
 			y += height + separatorThickness
 			lastItemHeight = height
@@ -191,6 +277,108 @@ func (l *List) scrollTo(id ListItemID) {
 	l.offsetUpdated(l.scroller.Offset)
 }
 
+// Page returns the page of data currently shown, counting from 0.
+// It is always 0 until paging is enabled with SetPageSize.
+//
+// Since: 2.6
+func (l *List) Page() int {
+	return l.page
+}
+
+// PageCount returns how many pages the data is split across, at least 1.
+//
+// Since: 2.6
+func (l *List) PageCount() int {
+	if l.pageSize <= 0 {
+		return 1
+	}
+	total := 0
+	if f := l.pageSrcLength; f != nil {
+		total = f()
+	}
+	if total <= 0 {
+		return 1
+	}
+	return (total + l.pageSize - 1) / l.pageSize
+}
+
+// SetPage shows the given page of the data, clamped to the valid page range.
+// It does nothing until paging is enabled with SetPageSize.
+//
+// Since: 2.6
+func (l *List) SetPage(page int) {
+	if l.pageSize <= 0 {
+		return
+	}
+	if max := l.PageCount() - 1; page > max {
+		page = max
+	}
+	if page < 0 {
+		page = 0
+	}
+	l.page = page
+	l.ScrollToTop()
+	l.Refresh()
+}
+
+// SetPageSize splits the data of this list into pages of the given number of rows,
+// showing a single page at a time as an alternative to scrolling the whole set.
+// The Length and UpdateItem callbacks keep describing the full data. Use SetPage and
+// PageCount, or a PagerControls widget, to navigate. A size of 0 or less returns the
+// list to continuous scrolling.
+//
+// Since: 2.6
+func (l *List) SetPageSize(size int) {
+	if size <= 0 {
+		if l.pageSrcLength != nil {
+			l.Length = l.pageSrcLength
+			l.UpdateItem = l.pageSrcUpdate
+			l.pageSrcLength, l.pageSrcUpdate = nil, nil
+		}
+		l.pageSize = 0
+		l.page = 0
+		l.Refresh()
+		return
+	}
+
+	if l.pageSrcLength == nil {
+		l.pageSrcLength = l.Length
+		l.pageSrcUpdate = l.UpdateItem
+		l.Length = func() int {
+			total := 0
+			if f := l.pageSrcLength; f != nil {
+				total = f()
+			}
+			remain := total - l.page*l.pageSize
+			if remain <= 0 {
+				return 0
+			}
+			if remain > l.pageSize {
+				return l.pageSize
+			}
+			return remain
+		}
+		l.UpdateItem = func(id ListItemID, item fyne.CanvasObject) {
+			if f := l.pageSrcUpdate; f != nil {
+				f(l.SourceIndex(id), item)
+			}
+		}
+	}
+	l.pageSize = size
+	l.SetPage(l.page)
+}
+
+// SourceIndex returns the index in the full data of the item shown at the given
+// visible position, accounting for any active paging.
+//
+// Since: 2.6
+func (l *List) SourceIndex(id ListItemID) ListItemID {
+	if l.pageSize <= 0 {
+		return id
+	}
+	return l.page*l.pageSize + id
+}
+
 // Resize is called when this list should change size. We refresh to ensure invisible items are drawn.
 func (l *List) Resize(s fyne.Size) {
 	l.BaseWidget.Resize(s)
@@ -204,7 +392,7 @@ func (l *List) Resize(s fyne.Size) {
 
 // Select add the item identified by the given ID to the selection.
 func (l *List) Select(id ListItemID) {
-	if len(l.selected) > 0 && id == l.selected[0] {
+	if len(l.selected) == 1 && id == l.selected[0] {
 		return
 	}
 	length := 0
@@ -214,18 +402,32 @@ func (l *List) Select(id ListItemID) {
 	if id < 0 || id >= length {
 		return
 	}
-	old := l.selected
-	l.selected = []ListItemID{id}
-	defer func() {
-		if f := l.OnUnselected; f != nil && len(old) > 0 {
-			f(old[0])
-		}
-		if f := l.OnSelected; f != nil {
-			f(id)
-		}
-	}()
 	l.scrollTo(id)
-	l.Refresh()
+	l.Selection().Select(id)
+}
+
+// Selection returns the model that tracks which items of this list are selected.
+// Use its SetMode method to allow more than one item to be selected at a time.
+//
+// Since: 2.6
+func (l *List) Selection() *SelectionModel {
+	if l.selection == nil {
+		l.selection = newSelectionModel(func(selected, unselected []int) {
+			l.selected = l.Selection().Selected()
+			l.Refresh()
+			if f := l.OnUnselected; f != nil {
+				for _, id := range unselected {
+					f(id)
+				}
+			}
+			if f := l.OnSelected; f != nil {
+				for _, id := range selected {
+					f(id)
+				}
+			}
+		})
+	}
+	return l.selection
 }
 
 // ScrollTo scrolls to the item represented by id
@@ -330,15 +532,11 @@ func (l *List) TypedRune(_ rune) {
 
 // Unselect removes the item identified by the given ID from the selection.
 func (l *List) Unselect(id ListItemID) {
-	if len(l.selected) == 0 || l.selected[0] != id {
+	if !l.Selection().IsSelected(id) {
 		return
 	}
 
-	l.selected = nil
-	l.Refresh()
-	if f := l.OnUnselected; f != nil {
-		f(id)
-	}
+	l.Selection().Unselect(id)
 }
 
 // UnselectAll removes all items from the selection.
@@ -349,14 +547,7 @@ func (l *List) UnselectAll() {
 		return
 	}
 
-	selected := l.selected
-	l.selected = nil
-	l.Refresh()
-	if f := l.OnUnselected; f != nil {
-		for _, id := range selected {
-			f(id)
-		}
-	}
+	l.Selection().UnselectAll()
 }
 
 func (l *List) contentMinSize() fyne.Size {
@@ -368,9 +559,22 @@ func (l *List) contentMinSize() fyne.Size {
 	}
 	items := l.Length()
 
+	extra := float32(0)
+	if l.loading { // leave room for the loading row below the last item
+		extra = l.itemMin.Height + separatorThickness
+	}
+
+	if l.sections != nil {
+		height := extra
+		for i := 0; i < items; i++ {
+			height += l.rowHeight(i)
+		}
+		return fyne.NewSize(l.itemMin.Width, height+separatorThickness*float32(items-1))
+	}
+
 	if l.itemHeights == nil || len(l.itemHeights) == 0 {
 		return fyne.NewSize(l.itemMin.Width,
-			(l.itemMin.Height+separatorThickness)*float32(items)-separatorThickness)
+			(l.itemMin.Height+separatorThickness)*float32(items)-separatorThickness+extra)
 	}
 
 	height := float32(0)
@@ -384,7 +588,7 @@ func (l *List) contentMinSize() fyne.Size {
 	}
 	height += float32(items-totalCustom) * templateHeight
 
-	return fyne.NewSize(l.itemMin.Width, height+separatorThickness*float32(items-1))
+	return fyne.NewSize(l.itemMin.Width, height+separatorThickness*float32(items-1)+extra)
 }
 
 // fills l.visibleRowHeights and also returns offY and minRow
@@ -399,7 +603,7 @@ func (l *listLayout) calculateVisibleRowHeights(itemHeight float32, length int,
 
 	padding := th.Size(theme.SizeNamePadding)
 
-	if len(l.list.itemHeights) == 0 {
+	if len(l.list.itemHeights) == 0 && l.list.sections == nil {
 		paddedItemHeight := itemHeight + padding
 
 		offY = float32(math.Floor(float64(l.list.offsetY/paddedItemHeight))) * paddedItemHeight
@@ -425,10 +629,7 @@ func (l *listLayout) calculateVisibleRowHeights(itemHeight float32, length int,
 	}
 
 	for i := 0; i < length; i++ {
-		height := itemHeight
-		if h, ok := l.list.itemHeights[i]; ok {
-			height = h
-		}
+		height := l.list.rowHeight(i)
 
 		if rowOffset <= l.list.offsetY-height-padding {
 			// before scroll
@@ -468,6 +669,7 @@ func newListRenderer(objects []fyne.CanvasObject, l *List, scroller *widget.Scro
 
 func (l *listRenderer) Layout(size fyne.Size) {
 	l.scroller.Resize(size)
+	l.layoutIndexBar(size)
 }
 
 func (l *listRenderer) MinSize() fyne.Size {
@@ -479,6 +681,10 @@ func (l *listRenderer) Refresh() {
 		item := createItemAndApplyThemeScope(f, l.list)
 		l.list.itemMin = item.MinSize()
 	}
+	if s := l.list.sections; s != nil && s.CreateHeader != nil {
+		header := createItemAndApplyThemeScope(s.CreateHeader, l.list)
+		l.list.headerMin = header.MinSize()
+	}
 	l.Layout(l.list.Size())
 	l.scroller.Refresh()
 	layout := l.layout.Layout.(*listLayout)
@@ -487,24 +693,42 @@ func (l *listRenderer) Refresh() {
 	for _, s := range layout.separators {
 		s.Refresh()
 	}
+	if bar := l.list.indexBar; bar != nil && bar.Visible() {
+		bar.Refresh()
+	}
 	canvas.Refresh(l.list.super())
 }
 
 // Declare conformity with interfaces.
 var _ fyne.Widget = (*listItem)(nil)
 var _ fyne.Tappable = (*listItem)(nil)
+var _ fyne.SecondaryTappable = (*listItem)(nil)
+var _ fyne.Draggable = (*listItem)(nil)
 var _ desktop.Hoverable = (*listItem)(nil)
+var _ desktop.Mouseable = (*listItem)(nil)
 
 type listItem struct {
 	BaseWidget
 
-	onTapped          func()
+	onTapped          func(mod fyne.KeyModifier)
 	background        *canvas.Rectangle
 	child             fyne.CanvasObject
+	handle            *Icon
+	list              *List
+	id                ListItemID
+	header            bool
+	reordering        bool
+	dragToTree        bool
+	swipeActions      *fyne.Container
+	swiping           bool
+	swipeOpen         bool
+	swipeSide         int
+	swipeOffset       float32
 	hovered, selected bool
+	tapModifier       fyne.KeyModifier
 }
 
-func newListItem(child fyne.CanvasObject, tapped func()) *listItem {
+func newListItem(child fyne.CanvasObject, tapped func(mod fyne.KeyModifier)) *listItem {
 	li := &listItem{
 		child:    child,
 		onTapped: tapped,
@@ -524,7 +748,13 @@ func (li *listItem) CreateRenderer() fyne.WidgetRenderer {
 	li.background.CornerRadius = th.Size(theme.SizeNameSelectionRadius)
 	li.background.Hide()
 
-	objects := []fyne.CanvasObject{li.background, li.child}
+	li.handle = NewIcon(th.Icon(theme.IconNameMenu))
+	li.handle.Hide()
+
+	li.swipeActions = &fyne.Container{}
+	li.swipeActions.Hide()
+
+	objects := []fyne.CanvasObject{li.background, li.swipeActions, li.child, li.handle}
 
 	return &listItemRenderer{widget.NewBaseRenderer(objects), li}
 }
@@ -551,13 +781,35 @@ func (li *listItem) MouseOut() {
 	li.Refresh()
 }
 
+// MouseDown records any held modifier keys so a following tap can honour them.
+func (li *listItem) MouseDown(ev *desktop.MouseEvent) {
+	li.tapModifier = ev.Modifier
+}
+
+// MouseUp is called when a desktop pointer is released over the widget.
+func (li *listItem) MouseUp(*desktop.MouseEvent) {
+}
+
 // Tapped is called when a pointer tapped event is captured and triggers any tap handler.
 func (li *listItem) Tapped(*fyne.PointEvent) {
+	if li.swipeOpen || li.swiping {
+		li.closeSwipe()
+		return
+	}
 	if li.onTapped != nil {
 		li.selected = true
 		li.Refresh()
-		li.onTapped()
+		li.onTapped(li.tapModifier)
+	}
+}
+
+// TappedSecondary is called when a secondary tap is captured over this row.
+// It shows the menu provided by the list's ItemMenu callback, if any.
+func (li *listItem) TappedSecondary(e *fyne.PointEvent) {
+	if li.list == nil || li.list.ItemMenu == nil || li.header {
+		return
 	}
+	showContextMenu(li.list.ItemMenu(li.id), li.super(), e.AbsolutePosition)
 }
 
 // Declare conformity with the WidgetRenderer interface.
@@ -578,7 +830,15 @@ func (li *listItemRenderer) MinSize() fyne.Size {
 // Layout the components of the listItem widget.
 func (li *listItemRenderer) Layout(size fyne.Size) {
 	li.item.background.Resize(size)
-	li.item.child.Resize(size)
+
+	handleWidth := li.item.reorderHandleWidth()
+	li.item.handle.Hidden = handleWidth == 0
+	li.item.child.Resize(fyne.NewSize(size.Width-handleWidth, size.Height))
+	if handleWidth > 0 {
+		iconSize := li.item.Theme().Size(theme.SizeNameInlineIcon)
+		li.item.handle.Resize(fyne.NewSquareSize(iconSize))
+		li.item.handle.Move(fyne.NewPos(size.Width-(handleWidth+iconSize)/2, (size.Height-iconSize)/2))
+	}
 }
 
 func (li *listItemRenderer) Refresh() {
@@ -586,6 +846,8 @@ func (li *listItemRenderer) Refresh() {
 	v := fyne.CurrentApp().Settings().ThemeVariant()
 
 	li.item.background.CornerRadius = th.Size(theme.SizeNameSelectionRadius)
+	li.item.handle.Hidden = li.item.reorderHandleWidth() == 0
+	li.item.handle.Resource = th.Icon(theme.IconNameMenu)
 	if li.item.selected {
 		li.item.background.FillColor = th.Color(theme.ColorNameSelection, v)
 		li.item.background.Show()
@@ -613,6 +875,7 @@ type listLayout struct {
 	children   []fyne.CanvasObject
 
 	itemPool          async.Pool[fyne.CanvasObject]
+	headerPool        async.Pool[fyne.CanvasObject]
 	visible           []listItemAndID
 	slicePool         async.Pool[*[]listItemAndID]
 	visibleRowHeights []float32
@@ -646,7 +909,9 @@ func (l *listLayout) getItem() *listItem {
 			item = newListItem(item2, nil)
 		}
 	}
-	return item.(*listItem)
+	li := item.(*listItem)
+	li.list = l.list
+	return li
 }
 
 func (l *listLayout) offsetUpdated(pos fyne.Position) {
@@ -658,6 +923,13 @@ func (l *listLayout) offsetUpdated(pos fyne.Position) {
 }
 
 func (l *listLayout) setupListItem(li *listItem, id ListItemID, focus bool) {
+	li.id = id
+	if li.header { // section headers are not selectable or focusable
+		if f := l.list.UpdateItem; f != nil {
+			f(id, li.child)
+		}
+		return
+	}
 	previousIndicator := li.selected
 	li.selected = false
 	for _, s := range l.list.selected {
@@ -676,7 +948,7 @@ func (l *listLayout) setupListItem(li *listItem, id ListItemID, focus bool) {
 	if f := l.list.UpdateItem; f != nil {
 		f(id, li.child)
 	}
-	li.onTapped = func() {
+	li.onTapped = func(mod fyne.KeyModifier) {
 		if !fyne.CurrentDevice().IsMobile() {
 			canvas := fyne.CurrentApp().Driver().CanvasForObject(l.list)
 			if canvas != nil {
@@ -686,7 +958,11 @@ func (l *listLayout) setupListItem(li *listItem, id ListItemID, focus bool) {
 			l.list.currentFocus = id
 		}
 
-		l.list.Select(id)
+		if l.list.Selection().Mode() == SelectionModeMulti && mod != 0 {
+			l.list.Selection().tap(id, mod)
+		} else {
+			l.list.Select(id)
+		}
 	}
 }
 
@@ -702,6 +978,9 @@ func (l *listLayout) updateList(newOnly bool) {
 	if l.list.UpdateItem == nil {
 		fyne.LogError("Missing UpdateCell callback required for List", nil)
 	}
+	if l.list.loading && length != l.list.loadedLength { // the fetched items arrived
+		l.list.hideLoadingRow()
+	}
 
 	// Keep pointer reference for copying slice header when returning to the pool
 	// https://blog.mike.norgate.xyz/unlocking-go-slice-performance-navigating-sync-pool-for-enhanced-efficiency-7cb63b0b453e
@@ -722,21 +1001,35 @@ func (l *listLayout) updateList(newOnly bool) {
 	oldChildrenLen := len(l.children)
 	l.children = l.children[:0]
 
+	animate := !newOnly && l.list.Animated && transitionsEnabled()
 	y := offY
 	for index, itemHeight := range l.visibleRowHeights {
 		row := index + minRow
 		size := fyne.NewSize(width, itemHeight)
 
+		header := l.list.isHeaderRow(row)
 		c, ok := l.searchVisible(wasVisible, row)
+		if ok && c.header != header {
+			ok = false // the row changed kind, a matching template is fetched below
+		}
 		if !ok {
-			c = l.getItem()
+			if header {
+				c = l.getHeader()
+			} else {
+				c = l.getItem()
+			}
 			if c == nil {
 				continue
 			}
 			c.Resize(size)
 		}
 
-		c.Move(fyne.NewPos(0, y))
+		pos := fyne.NewPos(0, y)
+		if animate && ok {
+			animateMove(c, pos) // slide rows shifted by a data change
+		} else {
+			c.Move(pos)
+		}
 		c.Resize(size)
 
 		y += itemHeight + separatorThickness
@@ -747,8 +1040,8 @@ func (l *listLayout) updateList(newOnly bool) {
 	l.nilOldVisibleSliceData(l.visible, len(l.visible), oldVisibleLen)
 
 	for _, wasVis := range wasVisible {
-		if _, ok := l.searchVisible(l.visible, wasVis.id); !ok {
-			l.itemPool.Put(wasVis.item)
+		if vis, ok := l.searchVisible(l.visible, wasVis.id); !ok || vis != wasVis.item {
+			l.recycleItem(wasVis.item)
 		}
 	}
 
@@ -759,8 +1052,24 @@ func (l *listLayout) updateList(newOnly bool) {
 	c.Objects = c.Objects[:0]
 	c.Objects = append(c.Objects, l.children...)
 	c.Objects = append(c.Objects, l.separators...)
+	if ind := l.list.reorderIndicator; ind != nil && ind.Visible() {
+		c.Objects = append(c.Objects, ind)
+	}
+	if row := l.list.loadingRow; row != nil && l.list.loading {
+		side := l.list.itemMin.Height
+		row.Resize(fyne.NewSize(side, side))
+		row.Move(fyne.NewPos((width-side)/2, l.list.contentMinSize().Height-side))
+		c.Objects = append(c.Objects, row)
+	}
+	stickySection := ListSectionID(-1)
+	if sticky, sec := l.list.stickyHeaderObject(width); sticky != nil {
+		c.Objects = append(c.Objects, sticky)
+		stickySection = sec
+	}
 	l.nilOldSliceData(c.Objects, len(c.Objects), oldObjLen)
 
+	maxRow := minRow + len(l.visibleRowHeights) - 1
+
 	// make a local deep copy of l.visible since rest of this function is unlocked
 	// and cannot safely access l.visible
 	visiblePtr := l.slicePool.Get()
@@ -780,6 +1089,9 @@ func (l *listLayout) updateList(newOnly bool) {
 		}
 	}
 
+	l.list.refreshStickyHeader(stickySection)
+	l.list.checkReachEnd(maxRow, length)
+
 	// nil out all references before returning slices to pool
 	for i := 0; i < len(wasVisible); i++ {
 		wasVisible[i].item = nil
@@ -680,3 +680,22 @@ func BenchmarkContentMinSize(b *testing.B) {
 
 	minSize = min
 }
+
+func TestList_ItemMenu(t *testing.T) {
+	menuFor := -1
+	list := NewList(
+		func() int { return 3 },
+		func() fyne.CanvasObject { return NewLabel("Template") },
+		func(id ListItemID, item fyne.CanvasObject) {})
+	list.ItemMenu = func(id ListItemID) *fyne.Menu {
+		menuFor = id
+		return fyne.NewMenu("", fyne.NewMenuItem("Delete", nil))
+	}
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 200))
+
+	children := list.scroller.Content.(*fyne.Container).Layout.(*listLayout).children
+	children[1].(*listItem).TappedSecondary(&fyne.PointEvent{AbsolutePosition: fyne.NewPos(100, 50)})
+	assert.Equal(t, 1, menuFor)
+	assert.Len(t, w.Canvas().Overlays().List(), 1)
+}
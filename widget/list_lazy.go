@@ -0,0 +1,32 @@
+package widget
+
+// checkReachEnd fires OnReachEnd once when the bottom of the list comes within
+// PrefetchMargin rows of the visible area, showing the loading row until the
+// length of the list changes.
+func (l *List) checkReachEnd(maxRow, length int) {
+	if l.OnReachEnd == nil || l.loading || length == 0 {
+		return
+	}
+	if maxRow < length-1-l.PrefetchMargin {
+		return
+	}
+
+	l.loading = true
+	l.loadedLength = length
+	if l.loadingRow == nil {
+		l.loadingRow = NewActivity()
+	}
+	l.loadingRow.Start()
+	l.loadingRow.Show()
+	l.OnReachEnd()
+	l.Refresh() // lay out the loading row
+}
+
+// hideLoadingRow stops and hides the loading row once new items have arrived.
+func (l *List) hideLoadingRow() {
+	l.loading = false
+	if l.loadingRow != nil {
+		l.loadingRow.Stop()
+		l.loadingRow.Hide()
+	}
+}
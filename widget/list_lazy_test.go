@@ -0,0 +1,68 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func lazyTestList(length *int, fetches *int) *List {
+	l := NewList(
+		func() int { return *length },
+		func() fyne.CanvasObject { return NewLabel("template") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText("row")
+		})
+	l.OnReachEnd = func() { *fetches++ }
+	return l
+}
+
+func TestList_OnReachEnd(t *testing.T) {
+	length, fetches := 50, 0
+	l := lazyTestList(&length, &fetches)
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(200, 200))
+
+	assert.Equal(t, 0, fetches)
+	assert.False(t, l.loading)
+
+	plain := l.contentMinSize()
+	l.ScrollToBottom()
+	assert.Equal(t, 1, fetches)
+	assert.True(t, l.loading)
+	assert.True(t, l.loadingRow.Visible())
+	assert.Greater(t, l.contentMinSize().Height, plain.Height, "room is left for the loading row")
+
+	// scrolling around while a fetch is pending does not fire another
+	l.ScrollToTop()
+	l.ScrollToBottom()
+	assert.Equal(t, 1, fetches)
+
+	length = 100
+	l.Refresh()
+	assert.False(t, l.loading)
+	assert.False(t, l.loadingRow.Visible())
+}
+
+func TestList_OnReachEnd_PrefetchMargin(t *testing.T) {
+	length, fetches := 50, 0
+	l := lazyTestList(&length, &fetches)
+	l.PrefetchMargin = 45
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(200, 200))
+
+	assert.Equal(t, 1, fetches, "the first rows are already within the prefetch margin")
+	assert.True(t, l.loading)
+}
+
+func TestList_OnReachEnd_ShortList(t *testing.T) {
+	length, fetches := 3, 0
+	l := lazyTestList(&length, &fetches)
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(200, 200))
+
+	assert.Equal(t, 1, fetches, "every row fits so the end is already reached")
+}
@@ -0,0 +1,199 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// itemDragged tracks a row being dragged to a new position, moving the gap
+// indicator and scrolling the list when the pointer nears its edges.
+func (l *List) itemDragged(li *listItem, e *fyne.DragEvent) {
+	if !l.reordering {
+		l.reordering = true
+		l.reorderFrom = li.id
+		l.reorderSlot = -1
+	}
+
+	pointerY := li.Position().Y + e.Position.Y
+	l.reorderAutoScroll(pointerY)
+
+	slot, slotY := l.reorderSlotAt(pointerY)
+	if slot == l.reorderSlot {
+		return
+	}
+	l.reorderSlot = slot
+	l.moveReorderIndicator(slotY)
+}
+
+// itemDragEnd drops the dragged row into the slot under the pointer, remapping the
+// backing data through OnReorder.
+func (l *List) itemDragEnd() {
+	if !l.reordering {
+		return
+	}
+	l.reordering = false
+
+	if l.reorderIndicator != nil {
+		l.reorderIndicator.Hide()
+	}
+
+	from, slot := l.reorderFrom, l.reorderSlot
+	to := slot
+	if slot > from {
+		to = slot - 1 // rows after the dragged one shift up once it is removed
+	}
+	if f := l.OnReorder; f != nil && slot >= 0 && to != from {
+		f(from, to)
+	}
+	l.Refresh()
+}
+
+// moveReorderIndicator slides the gap indicator to the slot boundary at the passed
+// content offset, creating it on first use.
+func (l *List) moveReorderIndicator(y float32) {
+	th := l.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	ind := l.reorderIndicator
+	created := ind == nil
+	if created {
+		ind = canvas.NewRectangle(th.Color(theme.ColorNamePrimary, v))
+		l.reorderIndicator = ind
+	}
+	ind.FillColor = th.Color(theme.ColorNamePrimary, v)
+	ind.Resize(fyne.NewSize(l.Size().Width, th.Size(theme.SizeNamePadding)))
+
+	target := fyne.NewPos(0, y)
+	if !created && ind.Visible() && fyne.CurrentApp().Settings().ShowAnimations() {
+		canvas.NewPositionAnimation(ind.Position(), target, canvas.DurationShort, ind.Move).Start()
+	} else {
+		ind.Move(target)
+	}
+	ind.Show()
+
+	if l.scroller == nil {
+		return
+	}
+	c := l.scroller.Content.(*fyne.Container)
+	for _, o := range c.Objects {
+		if o == ind {
+			return
+		}
+	}
+	c.Objects = append(c.Objects, ind) // updateList keeps it there as the list scrolls
+	c.Refresh()
+}
+
+// reorderAutoScroll scrolls the list when a reorder drag nears its top or bottom
+// edge, so rows outside the viewport can be reached.
+func (l *List) reorderAutoScroll(pointerY float32) {
+	if l.scroller == nil {
+		return
+	}
+	edge := l.Theme().Size(theme.SizeNameInlineIcon) * 2
+	viewY := pointerY - l.offsetY
+	height := l.scroller.Size().Height
+
+	if viewY < edge {
+		l.scroller.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, edge-viewY)})
+	} else if viewY > height-edge {
+		l.scroller.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, (height-edge)-viewY)})
+	}
+}
+
+// reorderSlotAt returns the insertion slot under the passed content offset, between
+// 0 and Length, along with the content offset of its boundary.
+func (l *List) reorderSlotAt(y float32) (slot int, slotY float32) {
+	separatorThickness := l.Theme().Size(theme.SizeNamePadding)
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+
+	rowY := float32(0)
+	for i := 0; i < length; i++ {
+		height := l.itemMin.Height
+		if custom, ok := l.itemHeights[i]; ok {
+			height = custom
+		}
+		if y < rowY+height/2 {
+			if i == 0 {
+				return 0, 0
+			}
+			return i, rowY - separatorThickness
+		}
+		rowY += height + separatorThickness
+	}
+	return length, rowY - separatorThickness
+}
+
+// reorderHandleWidth returns the space a row reserves for its drag handle, zero
+// when handles are not shown.
+func (li *listItem) reorderHandleWidth() float32 {
+	if li.list == nil || !li.list.ReorderHandles || li.list.OnReorder == nil {
+		return 0
+	}
+	th := li.Theme()
+	return th.Size(theme.SizeNameInlineIcon) + th.Size(theme.SizeNameInnerPadding)*2
+}
+
+// Dragged moves this row while reordering, if the list allows it.
+//
+// Implements: fyne.Draggable
+func (li *listItem) Dragged(e *fyne.DragEvent) {
+	if li.swiping {
+		li.swipeDragged(e)
+		return
+	}
+	if li.list == nil {
+		return
+	}
+	if !li.reordering && !li.list.reordering && li.hasSwipeActions(e.Dragged) {
+		li.swipeDragged(e)
+		return
+	}
+	if tree := li.list.DropTree; tree != nil && li.list.OnDroppedToTree != nil {
+		li.dragToTree = true
+		abs := fyne.CurrentApp().Driver().AbsolutePositionForObject(li.super()).Add(e.Position)
+		tree.externalDragOver(abs)
+		return
+	}
+	if li.list.OnReorder == nil {
+		return
+	}
+	if !li.reordering {
+		if li.list.ReorderHandles {
+			pressed := e.Position.Subtract(e.Dragged)
+			if pressed.X < li.Size().Width-li.reorderHandleWidth() {
+				return
+			}
+		}
+		li.reordering = true
+	}
+	li.list.itemDragged(li, e)
+}
+
+// DragEnd drops this row at the position it was dragged to.
+//
+// Implements: fyne.Draggable
+func (li *listItem) DragEnd() {
+	if li.swiping {
+		li.swipeEnd()
+		return
+	}
+	if li.dragToTree {
+		li.dragToTree = false
+		target, ok := li.list.DropTree.endDrag()
+		li.list.DropTree.Refresh()
+		if ok {
+			li.list.OnDroppedToTree(li.id, target)
+		}
+		return
+	}
+	if !li.reordering {
+		return
+	}
+	li.reordering = false
+	li.list.itemDragEnd()
+}
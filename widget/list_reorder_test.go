@@ -0,0 +1,101 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func reorderTestList(data []string) *List {
+	l := NewList(
+		func() int { return len(data) },
+		func() fyne.CanvasObject { return NewLabel("template") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText(data[id])
+		})
+	l.OnReorder = func(from, to ListItemID) {
+		moved := data[from]
+		data = append(data[:from], data[from+1:]...)
+		data = append(data[:to], append([]string{moved}, data[to:]...)...)
+	}
+	return l
+}
+
+func visibleListItem(l *List, id ListItemID) *listItem {
+	lo := l.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	item, _ := lo.searchVisible(lo.visible, id)
+	return item
+}
+
+func TestList_Reorder(t *testing.T) {
+	data := []string{"a", "b", "c", "d"}
+	l := reorderTestList(data)
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(100, 300))
+
+	item := visibleListItem(l, 0)
+	height := item.Size().Height
+
+	// drag the first row down past the third
+	item.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(10, height*2.6)},
+		Dragged:    fyne.NewDelta(0, height*2.6),
+	})
+	assert.True(t, l.reordering)
+	assert.NotNil(t, l.reorderIndicator)
+	assert.True(t, l.reorderIndicator.Visible())
+
+	item.DragEnd()
+	assert.False(t, l.reordering)
+	assert.False(t, l.reorderIndicator.Visible())
+	assert.Equal(t, "b", test.TempWidgetRenderer(t, visibleListItem(l, 0).child.(*Label)).Objects()[0].(*RichText).String())
+}
+
+func TestList_Reorder_SameSlot(t *testing.T) {
+	data := []string{"a", "b", "c"}
+	moved := false
+	l := reorderTestList(data)
+	l.OnReorder = func(from, to ListItemID) { moved = true }
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(100, 300))
+
+	item := visibleListItem(l, 1)
+	item.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(10, 5)},
+		Dragged:    fyne.NewDelta(0, 5),
+	})
+	item.DragEnd()
+	assert.False(t, moved, "dropping a row back where it was does not remap")
+}
+
+func TestList_ReorderHandles(t *testing.T) {
+	data := []string{"a", "b", "c"}
+	l := reorderTestList(data)
+	l.ReorderHandles = true
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(100, 300))
+
+	item := visibleListItem(l, 0)
+	assert.True(t, item.handle.Visible())
+	assert.Less(t, item.child.Size().Width, item.Size().Width,
+		"row content leaves room for the handle")
+
+	// a drag starting away from the handle does not reorder
+	item.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(10, 50)},
+		Dragged:    fyne.NewDelta(0, 40),
+	})
+	assert.False(t, l.reordering)
+
+	// one starting on the handle does
+	x := item.Size().Width - 5
+	item.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(x, 50)},
+		Dragged:    fyne.NewDelta(0, 40),
+	})
+	assert.True(t, l.reordering)
+	item.DragEnd()
+}
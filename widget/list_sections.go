@@ -0,0 +1,417 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// ListSectionID identifies a section within a sectioned List.
+//
+// Since: 2.6
+type ListSectionID = int
+
+// ListSections describes how the rows of a List are grouped into sections.
+// Each section is introduced by a header row built from the CreateHeader
+// template, which pins to the top edge while its section scrolls past.
+//
+// Since: 2.6
+type ListSections struct {
+	Count        func() int                                                 `json:"-"`
+	Length       func(section ListSectionID) int                            `json:"-"`
+	CreateHeader func() fyne.CanvasObject                                   `json:"-"`
+	UpdateHeader func(section ListSectionID, header fyne.CanvasObject)      `json:"-"`
+	UpdateItem   func(section ListSectionID, item int, o fyne.CanvasObject) `json:"-"`
+
+	// Title returns a short label for a section, shown in an index bar over the
+	// trailing edge of the list for jumping straight to a section. Leave nil to
+	// hide the index bar.
+	Title func(section ListSectionID) string `json:"-"`
+}
+
+// SetSections groups the rows of this list into sections, each starting with a
+// pinned header row. While sectioned, the Length and UpdateItem callbacks of the
+// list are ignored in favour of the section ones. Pass nil to return the list to
+// a flat row layout.
+//
+// Since: 2.6
+func (l *List) SetSections(sections *ListSections) {
+	if sections == nil {
+		if l.sections != nil {
+			l.Length = l.sectionSrcLength
+			l.UpdateItem = l.sectionSrcUpdate
+			l.sectionSrcLength, l.sectionSrcUpdate = nil, nil
+			l.sections = nil
+			if l.stickyHeader != nil {
+				l.stickyHeader.Hide()
+			}
+		}
+		l.Refresh()
+		return
+	}
+
+	if l.sections == nil {
+		l.sectionSrcLength, l.sectionSrcUpdate = l.Length, l.UpdateItem
+		l.Length = l.flatLength
+		l.UpdateItem = l.updateFlatRow
+	}
+	l.sections = sections
+	l.stickySection = -1
+	if f := sections.CreateHeader; f != nil {
+		header := createItemAndApplyThemeScope(f, l)
+		l.headerMin = header.MinSize()
+	}
+	l.Refresh()
+}
+
+// ScrollToSection scrolls so the header of the given section sits at the top of
+// the view, clamped to the valid section range.
+//
+// Since: 2.6
+func (l *List) ScrollToSection(section ListSectionID) {
+	s := l.sections
+	if s == nil || s.Count == nil {
+		return
+	}
+	if max := s.Count() - 1; section > max {
+		section = max
+	}
+	if section < 0 {
+		section = 0
+	}
+	l.ScrollToOffset(l.rowOffset(l.sectionFirstRow(section)))
+}
+
+// flatLength is the row count of a sectioned list, items plus one header per section.
+func (l *List) flatLength() int {
+	s := l.sections
+	if s == nil || s.Count == nil {
+		return 0
+	}
+	count := s.Count()
+	rows := count
+	if s.Length == nil {
+		return rows
+	}
+	for sec := 0; sec < count; sec++ {
+		rows += s.Length(sec)
+	}
+	return rows
+}
+
+// rowInfo translates a flat row index into the section it belongs to, and either
+// the header flag or the index of the item within its section.
+func (l *List) rowInfo(row int) (header bool, section ListSectionID, item int) {
+	s := l.sections
+	count := s.Count()
+	for sec := 0; sec < count; sec++ {
+		if row == 0 {
+			return true, sec, 0
+		}
+		row--
+		n := 0
+		if s.Length != nil {
+			n = s.Length(sec)
+		}
+		if row < n {
+			return false, sec, row
+		}
+		row -= n
+	}
+	return false, count - 1, row
+}
+
+func (l *List) isHeaderRow(row int) bool {
+	if l.sections == nil || l.sections.Count == nil {
+		return false
+	}
+	header, _, _ := l.rowInfo(row)
+	return header
+}
+
+// sectionFirstRow returns the flat row index of the given section's header.
+func (l *List) sectionFirstRow(section ListSectionID) int {
+	row := 0
+	for sec := 0; sec < section; sec++ {
+		row++
+		if f := l.sections.Length; f != nil {
+			row += f(sec)
+		}
+	}
+	return row
+}
+
+// rowHeight returns the height of the given flat row, headers taking the height
+// of their own template.
+func (l *List) rowHeight(row int) float32 {
+	if l.sections != nil && l.isHeaderRow(row) {
+		if l.headerMin.IsZero() {
+			return l.itemMin.Height
+		}
+		return l.headerMin.Height
+	}
+	if h, ok := l.itemHeights[row]; ok {
+		return h
+	}
+	return l.itemMin.Height
+}
+
+// rowOffset returns the content offset of the top of the given flat row.
+func (l *List) rowOffset(row int) float32 {
+	separatorThickness := l.Theme().Size(theme.SizeNamePadding)
+	y := float32(0)
+	for i := 0; i < row; i++ {
+		y += l.rowHeight(i) + separatorThickness
+	}
+	return y
+}
+
+// updateFlatRow dispatches a flat row update to the header or item callback of
+// the sections, installed as UpdateItem while the list is sectioned.
+func (l *List) updateFlatRow(id ListItemID, o fyne.CanvasObject) {
+	s := l.sections
+	if s == nil || s.Count == nil {
+		return
+	}
+	header, section, item := l.rowInfo(id)
+	if header {
+		if f := s.UpdateHeader; f != nil {
+			f(section, o)
+		}
+		return
+	}
+	if f := s.UpdateItem; f != nil {
+		f(section, item, o)
+	}
+}
+
+// stickyHeaderObject positions the pinned copy of the current top section's
+// header, returning it for drawing over the rows along with the section it
+// should show. The header content is refreshed later, outside the render lock.
+func (l *List) stickyHeaderObject(width float32) (fyne.CanvasObject, ListSectionID) {
+	s := l.sections
+	if s == nil || s.Count == nil || s.CreateHeader == nil {
+		return nil, -1
+	}
+	count := s.Count()
+	if count == 0 {
+		return nil, -1
+	}
+
+	separatorThickness := l.Theme().Size(theme.SizeNamePadding)
+	length := l.flatLength()
+	section := ListSectionID(0)
+	nextHeaderY := float32(-1)
+	rowY := float32(0)
+	for row := 0; row < length; row++ {
+		if header, sec, _ := l.rowInfo(row); header {
+			if rowY > l.offsetY {
+				nextHeaderY = rowY
+				break
+			}
+			section = sec
+		}
+		rowY += l.rowHeight(row) + separatorThickness
+	}
+
+	if l.stickyHeader == nil {
+		th := l.Theme()
+		v := fyne.CurrentApp().Settings().ThemeVariant()
+		l.stickyBG = canvas.NewRectangle(th.Color(theme.ColorNameBackground, v))
+		l.stickyChild = createItemAndApplyThemeScope(s.CreateHeader, l)
+		l.stickyHeader = &fyne.Container{Objects: []fyne.CanvasObject{l.stickyBG, l.stickyChild}}
+		l.stickySection = -1
+	}
+
+	headerHeight := l.rowHeight(l.sectionFirstRow(section))
+	y := l.offsetY
+	if nextHeaderY >= 0 && nextHeaderY-headerHeight < y {
+		y = nextHeaderY - headerHeight // pushed out by the next section's header
+	}
+
+	size := fyne.NewSize(width, headerHeight)
+	l.stickyHeader.Resize(size)
+	l.stickyHeader.Move(fyne.NewPos(0, y))
+	l.stickyBG.Resize(size)
+	l.stickyChild.Resize(size)
+	l.stickyHeader.Show()
+	return l.stickyHeader, section
+}
+
+// refreshStickyHeader updates the pinned header content once the section at the
+// top of the view changes.
+func (l *List) refreshStickyHeader(section ListSectionID) {
+	if section < 0 || l.stickyChild == nil || section == l.stickySection {
+		return
+	}
+	l.stickySection = section
+	if f := l.sections.UpdateHeader; f != nil {
+		f(section, l.stickyChild)
+	}
+	l.stickyHeader.Refresh()
+}
+
+// getHeader returns a pooled or newly created row holding the header template.
+func (l *listLayout) getHeader() *listItem {
+	item := l.headerPool.Get()
+	if item == nil {
+		s := l.list.sections
+		if s == nil || s.CreateHeader == nil {
+			return l.getItem()
+		}
+		header := createItemAndApplyThemeScope(s.CreateHeader, l.list)
+		li := newListItem(header, nil)
+		li.header = true
+		item = li
+	}
+	li := item.(*listItem)
+	li.list = l.list
+	return li
+}
+
+// recycleItem returns a row to the pool matching its template.
+func (l *listLayout) recycleItem(li *listItem) {
+	if li.header {
+		l.headerPool.Put(li)
+		return
+	}
+	l.itemPool.Put(li)
+}
+
+// layoutIndexBar shows the section index bar over the trailing edge when a
+// sectioned list provides titles.
+func (l *listRenderer) layoutIndexBar(size fyne.Size) {
+	s := l.list.sections
+	if s == nil || s.Count == nil || s.Title == nil {
+		if l.list.indexBar != nil {
+			l.list.indexBar.Hide()
+		}
+		return
+	}
+
+	if l.list.indexBar == nil {
+		l.list.indexBar = newListIndexBar(l.list)
+		l.SetObjects(append(l.Objects(), l.list.indexBar))
+	}
+	bar := l.list.indexBar
+	bar.Show()
+	width := bar.MinSize().Width
+	bar.Resize(fyne.NewSize(width, size.Height))
+	bar.Move(fyne.NewPos(size.Width-width, 0))
+}
+
+// listIndexBar is the strip of section titles over the trailing edge of a
+// sectioned list, jumping to a section when tapped or dragged across.
+type listIndexBar struct {
+	BaseWidget
+
+	list *List
+}
+
+func newListIndexBar(list *List) *listIndexBar {
+	b := &listIndexBar{list: list}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (b *listIndexBar) CreateRenderer() fyne.WidgetRenderer {
+	r := &listIndexBarRenderer{bar: b}
+	r.rebuild()
+	return r
+}
+
+// Tapped jumps to the section under the pointer.
+//
+// Implements: fyne.Tappable
+func (b *listIndexBar) Tapped(e *fyne.PointEvent) {
+	b.jumpTo(e.Position.Y)
+}
+
+// Dragged follows the pointer down the index, scrubbing through the sections.
+//
+// Implements: fyne.Draggable
+func (b *listIndexBar) Dragged(e *fyne.DragEvent) {
+	b.jumpTo(e.Position.Y)
+}
+
+// DragEnd is called when the index scrub ends.
+//
+// Implements: fyne.Draggable
+func (b *listIndexBar) DragEnd() {
+}
+
+func (b *listIndexBar) jumpTo(y float32) {
+	s := b.list.sections
+	if s == nil || s.Count == nil {
+		return
+	}
+	count := s.Count()
+	height := b.Size().Height
+	if count == 0 || height <= 0 {
+		return
+	}
+	b.list.ScrollToSection(int(y / height * float32(count))) // clamped there
+}
+
+type listIndexBarRenderer struct {
+	bar    *listIndexBar
+	labels []*canvas.Text
+}
+
+func (r *listIndexBarRenderer) Destroy() {
+}
+
+func (r *listIndexBarRenderer) Layout(size fyne.Size) {
+	count := len(r.labels)
+	if count == 0 {
+		return
+	}
+	step := size.Height / float32(count)
+	for i, label := range r.labels {
+		min := label.MinSize()
+		label.Move(fyne.NewPos((size.Width-min.Width)/2, float32(i)*step+(step-min.Height)/2))
+	}
+}
+
+func (r *listIndexBarRenderer) MinSize() fyne.Size {
+	pad := r.bar.Theme().Size(theme.SizeNameInnerPadding)
+	size := fyne.NewSize(0, 0)
+	for _, label := range r.labels {
+		min := label.MinSize()
+		size.Width = fyne.Max(size.Width, min.Width)
+		size.Height += min.Height
+	}
+	return fyne.NewSize(size.Width+pad*2, size.Height)
+}
+
+func (r *listIndexBarRenderer) Objects() []fyne.CanvasObject {
+	objects := make([]fyne.CanvasObject, len(r.labels))
+	for i, label := range r.labels {
+		objects[i] = label
+	}
+	return objects
+}
+
+func (r *listIndexBarRenderer) Refresh() {
+	r.rebuild()
+	r.Layout(r.bar.Size())
+	canvas.Refresh(r.bar)
+}
+
+func (r *listIndexBarRenderer) rebuild() {
+	th := r.bar.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	count := 0
+	s := r.bar.list.sections
+	if s != nil && s.Count != nil && s.Title != nil {
+		count = s.Count()
+	}
+	r.labels = r.labels[:0]
+	for sec := 0; sec < count; sec++ {
+		label := canvas.NewText(s.Title(sec), th.Color(theme.ColorNamePrimary, v))
+		label.TextSize = th.Size(theme.SizeNameCaptionText)
+		r.labels = append(r.labels, label)
+	}
+}
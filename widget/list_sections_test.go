@@ -0,0 +1,101 @@
+package widget
+
+import (
+	"fmt"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sectionedTestList() *List {
+	lengths := []int{2, 3, 1}
+	l := NewList(nil,
+		func() fyne.CanvasObject { return NewLabel("item template") },
+		nil)
+	l.SetSections(&ListSections{
+		Count:        func() int { return len(lengths) },
+		Length:       func(section ListSectionID) int { return lengths[section] },
+		CreateHeader: func() fyne.CanvasObject { return NewLabel("header template") },
+		UpdateHeader: func(section ListSectionID, header fyne.CanvasObject) {
+			header.(*Label).SetText(fmt.Sprintf("Section %d", section))
+		},
+		UpdateItem: func(section ListSectionID, item int, o fyne.CanvasObject) {
+			o.(*Label).SetText(fmt.Sprintf("%d.%d", section, item))
+		},
+		Title: func(section ListSectionID) string { return fmt.Sprintf("%d", section) },
+	})
+	return l
+}
+
+func TestList_Sections(t *testing.T) {
+	l := sectionedTestList()
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(200, 400))
+
+	assert.Equal(t, 9, l.Length(), "one header row per section plus the items")
+
+	header := visibleListItem(l, 0)
+	assert.True(t, header.header)
+	assert.Equal(t, "Section 0", header.child.(*Label).Text)
+
+	item := visibleListItem(l, 1)
+	assert.False(t, item.header)
+	assert.Equal(t, "0.0", item.child.(*Label).Text)
+
+	assert.Equal(t, "Section 1", visibleListItem(l, 3).child.(*Label).Text)
+	assert.Equal(t, "1.2", visibleListItem(l, 6).child.(*Label).Text)
+}
+
+func TestList_Sections_StickyHeader(t *testing.T) {
+	l := sectionedTestList()
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(200, 100))
+
+	assert.Equal(t, ListSectionID(0), l.stickySection)
+	assert.Equal(t, "Section 0", l.stickyChild.(*Label).Text)
+	assert.Equal(t, l.offsetY, l.stickyHeader.Position().Y)
+
+	l.ScrollToSection(1)
+	assert.Equal(t, ListSectionID(1), l.stickySection)
+	assert.Equal(t, "Section 1", l.stickyChild.(*Label).Text)
+	assert.Equal(t, l.offsetY, l.stickyHeader.Position().Y)
+}
+
+func TestList_ScrollToSection(t *testing.T) {
+	l := sectionedTestList()
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(200, 100))
+
+	l.ScrollToSection(1)
+	assert.Equal(t, l.rowOffset(l.sectionFirstRow(1)), l.offsetY)
+
+	l.ScrollToSection(0)
+	assert.Equal(t, float32(0), l.offsetY)
+}
+
+func TestList_Sections_IndexBar(t *testing.T) {
+	l := sectionedTestList()
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(200, 100))
+
+	bar := l.indexBar
+	assert.NotNil(t, bar)
+	assert.True(t, bar.Visible())
+
+	// jumping to the last section scrolls as far as the content allows
+	bar.Tapped(&fyne.PointEvent{Position: fyne.NewPos(2, bar.Size().Height-1)})
+	assert.InDelta(t, l.contentMinSize().Height-l.scroller.Size().Height, l.offsetY, 0.01)
+}
+
+func TestList_SetSections_Reset(t *testing.T) {
+	l := sectionedTestList()
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(200, 100))
+
+	l.SetSections(nil)
+	assert.Nil(t, l.Length, "the original flat callbacks are restored")
+	assert.False(t, l.stickyHeader.Visible())
+}
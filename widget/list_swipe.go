@@ -0,0 +1,193 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// SwipeAction describes one action revealed when a list row is swiped aside,
+// shown as a button with the set icon, or text when no icon is given.
+//
+// Since: 2.6
+type SwipeAction struct {
+	Icon  fyne.Resource
+	Label string
+
+	// OnActivated is called with the id of the row the action was triggered on.
+	OnActivated func(id ListItemID) `json:"-"`
+}
+
+// swipeThresholdFraction is how far across the row a swipe must travel to trigger
+// the first action directly, without tapping its button.
+const swipeThresholdFraction = 0.6
+
+// beginSwipe prepares the action buttons for the side being revealed, +1 when the
+// row is pushed towards the trailing edge, uncovering the leading actions.
+func (li *listItem) beginSwipe(side int) {
+	li.swipeSide = side
+	li.swipeOffset = 0
+
+	actions := li.swipeSideActions(side)
+	li.swipeActions.Objects = nil
+	for _, action := range actions {
+		a := action // capture
+		var button *Button
+		if a.Icon != nil {
+			button = NewButtonWithIcon("", a.Icon, nil)
+		} else {
+			button = NewButton(a.Label, nil)
+		}
+		button.OnTapped = func() {
+			li.closeSwipe()
+			if a.OnActivated != nil {
+				a.OnActivated(li.id)
+			}
+		}
+		li.swipeActions.Objects = append(li.swipeActions.Objects, button)
+	}
+
+	li.layoutSwipeActions()
+	li.swipeActions.Show()
+}
+
+// closeSwipe slides the row content back over the actions.
+func (li *listItem) closeSwipe() {
+	li.swiping = false
+	li.swipeOpen = false
+	li.swipeOffset = 0
+
+	if fyne.CurrentApp().Settings().ShowAnimations() {
+		canvas.NewPositionAnimation(li.child.Position(), fyne.NewPos(0, 0), canvas.DurationShort, li.child.Move).Start()
+	} else {
+		li.child.Move(fyne.NewPos(0, 0))
+	}
+	li.swipeActions.Hide()
+	li.Refresh()
+}
+
+// hasSwipeActions returns whether a drag starting with the passed delta should
+// reveal swipe actions rather than reorder the row.
+func (li *listItem) hasSwipeActions(delta fyne.Delta) bool {
+	if li.list == nil {
+		return false
+	}
+	horizontal := delta.DX > delta.DY
+	if delta.DX < 0 {
+		horizontal = -delta.DX > delta.DY
+	}
+	if delta.DY < 0 {
+		horizontal = delta.DX > -delta.DY
+		if delta.DX < 0 {
+			horizontal = -delta.DX > -delta.DY
+		}
+	}
+	if !horizontal {
+		return false
+	}
+
+	side := 1
+	if delta.DX < 0 {
+		side = -1
+	}
+	return len(li.swipeSideActions(side)) > 0
+}
+
+// layoutSwipeActions positions the action buttons against the edge being uncovered.
+func (li *listItem) layoutSwipeActions() {
+	size := li.Size()
+	x := float32(0)
+	if li.swipeSide < 0 {
+		x = size.Width - li.swipeActionsWidth()
+	}
+	for _, o := range li.swipeActions.Objects {
+		width := o.MinSize().Width
+		o.Resize(fyne.NewSize(width, size.Height))
+		o.Move(fyne.NewPos(x, 0))
+		x += width
+	}
+}
+
+func (li *listItem) swipeActionsWidth() float32 {
+	width := float32(0)
+	for _, o := range li.swipeActions.Objects {
+		width += o.MinSize().Width
+	}
+	return width
+}
+
+// swipeDragged follows the pointer, sliding the row content aside to uncover the
+// action buttons.
+func (li *listItem) swipeDragged(e *fyne.DragEvent) {
+	if !li.swiping {
+		side := 1
+		if e.Dragged.DX < 0 {
+			side = -1
+		}
+		li.swiping = true
+		li.beginSwipe(side)
+	}
+
+	li.swipeOffset += e.Dragged.DX
+	max := li.Size().Width
+	if li.swipeSide > 0 {
+		if li.swipeOffset < 0 {
+			li.swipeOffset = 0
+		} else if li.swipeOffset > max {
+			li.swipeOffset = max
+		}
+	} else {
+		if li.swipeOffset > 0 {
+			li.swipeOffset = 0
+		} else if li.swipeOffset < -max {
+			li.swipeOffset = -max
+		}
+	}
+
+	li.child.Move(fyne.NewPos(li.swipeOffset, 0))
+}
+
+// swipeEnd triggers the first action when dragged past the threshold, snaps the
+// actions open when revealed far enough, and closes again otherwise.
+func (li *listItem) swipeEnd() {
+	offset := li.swipeOffset
+	if offset < 0 {
+		offset = -offset
+	}
+
+	if offset >= li.Size().Width*swipeThresholdFraction {
+		actions := li.swipeSideActions(li.swipeSide)
+		li.closeSwipe()
+		if len(actions) > 0 && actions[0].OnActivated != nil {
+			actions[0].OnActivated(li.id)
+		}
+		return
+	}
+
+	actionsWidth := li.swipeActionsWidth()
+	if offset >= actionsWidth/2 {
+		li.swipeOpen = true
+		target := actionsWidth
+		if li.swipeSide < 0 {
+			target = -actionsWidth
+		}
+		li.swipeOffset = target
+		if fyne.CurrentApp().Settings().ShowAnimations() {
+			canvas.NewPositionAnimation(li.child.Position(), fyne.NewPos(target, 0), canvas.DurationShort, li.child.Move).Start()
+		} else {
+			li.child.Move(fyne.NewPos(target, 0))
+		}
+		return
+	}
+
+	li.closeSwipe()
+}
+
+func (li *listItem) swipeSideActions(side int) []SwipeAction {
+	if li.list == nil {
+		return nil
+	}
+	if side > 0 {
+		return li.list.LeadingSwipeActions
+	}
+	return li.list.TrailingSwipeActions
+}
@@ -0,0 +1,96 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func swipeTestList(deleted *ListItemID) *List {
+	l := NewList(
+		func() int { return 3 },
+		func() fyne.CanvasObject { return NewLabel("template") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText("row")
+		})
+	l.TrailingSwipeActions = []SwipeAction{
+		{Icon: theme.DeleteIcon(), OnActivated: func(id ListItemID) { *deleted = id }},
+	}
+	return l
+}
+
+func TestList_SwipeReveal(t *testing.T) {
+	deleted := ListItemID(-1)
+	l := swipeTestList(&deleted)
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(200, 200))
+
+	item := visibleListItem(l, 1)
+	item.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(150, 10)},
+		Dragged:    fyne.NewDelta(-50, 0),
+	})
+	assert.True(t, item.swiping)
+	assert.True(t, item.swipeActions.Visible())
+	assert.Negative(t, item.child.Position().X)
+
+	item.DragEnd()
+	assert.True(t, item.swipeOpen, "revealed past half the actions so it snaps open")
+	assert.Equal(t, ListItemID(-1), deleted)
+
+	// tapping the revealed button activates the action for this row
+	test.Tap(item.swipeActions.Objects[0].(*Button))
+	assert.Equal(t, 1, deleted)
+	assert.False(t, item.swipeOpen)
+}
+
+func TestList_SwipeThreshold(t *testing.T) {
+	deleted := ListItemID(-1)
+	l := swipeTestList(&deleted)
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(200, 200))
+
+	item := visibleListItem(l, 0)
+	item.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(30, 10)},
+		Dragged:    fyne.NewDelta(-170, 0),
+	})
+	item.DragEnd()
+	assert.Equal(t, 0, deleted, "swiping past the threshold triggers the action")
+	assert.False(t, item.swipeOpen)
+}
+
+func TestList_SwipeShortClose(t *testing.T) {
+	deleted := ListItemID(-1)
+	l := swipeTestList(&deleted)
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(200, 200))
+
+	item := visibleListItem(l, 0)
+	item.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(190, 10)},
+		Dragged:    fyne.NewDelta(-5, 0),
+	})
+	item.DragEnd()
+	assert.False(t, item.swipeOpen)
+	assert.Equal(t, ListItemID(-1), deleted)
+	assert.False(t, item.swipeActions.Visible())
+}
+
+func TestList_SwipeNoLeadingActions(t *testing.T) {
+	deleted := ListItemID(-1)
+	l := swipeTestList(&deleted)
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(200, 200))
+
+	item := visibleListItem(l, 0)
+	item.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(100, 10)},
+		Dragged:    fyne.NewDelta(50, 0),
+	})
+	assert.False(t, item.swiping, "no leading actions are configured")
+}
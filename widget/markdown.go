@@ -2,8 +2,10 @@ package widget
 
 import (
 	"fmt"
+	"image/color"
 	"io"
 	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/yuin/goldmark"
@@ -13,7 +15,10 @@ import (
 	"github.com/yuin/goldmark/renderer"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/cache"
 	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/theme"
 )
 
 // NewRichTextFromMarkdown configures a RichText widget by parsing the provided markdown content.
@@ -154,9 +159,10 @@ func renderNode(source []byte, n ast.Node, blockquote bool) ([]RichTextSegment,
 			if !ok {
 				return nil, fmt.Errorf("Unable to cast element %d to TableCell", i)
 			}
+			cell.setHeader()
 			cells[i] = cell
 		}
-		return []RichTextSegment{&TableRow{cells: cells}}, nil
+		return []RichTextSegment{&TableRow{cells: cells, header: true}}, nil
 	case *east.TableRow:
 		segs, err := renderChildren(source, n, blockquote)
 		if err != nil {
@@ -259,17 +265,32 @@ type (
 		BaseWidget
 		DummyRichTextSegment
 		content  *RichText
-		renderer cellRenderer
+		header   bool
+		renderer *tableCellRenderer
 	}
 	TableRow struct {
 		DummyRichTextSegment
-		cells []*TableCell
+		cells  []*TableCell
+		header bool
 	}
 	TableSegment struct {
 		Table
 		DummyRichTextSegment
-		rows []*TableRow
-		size fyne.Size
+
+		// Sortable enables reordering the body rows by tapping a header cell.
+		// Tapping the same header again reverses the sort order.
+		Sortable bool
+		// SortComparator optionally overrides the string comparison used when sorting,
+		// reporting whether the cell text a should order before b in the given column.
+		SortComparator func(col int, a, b string) bool `json:"-"`
+
+		rows      []*TableRow
+		size      fyne.Size
+		maxWidths map[int]float32
+		sortCol   int
+		sortAsc   bool
+		sortMark  *TextSegment
+		sortHost  *TableCell
 	}
 )
 
@@ -289,16 +310,28 @@ func (c *TableCell) CreateRenderer() fyne.WidgetRenderer {
 
 func NewTableCell(content *RichText) *TableCell {
 	cell := &TableCell{
-		content:  content,
-		renderer: NewCellRenderer(content),
+		content: content,
 	}
+	cell.renderer = newTableCellRenderer(cell)
 	cell.ExtendBaseWidget(cell)
 	return cell
 }
 
-func (c *TableCell) updateSegment(content *RichText) {
-	c.content = content
-	c.renderer.setObject(c.content)
+// setHeader marks this cell as part of the table header row, making its text bold.
+func (c *TableCell) setHeader() {
+	c.header = true
+	for _, seg := range c.content.Segments {
+		if text, ok := seg.(*TextSegment); ok {
+			text.Style.TextStyle.Bold = true
+		}
+	}
+}
+
+func (c *TableCell) updateFrom(src *TableCell) {
+	c.content = src.content
+	c.header = src.header
+	c.renderer.setContent(c.content)
+	c.Refresh()
 }
 
 func NewTableSegment(rows []*TableRow) *TableSegment {
@@ -316,7 +349,7 @@ func NewTableSegment(rows []*TableRow) *TableSegment {
 			return
 		}
 		cell := o.(*TableCell)
-		cell.updateSegment(rows[pos.Row].cells[pos.Col].content)
+		cell.updateFrom(rows[pos.Row].cells[pos.Col])
 	}
 	table := &TableSegment{
 		Table: Table{
@@ -324,33 +357,136 @@ func NewTableSegment(rows []*TableRow) *TableSegment {
 			CreateCell: create,
 			UpdateCell: update,
 		},
-		rows: rows,
+		rows:    rows,
+		sortCol: -1,
+	}
+	table.OnSelected = func(pos TableCellID) {
+		if !table.Sortable || pos.Row != 0 || len(table.rows) == 0 || !table.rows[0].header {
+			return
+		}
+		table.UnselectAll()
+		table.sortByColumn(pos.Col)
 	}
 	table.ExtendBaseWidget(table)
 	table.resize()
 	return table
 }
 
+// sortByColumn orders the body rows of the table by the given column, toggling
+// between ascending and descending when the same column is sorted again.
+func (l *TableSegment) sortByColumn(col int) {
+	if col < 0 || col >= len(l.rows[0].cells) {
+		return
+	}
+	if l.sortCol == col {
+		l.sortAsc = !l.sortAsc
+	} else {
+		l.sortCol = col
+		l.sortAsc = true
+	}
+
+	body := l.rows
+	if l.rows[0].header {
+		body = l.rows[1:]
+	}
+	less := l.SortComparator
+	if less == nil {
+		less = func(_ int, a, b string) bool { return a < b }
+	}
+	sort.SliceStable(body, func(i, j int) bool {
+		a, b := tableCellText(body[i], col), tableCellText(body[j], col)
+		if l.sortAsc {
+			return less(col, a, b)
+		}
+		return less(col, b, a)
+	})
+
+	l.updateSortIndicator()
+	l.resize()
+	l.Refresh()
+}
+
+// updateSortIndicator moves the arrow marking the sorted column into the current header cell.
+func (l *TableSegment) updateSortIndicator() {
+	if l.sortHost != nil && l.sortMark != nil {
+		segs := l.sortHost.content.Segments
+		for i, seg := range segs {
+			if seg == l.sortMark {
+				l.sortHost.content.Segments = append(segs[:i], segs[i+1:]...)
+				break
+			}
+		}
+	}
+
+	arrow := " ▲"
+	if !l.sortAsc {
+		arrow = " ▼"
+	}
+	l.sortMark = &TextSegment{Style: RichTextStyleStrong, Text: arrow}
+	l.sortHost = l.rows[0].cells[l.sortCol]
+	l.sortHost.content.Segments = append(l.sortHost.content.Segments, l.sortMark)
+}
+
+// tableCellText returns the plain text of the cell in the given column of a row.
+func tableCellText(row *TableRow, col int) string {
+	if col >= len(row.cells) {
+		return ""
+	}
+	var text strings.Builder
+	for _, seg := range row.cells[col].content.Segments {
+		switch t := seg.(type) {
+		case *TextSegment:
+			text.WriteString(t.Text)
+		case *HyperlinkSegment:
+			text.WriteString(t.Text)
+		}
+	}
+	return strings.TrimSpace(text.String())
+}
+
+// SetColumnMaxWidth constrains the width of the given column, wrapping the text of
+// any cell that would be wider and growing row heights to fit the wrapped content.
+func (l *TableSegment) SetColumnMaxWidth(col int, width float32) {
+	if l.maxWidths == nil {
+		l.maxWidths = make(map[int]float32)
+	}
+	l.maxWidths[col] = width
+	l.resize()
+	l.Refresh()
+}
+
 func (l *TableSegment) resize() {
-	// Compute the size of the columns and rows
+	// Compute the size of the columns, capped to any configured maximum
 	widths := []float32{}
+	for _, row := range l.rows {
+		for j, cell := range row.cells {
+			width := cell.content.MinSize().Width
+			if len(widths) < j+1 {
+				widths = append(widths, width)
+			} else if widths[j] < width {
+				widths[j] = width
+			}
+		}
+	}
+	for j, width := range widths {
+		if max, ok := l.maxWidths[j]; ok && width > max {
+			widths[j] = max
+		}
+	}
+
+	// Compute row heights, wrapping cells in capped columns
 	heights := []float32{}
 	for i, row := range l.rows {
 		for j, cell := range row.cells {
-			width := cell.content.MinSize().Width
-			height := cell.content.MinSize().Height
+			height := l.cellHeight(cell, j, widths[j])
 			if len(heights) < i+1 {
 				heights = append(heights, height)
 			} else if heights[i] < height {
 				heights[i] = height
 			}
-			if len(widths) < j+1 {
-				widths = append(widths, width)
-			} else if widths[j] < width {
-				widths[j] = width
-			}
 		}
 	}
+
 	l.size.Height = 0
 	for i, height := range heights {
 		l.SetRowHeight(i, height)
@@ -363,6 +499,18 @@ func (l *TableSegment) resize() {
 	}
 }
 
+// cellHeight measures the height the given cell requires at the chosen column width,
+// enabling word wrapping on its content if the column is narrower than its natural size.
+func (l *TableSegment) cellHeight(cell *TableCell, col int, width float32) float32 {
+	min := cell.content.MinSize()
+	if min.Width <= width {
+		return min.Height
+	}
+	cell.content.Wrapping = fyne.TextWrapWord
+	cell.content.Resize(fyne.NewSize(width, min.Height))
+	return cache.Renderer(cell.content).MinSize().Height
+}
+
 func (l *TableSegment) Unselect()                       { panic("not implemented") }
 func (l *TableSegment) Select(pos1, pos2 fyne.Position) { panic("not implemented") }
 func (l *TableSegment) SelectedText() string            { panic("not implemented") }
@@ -380,37 +528,63 @@ func (l *TableSegment) Visual() fyne.CanvasObject {
 // Update applies the current state of this table segment to an existing visual.
 func (l *TableSegment) Update(o fyne.CanvasObject) {}
 
-// cellRenderer implements fyne.WidgetRenderer. It contains exactly one canvas object.
-type cellRenderer []fyne.CanvasObject
+// tableCellRenderer implements fyne.WidgetRenderer for a TableCell,
+// drawing a themed background and a divider underneath header cells.
+type tableCellRenderer struct {
+	cell       *TableCell
+	background *canvas.Rectangle
+	divider    *canvas.Rectangle
+	content    fyne.CanvasObject
+}
 
-func NewCellRenderer(object fyne.CanvasObject) cellRenderer {
-	return cellRenderer([]fyne.CanvasObject{object})
+func newTableCellRenderer(cell *TableCell) *tableCellRenderer {
+	return &tableCellRenderer{
+		cell:       cell,
+		background: canvas.NewRectangle(color.Transparent),
+		divider:    canvas.NewRectangle(color.Transparent),
+		content:    cell.content,
+	}
 }
 
-func (r cellRenderer) setObject(object fyne.CanvasObject) {
-	r[0] = object
+func (r *tableCellRenderer) setContent(object fyne.CanvasObject) {
+	r.content = object
 }
 
 // Destroy does nothing in this implementation.
-func (r cellRenderer) Destroy() {
+func (r *tableCellRenderer) Destroy() {
 }
 
-// Layout updates the contained object to be the requested size.
-func (r cellRenderer) Layout(s fyne.Size) {
-	r[0].Resize(s)
+// Layout updates the contained objects to be the requested size.
+func (r *tableCellRenderer) Layout(s fyne.Size) {
+	r.background.Resize(s)
+	thickness := r.cell.Theme().Size(theme.SizeNameSeparatorThickness)
+	r.divider.Move(fyne.NewPos(0, s.Height-thickness))
+	r.divider.Resize(fyne.NewSize(s.Width, thickness))
+	r.content.Resize(s)
 }
 
-// MinSize returns the smallest size that this render can use, returned from the underlying object.
-func (r cellRenderer) MinSize() fyne.Size {
-	return r[0].MinSize()
+// MinSize returns the smallest size that this render can use, returned from the underlying content.
+func (r *tableCellRenderer) MinSize() fyne.Size {
+	return r.content.MinSize()
 }
 
 // Objects returns the objects that should be rendered.
-func (r cellRenderer) Objects() []fyne.CanvasObject {
-	return r
+func (r *tableCellRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.background, r.content, r.divider}
 }
 
-// Refresh requests the underlying object to redraw.
-func (r cellRenderer) Refresh() {
-	r[0].Refresh()
+// Refresh requests the underlying objects to redraw, applying header styling where needed.
+func (r *tableCellRenderer) Refresh() {
+	if r.cell.header {
+		th := r.cell.Theme()
+		v := fyne.CurrentApp().Settings().ThemeVariant()
+		r.background.FillColor = th.Color(theme.ColorNameHeaderBackground, v)
+		r.divider.FillColor = th.Color(theme.ColorNameSeparator, v)
+	} else {
+		r.background.FillColor = color.Transparent
+		r.divider.FillColor = color.Transparent
+	}
+	r.background.Refresh()
+	r.divider.Refresh()
+	r.content.Refresh()
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/storage"
 )
 
@@ -263,6 +264,76 @@ func TestRichTextMarkdown_NewlineInHyperlink(t *testing.T) {
 	assert.Equal(t, "foo bar", r.String())
 }
 
+func TestRichTextMarkdown_TableHeader(t *testing.T) {
+	r := NewRichTextFromMarkdown("| a | b |\n| --- | --- |\n| 1 | 2 |\n")
+
+	assert.Equal(t, 1, len(r.Segments))
+	table, ok := r.Segments[0].(*TableSegment)
+	if !ok {
+		t.Fatal("Segment should be a TableSegment")
+	}
+
+	assert.Equal(t, 2, len(table.rows))
+	assert.True(t, table.rows[0].header)
+	assert.False(t, table.rows[1].header)
+	for _, cell := range table.rows[0].cells {
+		assert.True(t, cell.header)
+		if text, ok := cell.content.Segments[0].(*TextSegment); ok {
+			assert.True(t, text.Style.TextStyle.Bold)
+		} else {
+			t.Error("Cell segment should be Text")
+		}
+	}
+	for _, cell := range table.rows[1].cells {
+		assert.False(t, cell.header)
+	}
+}
+
+func TestRichTextMarkdown_TableColumnMaxWidth(t *testing.T) {
+	r := NewRichTextFromMarkdown("| a | b |\n| --- | --- |\n| some quite long cell content that needs wrapping | 2 |\n")
+
+	table, ok := r.Segments[0].(*TableSegment)
+	if !ok {
+		t.Fatal("Segment should be a TableSegment")
+	}
+	wide := table.MinSize()
+
+	table.SetColumnMaxWidth(0, 100)
+
+	narrow := table.MinSize()
+	assert.Less(t, narrow.Width, wide.Width)
+	assert.Greater(t, narrow.Height, wide.Height)
+	assert.Equal(t, fyne.TextWrapWord, table.rows[1].cells[0].content.Wrapping)
+}
+
+func TestRichTextMarkdown_TableSort(t *testing.T) {
+	r := NewRichTextFromMarkdown("| name | count |\n| --- | --- |\n| beta | 2 |\n| alpha | 3 |\n| gamma | 1 |\n")
+
+	table, ok := r.Segments[0].(*TableSegment)
+	if !ok {
+		t.Fatal("Segment should be a TableSegment")
+	}
+	table.Sortable = true
+
+	table.sortByColumn(0)
+	assert.Equal(t, "alpha", tableCellText(table.rows[1], 0))
+	assert.Equal(t, "beta", tableCellText(table.rows[2], 0))
+	assert.Equal(t, "gamma", tableCellText(table.rows[3], 0))
+	assert.Contains(t, tableCellText(table.rows[0], 0), "▲")
+
+	table.sortByColumn(0) // again reverses the order
+	assert.Equal(t, "gamma", tableCellText(table.rows[1], 0))
+	assert.Contains(t, tableCellText(table.rows[0], 0), "▼")
+
+	table.SortComparator = func(_ int, a, b string) bool { return a < b }
+	table.sortByColumn(1)
+	assert.Equal(t, "gamma", tableCellText(table.rows[1], 0))
+	assert.Equal(t, "beta", tableCellText(table.rows[2], 0))
+	assert.Equal(t, "alpha", tableCellText(table.rows[3], 0))
+	assert.NotContains(t, tableCellText(table.rows[0], 0), "▼")
+	assert.Contains(t, tableCellText(table.rows[0], 1), "▲")
+}
+
 func BenchmarkMarkdownParsing(b *testing.B) {
 	md := `# Test heading
 This is some test markdown. It contains some different markdown
@@ -0,0 +1,356 @@
+package widget
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/lang"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Declare conformity with interfaces
+var _ fyne.Scrollable = (*NumericEntry)(nil)
+var _ fyne.Widget = (*NumericEntry)(nil)
+
+var errOutOfRange = errors.New("value out of range")
+
+// NumericEntry is an Entry that accepts a number within a range, with step buttons
+// at its trailing edge. The value can also be stepped with the Up and Down keys or
+// the mouse wheel. The decimal separator of the system locale is accepted alongside
+// the point when typing.
+//
+// Since: 2.6
+type NumericEntry struct {
+	Entry
+
+	// Min and Max bound the accepted value and Step is how far the step buttons,
+	// arrow keys and mouse wheel move it.
+	Min, Max, Step float64
+
+	// Integer restricts the entry to whole numbers. NewNumericEntry sets it when
+	// min, max and step are all whole.
+	Integer bool
+
+	valueBinder basicBinder
+}
+
+// NewNumericEntry creates an entry accepting numbers between min and max, stepped by
+// the passed amount. Whole number arguments give an integer entry, any fractional
+// argument a float one.
+//
+// Since: 2.6
+func NewNumericEntry(min, max, step float64) *NumericEntry {
+	e := &NumericEntry{Min: min, Max: max, Step: step}
+	e.Integer = isWholeNumber(min) && isWholeNumber(max) && isWholeNumber(step)
+	e.Wrapping = fyne.TextWrap(fyne.TextTruncateClip)
+	e.ExtendBaseWidget(e)
+	e.ActionItem = newNumericStepper(e)
+	e.Validator = func(s string) error {
+		_, err := e.parseValue(s)
+		return err
+	}
+	return e
+}
+
+// Bind connects the specified data source to this entry. The current value will be
+// displayed and any changes in the data will cause the widget to update.
+//
+// Since: 2.6
+func (n *NumericEntry) Bind(data binding.Float) {
+	n.bindValue(data)
+}
+
+// BindInt connects the specified integer data source to this entry.
+//
+// Since: 2.6
+func (n *NumericEntry) BindInt(data binding.Int) {
+	n.bindValue(data)
+}
+
+// Scrolled steps the value when the mouse wheel moves over this entry.
+//
+// Implements: fyne.Scrollable
+func (n *NumericEntry) Scrolled(ev *fyne.ScrollEvent) {
+	if n.Disabled() {
+		return
+	}
+	if ev.Scrolled.DY > 0 {
+		n.stepBy(1)
+	} else if ev.Scrolled.DY < 0 {
+		n.stepBy(-1)
+	}
+}
+
+// SetValue updates the number shown in this entry, clamped to the Min and Max range.
+//
+// Since: 2.6
+func (n *NumericEntry) SetValue(val float64) {
+	n.SetText(n.formatValue(n.clamp(val)))
+}
+
+// TypedKey steps the value on Up and Down, passing other keys to the Entry.
+//
+// Implements: fyne.Focusable
+func (n *NumericEntry) TypedKey(key *fyne.KeyEvent) {
+	switch key.Name {
+	case fyne.KeyUp:
+		n.stepBy(1)
+	case fyne.KeyDown:
+		n.stepBy(-1)
+	default:
+		n.Entry.TypedKey(key)
+	}
+}
+
+// TypedRune rejects any rune that could not form a number in this entry's range.
+//
+// Implements: fyne.Focusable
+func (n *NumericEntry) TypedRune(r rune) {
+	if r >= '0' && r <= '9' {
+		n.Entry.TypedRune(r)
+		return
+	}
+
+	switch r {
+	case '-':
+		if n.Min < 0 && n.CursorColumn == 0 && !strings.Contains(n.Text, "-") {
+			n.Entry.TypedRune(r)
+		}
+	case '.', ',':
+		if !n.Integer && !strings.ContainsAny(n.Text, ".,") {
+			n.Entry.TypedRune(decimalSeparator())
+		}
+	}
+}
+
+// Unbind disconnects any configured data source from this entry.
+//
+// Since: 2.6
+func (n *NumericEntry) Unbind() {
+	n.OnChanged = nil
+	n.valueBinder.Unbind()
+}
+
+// Value returns the number currently entered, clamped to the Min and Max range.
+// Text that does not parse as a number returns Min.
+//
+// Since: 2.6
+func (n *NumericEntry) Value() float64 {
+	val, err := n.parseValue(n.Text)
+	if err != nil {
+		if errors.Is(err, errOutOfRange) {
+			return n.clamp(val)
+		}
+		return n.Min
+	}
+	return val
+}
+
+func (n *NumericEntry) bindValue(data binding.DataItem) {
+	n.valueBinder.SetCallback(n.updateFromValueData)
+	n.valueBinder.Bind(data)
+
+	n.OnChanged = func(_ string) {
+		n.valueBinder.CallWithData(n.writeValueData)
+	}
+}
+
+func (n *NumericEntry) clamp(val float64) float64 {
+	if val < n.Min {
+		return n.Min
+	}
+	if val > n.Max {
+		return n.Max
+	}
+	return val
+}
+
+func (n *NumericEntry) formatValue(val float64) string {
+	if n.Integer {
+		return strconv.FormatInt(int64(val), 10)
+	}
+	s := strconv.FormatFloat(val, 'f', -1, 64)
+	if sep := decimalSeparator(); sep != '.' {
+		s = strings.Replace(s, ".", string(sep), 1)
+	}
+	return s
+}
+
+func (n *NumericEntry) parseValue(text string) (float64, error) {
+	text = strings.Replace(strings.TrimSpace(text), ",", ".", 1)
+	if text == "" {
+		return n.Min, nil // an empty entry is valid while editing
+	}
+
+	var val float64
+	var err error
+	if n.Integer {
+		var i int64
+		i, err = strconv.ParseInt(text, 10, 64)
+		val = float64(i)
+	} else {
+		val, err = strconv.ParseFloat(text, 64)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if val < n.Min || val > n.Max {
+		return val, errOutOfRange
+	}
+	return val, nil
+}
+
+func (n *NumericEntry) stepBy(direction float64) {
+	if n.Disabled() {
+		return
+	}
+	n.SetValue(n.Value() + direction*n.Step)
+}
+
+func (n *NumericEntry) updateFromValueData(data binding.DataItem) {
+	if data == nil {
+		return
+	}
+	val := 0.0
+	switch source := data.(type) {
+	case binding.Float:
+		f, err := source.Get()
+		if err != nil {
+			fyne.LogError("Error getting current data value", err)
+			return
+		}
+		val = f
+	case binding.Int:
+		i, err := source.Get()
+		if err != nil {
+			fyne.LogError("Error getting current data value", err)
+			return
+		}
+		val = float64(i)
+	default:
+		return
+	}
+	if val != n.Value() || n.Text == "" {
+		n.SetValue(val)
+	}
+}
+
+func (n *NumericEntry) writeValueData(data binding.DataItem) {
+	if data == nil {
+		return
+	}
+	val := n.Value()
+	switch target := data.(type) {
+	case binding.Float:
+		current, err := target.Get()
+		if err != nil {
+			return
+		}
+		if val != current {
+			if err = target.Set(val); err != nil {
+				fyne.LogError("Failed to set binding value", err)
+			}
+		}
+	case binding.Int:
+		current, err := target.Get()
+		if err != nil {
+			return
+		}
+		if int(val) != current {
+			if err = target.Set(int(val)); err != nil {
+				fyne.LogError("Failed to set binding value", err)
+			}
+		}
+	}
+}
+
+// decimalSeparator returns the separator the system locale writes between the whole
+// and fractional parts of a number.
+func decimalSeparator() rune {
+	switch lang.SystemLocale().LanguageString() {
+	case "bg", "cs", "da", "de", "el", "es", "fi", "fr", "hr", "hu", "id", "it",
+		"lt", "lv", "nb", "nl", "pl", "pt", "ro", "ru", "sk", "sl", "sv", "tr", "uk", "vi":
+		return ','
+	}
+	return '.'
+}
+
+func isWholeNumber(val float64) bool {
+	return val == math.Trunc(val)
+}
+
+// Declare conformity with interfaces
+var _ fyne.Tappable = (*numericStepper)(nil)
+var _ fyne.Widget = (*numericStepper)(nil)
+
+// numericStepper is the pair of step buttons shown at the trailing edge of a
+// NumericEntry, tapping the top half steps up and the bottom half down.
+type numericStepper struct {
+	BaseWidget
+
+	up, down *canvas.Image
+	entry    *NumericEntry
+}
+
+func newNumericStepper(e *NumericEntry) *numericStepper {
+	th := e.Theme()
+	s := &numericStepper{
+		up:    canvas.NewImageFromResource(th.Icon(theme.IconNameArrowDropUp)),
+		down:  canvas.NewImageFromResource(th.Icon(theme.IconNameArrowDropDown)),
+		entry: e,
+	}
+	s.up.FillMode = canvas.ImageFillContain
+	s.down.FillMode = canvas.ImageFillContain
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+func (s *numericStepper) CreateRenderer() fyne.WidgetRenderer {
+	return &numericStepperRenderer{stepper: s}
+}
+
+func (s *numericStepper) Tapped(ev *fyne.PointEvent) {
+	if ev.Position.Y < s.Size().Height/2 {
+		s.entry.stepBy(1)
+	} else {
+		s.entry.stepBy(-1)
+	}
+}
+
+var _ fyne.WidgetRenderer = (*numericStepperRenderer)(nil)
+
+type numericStepperRenderer struct {
+	stepper *numericStepper
+}
+
+func (r *numericStepperRenderer) Destroy() {
+}
+
+func (r *numericStepperRenderer) Layout(size fyne.Size) {
+	half := fyne.NewSize(size.Width, size.Height/2)
+	r.stepper.up.Resize(half)
+	r.stepper.down.Resize(half)
+	r.stepper.down.Move(fyne.NewPos(0, half.Height))
+}
+
+func (r *numericStepperRenderer) MinSize() fyne.Size {
+	iconSize := r.stepper.entry.Theme().Size(theme.SizeNameInlineIcon)
+	return fyne.NewSize(iconSize, iconSize)
+}
+
+func (r *numericStepperRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.stepper.up, r.stepper.down}
+}
+
+func (r *numericStepperRenderer) Refresh() {
+	th := r.stepper.entry.Theme()
+	r.stepper.up.Resource = th.Icon(theme.IconNameArrowDropUp)
+	r.stepper.down.Resource = th.Icon(theme.IconNameArrowDropDown)
+	r.stepper.up.Refresh()
+	r.stepper.down.Refresh()
+}
@@ -0,0 +1,88 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumericEntry_Typed(t *testing.T) {
+	entry := NewNumericEntry(0, 100, 1)
+	assert.True(t, entry.Integer)
+
+	test.Type(entry, "4x2.")
+	assert.Equal(t, "42", entry.Text)
+	assert.Equal(t, 42.0, entry.Value())
+
+	test.Type(entry, "-")
+	assert.Equal(t, "42", entry.Text, "minus not allowed when min is zero")
+}
+
+func TestNumericEntry_TypedFloat(t *testing.T) {
+	entry := NewNumericEntry(-10, 10, 0.5)
+	assert.False(t, entry.Integer)
+
+	test.Type(entry, "-1.5")
+	assert.Equal(t, "-1.5", entry.Text)
+	assert.Equal(t, -1.5, entry.Value())
+
+	test.Type(entry, ".")
+	assert.Equal(t, "-1.5", entry.Text, "only one decimal separator")
+}
+
+func TestNumericEntry_Step(t *testing.T) {
+	entry := NewNumericEntry(0, 5, 2)
+	entry.SetValue(1)
+
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	assert.Equal(t, "3", entry.Text)
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	assert.Equal(t, "5", entry.Text, "clamped to max")
+
+	entry.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, -1)})
+	assert.Equal(t, "3", entry.Text)
+
+	stepper := entry.ActionItem.(*numericStepper)
+	stepper.Resize(fyne.NewSize(20, 20))
+	stepper.Tapped(&fyne.PointEvent{Position: fyne.NewPos(10, 5)})
+	assert.Equal(t, "5", entry.Text)
+	stepper.Tapped(&fyne.PointEvent{Position: fyne.NewPos(10, 15)})
+	assert.Equal(t, "3", entry.Text)
+}
+
+func TestNumericEntry_Bind(t *testing.T) {
+	data := binding.NewFloat()
+	assert.NoError(t, data.Set(2.5))
+
+	entry := NewNumericEntry(0, 10, 0.5)
+	entry.Bind(data)
+	waitForBinding()
+	assert.Equal(t, "2.5", entry.Text)
+
+	entry.SetValue(4)
+	waitForBinding()
+	val, err := data.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, 4.0, val)
+}
+
+func TestNumericEntry_BindInt(t *testing.T) {
+	data := binding.NewInt()
+	assert.NoError(t, data.Set(3))
+
+	entry := NewNumericEntry(0, 10, 1)
+	entry.BindInt(data)
+	waitForBinding()
+	assert.Equal(t, "3", entry.Text)
+
+	entry.SetText("30") // out of range, clamped before reaching the data
+	waitForBinding()
+	val, err := data.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, 10, val)
+}
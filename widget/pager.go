@@ -0,0 +1,88 @@
+package widget
+
+import (
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Pageable describes a widget whose data is split into pages, such as a List or
+// Table after SetPageSize, so that PagerControls can navigate it.
+//
+// Since: 2.6
+type Pageable interface {
+	Page() int
+	PageCount() int
+	SetPage(page int)
+}
+
+// PagerControls is a small bar of previous/next buttons and a page indicator for
+// stepping through the pages of a Pageable widget. Refresh it after the underlying
+// data changes so the page count stays accurate.
+//
+// Since: 2.6
+type PagerControls struct {
+	BaseWidget
+
+	target Pageable
+
+	previous *Button
+	next     *Button
+	label    *Label
+}
+
+// NewPagerControls returns page navigation controls for the passed widget, typically
+// a List or Table with a page size set.
+//
+// Since: 2.6
+func NewPagerControls(target Pageable) *PagerControls {
+	p := &PagerControls{target: target}
+	p.ExtendBaseWidget(p)
+	return p
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (p *PagerControls) CreateRenderer() fyne.WidgetRenderer {
+	p.previous = NewButtonWithIcon("", theme.NavigateBackIcon(), func() {
+		p.target.SetPage(p.target.Page() - 1)
+		p.Refresh()
+	})
+	p.next = NewButtonWithIcon("", theme.NavigateNextIcon(), func() {
+		p.target.SetPage(p.target.Page() + 1)
+		p.Refresh()
+	})
+	p.label = NewLabel("")
+	p.updateState()
+
+	bar := &fyne.Container{Layout: layout.NewHBoxLayout(),
+		Objects: []fyne.CanvasObject{p.previous, p.label, p.next}}
+	return NewSimpleRenderer(bar)
+}
+
+// Refresh updates the page indicator and button state to match the target widget.
+func (p *PagerControls) Refresh() {
+	p.updateState()
+	p.BaseWidget.Refresh()
+}
+
+func (p *PagerControls) updateState() {
+	if p.label == nil {
+		return // renderer not created yet
+	}
+
+	page, count := p.target.Page(), p.target.PageCount()
+	p.label.SetText(strconv.Itoa(page+1) + " / " + strconv.Itoa(count))
+	if page <= 0 {
+		p.previous.Disable()
+	} else {
+		p.previous.Enable()
+	}
+	if page >= count-1 {
+		p.next.Disable()
+	} else {
+		p.next.Enable()
+	}
+}
@@ -0,0 +1,128 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList_Paging(t *testing.T) {
+	data := make([]string, 25)
+	for i := range data {
+		data[i] = "row " + string(rune('a'+i))
+	}
+	list := NewList(
+		func() int { return len(data) },
+		func() fyne.CanvasObject { return NewLabel("template") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText(data[id])
+		})
+
+	list.SetPageSize(10)
+	assert.Equal(t, 10, list.Length())
+	assert.Equal(t, 3, list.PageCount())
+	assert.Equal(t, 0, list.Page())
+
+	list.SetPage(2)
+	assert.Equal(t, 5, list.Length())
+	assert.Equal(t, 22, list.SourceIndex(2))
+
+	item := NewLabel("")
+	list.UpdateItem(0, item)
+	assert.Equal(t, data[20], item.Text)
+
+	list.SetPage(9)
+	assert.Equal(t, 2, list.Page())
+	list.SetPage(-1)
+	assert.Equal(t, 0, list.Page())
+
+	list.SetPageSize(0)
+	assert.Equal(t, 25, list.Length())
+	assert.Equal(t, 1, list.PageCount())
+	assert.Equal(t, 7, list.SourceIndex(7))
+}
+
+func TestTable_Paging(t *testing.T) {
+	table := NewTable(
+		func() (int, int) { return 12, 2 },
+		func() fyne.CanvasObject { return NewLabel("template") },
+		func(id TableCellID, template fyne.CanvasObject) {
+			template.(*Label).SetText("cell " + string(rune('a'+id.Row)))
+		})
+
+	table.SetPageSize(5)
+	rows, cols := table.Length()
+	assert.Equal(t, 5, rows)
+	assert.Equal(t, 2, cols)
+	assert.Equal(t, 3, table.PageCount())
+
+	table.SetPage(2)
+	rows, _ = table.Length()
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, 11, table.SourceIndex(1))
+
+	cell := NewLabel("")
+	table.UpdateCell(TableCellID{Row: 1, Col: 0}, cell)
+	assert.Equal(t, "cell "+string(rune('a'+11)), cell.Text)
+
+	table.SetPageSize(0)
+	rows, _ = table.Length()
+	assert.Equal(t, 12, rows)
+	assert.Equal(t, 1, table.PageCount())
+}
+
+func TestTable_PagingFiltered(t *testing.T) {
+	table := NewTable(
+		func() (int, int) { return 10, 1 },
+		func() fyne.CanvasObject { return NewLabel("template") },
+		func(id TableCellID, template fyne.CanvasObject) {
+			template.(*Label).SetText("cell " + string(rune('a'+id.Row)))
+		})
+
+	table.SetFilter(func(row int) bool { return row%2 == 0 }) // 5 rows remain
+	table.SetPageSize(3)
+	assert.Equal(t, 2, table.PageCount())
+
+	table.SetPage(1)
+	rows, _ := table.Length()
+	assert.Equal(t, 2, rows)
+
+	cell := NewLabel("")
+	table.UpdateCell(TableCellID{Row: 1, Col: 0}, cell)
+	assert.Equal(t, "cell "+string(rune('a'+8)), cell.Text)
+}
+
+func TestPagerControls(t *testing.T) {
+	test.NewTempApp(t)
+
+	list := NewList(
+		func() int { return 8 },
+		func() fyne.CanvasObject { return NewLabel("template") },
+		func(id ListItemID, item fyne.CanvasObject) {})
+	list.SetPageSize(3)
+
+	pager := NewPagerControls(list)
+	w := test.NewTempWindow(t, pager)
+	w.Resize(fyne.NewSize(200, 50))
+
+	assert.Equal(t, "1 / 3", pager.label.Text)
+	assert.True(t, pager.previous.Disabled())
+	assert.False(t, pager.next.Disabled())
+
+	pager.next.Tapped(nil)
+	assert.Equal(t, 1, list.Page())
+	assert.Equal(t, "2 / 3", pager.label.Text)
+	assert.False(t, pager.previous.Disabled())
+
+	pager.next.Tapped(nil)
+	assert.Equal(t, "3 / 3", pager.label.Text)
+	assert.True(t, pager.next.Disabled())
+
+	pager.previous.Tapped(nil)
+	pager.previous.Tapped(nil)
+	assert.Equal(t, 0, list.Page())
+	assert.True(t, pager.previous.Disabled())
+}
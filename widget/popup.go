@@ -7,6 +7,31 @@ import (
 	"fyne.io/fyne/v2/theme"
 )
 
+// PopUpPlacement describes which edge of an anchor object a pop-up shown with
+// ShowNextTo prefers to appear against.
+//
+// Since: 2.6
+type PopUpPlacement int
+
+const (
+	// PopUpPlacementBelow shows the pop-up under the anchor object.
+	//
+	// Since: 2.6
+	PopUpPlacementBelow PopUpPlacement = iota
+	// PopUpPlacementAbove shows the pop-up over the anchor object.
+	//
+	// Since: 2.6
+	PopUpPlacementAbove
+	// PopUpPlacementStart shows the pop-up at the leading edge of the anchor object.
+	//
+	// Since: 2.6
+	PopUpPlacementStart
+	// PopUpPlacementEnd shows the pop-up at the trailing edge of the anchor object.
+	//
+	// Since: 2.6
+	PopUpPlacementEnd
+)
+
 // PopUp is a widget that can float above the user interface.
 // It wraps any standard elements with padding and a shadow.
 // If it is modal then the shadow will cover the entire canvas it hovers over and block interactions.
@@ -16,10 +41,12 @@ type PopUp struct {
 	Content fyne.CanvasObject
 	Canvas  fyne.Canvas
 
-	innerPos     fyne.Position
-	innerSize    fyne.Size
-	modal        bool
-	overlayShown bool
+	anchor          fyne.CanvasObject
+	anchorPlacement PopUpPlacement
+	innerPos        fyne.Position
+	innerSize       fyne.Size
+	modal           bool
+	overlayShown    bool
 }
 
 // Hide this widget, if it was previously visible
@@ -33,10 +60,12 @@ func (p *PopUp) Hide() {
 
 // Move the widget to a new position. A PopUp position is absolute to the top, left of its canvas.
 // For PopUp this actually moves the content so checking Position() will not return the same value as is set here.
+// Moving a pop-up shown with ShowNextTo detaches it from its anchor object.
 func (p *PopUp) Move(pos fyne.Position) {
 	if p.modal {
 		return
 	}
+	p.anchor = nil
 	p.innerPos = pos
 	p.Refresh()
 }
@@ -76,6 +105,69 @@ func (p *PopUp) ShowAtRelativePosition(rel fyne.Position, to fyne.CanvasObject)
 	withRelativePosition(rel, to, p.ShowAtPosition)
 }
 
+// ShowNextTo shows this pop-up against the chosen edge of the anchor object,
+// flipping to the opposite edge if there is not enough room on the canvas.
+// The position is recalculated every time the pop-up refreshes, so hooking a
+// scroll container's OnScrolled up to Refresh keeps the pop-up attached while
+// the anchor scrolls.
+//
+// Since: 2.6
+func (p *PopUp) ShowNextTo(to fyne.CanvasObject, placement PopUpPlacement) {
+	p.anchor = to
+	p.anchorPlacement = placement
+	p.innerPos = p.anchoredPosition()
+	p.Show()
+}
+
+// anchoredPosition returns the position against the preferred edge of the
+// anchor object, or against the opposite edge if the pop-up would not fit.
+func (p *PopUp) anchoredPosition() fyne.Position {
+	d := fyne.CurrentApp().Driver()
+	if d.CanvasForObject(p.anchor) == nil {
+		fyne.LogError("Could not locate anchor object to position next to", nil)
+		return p.innerPos
+	}
+
+	anchorPos := d.AbsolutePositionForObject(p.anchor)
+	anchorSize := p.anchor.Size()
+	popSize := p.MinSize().Max(p.innerSize)
+	canvasSize := p.Canvas.Size()
+
+	placement := p.anchorPlacement
+	if fyne.CurrentLayoutDirection() == fyne.LayoutDirectionRightToLeft {
+		if placement == PopUpPlacementStart {
+			placement = PopUpPlacementEnd
+		} else if placement == PopUpPlacementEnd {
+			placement = PopUpPlacementStart
+		}
+	}
+
+	pos := anchorPos
+	switch placement {
+	case PopUpPlacementAbove:
+		pos.Y = anchorPos.Y - popSize.Height
+		if pos.Y < 0 && anchorPos.Y+anchorSize.Height+popSize.Height <= canvasSize.Height {
+			pos.Y = anchorPos.Y + anchorSize.Height
+		}
+	case PopUpPlacementBelow:
+		pos.Y = anchorPos.Y + anchorSize.Height
+		if pos.Y+popSize.Height > canvasSize.Height && anchorPos.Y-popSize.Height >= 0 {
+			pos.Y = anchorPos.Y - popSize.Height
+		}
+	case PopUpPlacementStart:
+		pos.X = anchorPos.X - popSize.Width
+		if pos.X < 0 && anchorPos.X+anchorSize.Width+popSize.Width <= canvasSize.Width {
+			pos.X = anchorPos.X + anchorSize.Width
+		}
+	case PopUpPlacementEnd:
+		pos.X = anchorPos.X + anchorSize.Width
+		if pos.X+popSize.Width > canvasSize.Width && anchorPos.X-popSize.Width >= 0 {
+			pos.X = anchorPos.X - popSize.Width
+		}
+	}
+	return pos
+}
+
 // Tapped is called when the user taps the popUp background - if not modal then dismiss this widget
 func (p *PopUp) Tapped(_ *fyne.PointEvent) {
 	if !p.modal {
@@ -135,6 +227,15 @@ func ShowPopUpAtRelativePosition(content fyne.CanvasObject, canvas fyne.Canvas,
 	})
 }
 
+// ShowPopUpNextTo creates a new popUp for the specified content and shows it
+// against the chosen edge of the anchor object, flipping to the opposite edge
+// if there is not enough room on the canvas.
+//
+// Since: 2.6
+func ShowPopUpNextTo(content fyne.CanvasObject, canvas fyne.Canvas, to fyne.CanvasObject, placement PopUpPlacement) {
+	newPopUp(content, canvas).ShowNextTo(to, placement)
+}
+
 func newPopUp(content fyne.CanvasObject, canvas fyne.Canvas) *PopUp {
 	ret := &PopUp{Content: content, Canvas: canvas, modal: false}
 	ret.ExtendBaseWidget(ret)
@@ -219,6 +320,9 @@ func (r *popUpRenderer) MinSize() fyne.Size {
 }
 
 func (r *popUpRenderer) Refresh() {
+	if r.popUp.anchor != nil {
+		r.popUp.innerPos = r.popUp.anchoredPosition()
+	}
 	th := r.popUp.Theme()
 	v := fyne.CurrentApp().Settings().ThemeVariant()
 	r.background.FillColor = th.Color(theme.ColorNameOverlayBackground, v)
@@ -134,6 +134,35 @@ func TestPopUp_ShowAtPosition(t *testing.T) {
 	assert.Equal(t, pos.Add(fyne.NewPos(theme.Padding(), theme.Padding())), pop.Content.Position())
 }
 
+func TestPopUp_ShowNextTo(t *testing.T) {
+	anchor := NewLabel("Anchor")
+	w := test.NewTempWindow(t, &fyne.Container{Layout: layout.NewVBoxLayout(), Objects: []fyne.CanvasObject{anchor}})
+	w.Resize(fyne.NewSize(200, 200))
+
+	label := NewLabel("Hi")
+	pop := newPopUp(label, w.Canvas())
+	pop.ShowNextTo(anchor, PopUpPlacementBelow)
+
+	anchorPos := fyne.CurrentApp().Driver().AbsolutePositionForObject(anchor)
+	assert.Equal(t, anchorPos.Add(fyne.NewPos(0, anchor.Size().Height)), pop.innerPos)
+}
+
+func TestPopUp_ShowNextTo_Flip(t *testing.T) {
+	anchor := NewLabel("Anchor")
+	anchor.Resize(anchor.MinSize())
+	anchor.Move(fyne.NewPos(10, 150))
+	w := test.NewTempWindow(t, &fyne.Container{Objects: []fyne.CanvasObject{anchor}})
+	w.Resize(fyne.NewSize(200, 200))
+
+	label := NewLabel("Hi")
+	pop := newPopUp(label, w.Canvas())
+	pop.ShowNextTo(anchor, PopUpPlacementBelow)
+
+	// not enough room below the anchor so the pop-up flips above it
+	anchorPos := fyne.CurrentApp().Driver().AbsolutePositionForObject(anchor)
+	assert.Equal(t, anchorPos.Y-pop.MinSize().Height, pop.innerPos.Y)
+}
+
 func TestPopUp_Hide(t *testing.T) {
 	label := NewLabel("Hi")
 	pop := NewPopUp(label, test.Canvas())
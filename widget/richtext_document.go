@@ -0,0 +1,44 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2/data/richtext"
+	"fyne.io/fyne/v2/theme"
+)
+
+// NewRichTextFromDocument configures a RichText widget that renders the given document model.
+// The widget refreshes whenever the document changes, so several views can observe one document.
+//
+// Since: 2.6
+func NewRichTextFromDocument(doc *richtext.Document) *RichText {
+	t := NewRichText(segmentsFromDocument(doc)...)
+	doc.AddChangeListener(func(richtext.Change) {
+		t.Segments = segmentsFromDocument(doc)
+		t.Refresh()
+	})
+	return t
+}
+
+// segmentsFromDocument converts the styled runs of a document model into widget segments.
+func segmentsFromDocument(doc *richtext.Document) []RichTextSegment {
+	segs := doc.Segments()
+	out := make([]RichTextSegment, len(segs))
+	for i, seg := range segs {
+		style := RichTextStyle{
+			ColorName: seg.Style.ColorName,
+			Inline:    true,
+			SizeName:  seg.Style.SizeName,
+		}
+		if style.ColorName == "" {
+			style.ColorName = theme.ColorNameForeground
+		}
+		if style.SizeName == "" {
+			style.SizeName = theme.SizeNameText
+		}
+		style.TextStyle.Bold = seg.Style.Bold
+		style.TextStyle.Italic = seg.Style.Italic
+		style.TextStyle.Monospace = seg.Style.Monospace
+		style.TextStyle.Underline = seg.Style.Underline
+		out[i] = &TextSegment{Style: style, Text: seg.Text}
+	}
+	return out
+}
@@ -216,6 +216,56 @@ func (i *ImageSegment) Unselect() {
 	// no-op: this will be added when we progress to editor
 }
 
+// ObjectSegment embeds any fyne.CanvasObject within a rich text widget, either inline
+// with the surrounding text or as a standalone block. This can be used to mix interactive
+// widgets such as buttons, chips or preview cards into a document flow.
+//
+// Since: 2.6
+type ObjectSegment struct {
+	// Object is the canvas object to embed in the text flow.
+	Object fyne.CanvasObject `json:"-"`
+	// Title is used as the plain text representation of this segment.
+	Title string
+	// Block specifies that the object should stand on its own line instead of flowing inline with text.
+	Block bool
+}
+
+// Inline returns whether this segment flows with the surrounding text.
+func (o *ObjectSegment) Inline() bool {
+	return !o.Block
+}
+
+// Textual returns the content of this segment rendered to plain text.
+func (o *ObjectSegment) Textual() string {
+	return o.Title
+}
+
+// Visual returns the embedded object used to render this segment.
+func (o *ObjectSegment) Visual() fyne.CanvasObject {
+	return o.Object
+}
+
+// Update applies the current state of this object segment to an existing visual.
+func (o *ObjectSegment) Update(v fyne.CanvasObject) {
+	v.Refresh()
+}
+
+// Select tells the segment that the user is selecting the content between the two positions.
+func (o *ObjectSegment) Select(begin, end fyne.Position) {
+	// no-op: embedded objects have no text selection
+}
+
+// SelectedText should return the text representation of any content currently selected through the Select call.
+func (o *ObjectSegment) SelectedText() string {
+	// no-op: embedded objects have no text rendering
+	return ""
+}
+
+// Unselect tells the segment that the user is has cancelled the previous selection.
+func (o *ObjectSegment) Unselect() {
+	// no-op: embedded objects have no text selection
+}
+
 // ListSegment includes an itemised list with the content set using the Items field.
 //
 // Since: 2.1
@@ -48,6 +48,24 @@ func TestRichText_HyperLink(t *testing.T) {
 	assert.Equal(t, texts[0].Position().Y, linkText.Position().Y)
 }
 
+func TestRichText_Object(t *testing.T) {
+	btn := NewButton("Tap", func() {})
+	text := NewRichText(
+		&TextSegment{Text: "before ", Style: RichTextStyleInline},
+		&ObjectSegment{Object: btn, Title: "tap button"},
+		&TextSegment{Text: " after", Style: RichTextStyleInline})
+
+	objs := test.TempWidgetRenderer(t, text).Objects()
+	assert.Equal(t, 3, len(objs))
+	assert.Equal(t, btn, objs[1])
+	assert.Equal(t, "tap button", text.Segments[1].Textual())
+
+	c := test.NewCanvas()
+	c.SetContent(text)
+	assert.Equal(t, "before ", objs[0].(*canvas.Text).Text)
+	assert.Greater(t, objs[2].Position().X, btn.Position().X)
+}
+
 func TestRichText_List(t *testing.T) {
 	seg := trailingBoldErrorSegment()
 	seg.Text = "Test"
@@ -8,6 +8,7 @@ import (
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/data/richtext"
 	"fyne.io/fyne/v2/internal/cache"
 	"fyne.io/fyne/v2/internal/widget"
 	"fyne.io/fyne/v2/test"
@@ -1165,3 +1166,16 @@ func TestText_findSpaceIndex(t *testing.T) {
 		})
 	}
 }
+
+func TestRichText_FromDocument(t *testing.T) {
+	doc := richtext.NewDocument()
+	doc.Insert(0, "Hello", richtext.Style{})
+
+	text := NewRichTextFromDocument(doc)
+	assert.Equal(t, "Hello", text.String())
+
+	doc.Insert(5, " World", richtext.Style{Italic: true})
+	assert.Equal(t, "Hello World", text.String())
+	assert.Equal(t, 2, len(text.Segments))
+	assert.True(t, text.Segments[1].(*TextSegment).Style.TextStyle.Italic)
+}
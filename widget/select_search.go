@@ -0,0 +1,334 @@
+package widget
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/lang"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+)
+
+// maximum number of option rows visible in a SelectSearch popup before scrolling
+const maxSelectSearchRows = 8
+
+// SelectSearch is a Select whose popup holds a filter entry and a virtualized list,
+// making it usable with very large option sets. The list can be navigated with the
+// Up and Down keys while typing, an option accepted with Return or a tap, and the
+// popup dismissed with Escape. With MultiSelect set, several options can be chosen
+// and are shown as removable chips above the filter.
+//
+// Since: 2.6
+type SelectSearch struct {
+	Select
+
+	// MultiSelect keeps the popup open and toggles tapped options in and out of
+	// Selections instead of replacing Selected.
+	MultiSelect bool
+	// Selections holds the options currently chosen when MultiSelect is set.
+	Selections []string
+	// OnSelectionsChanged is called with the new Selections when MultiSelect is set.
+	OnSelectionsChanged func([]string) `json:"-"`
+
+	searchPop  *PopUp
+	filter     *selectSearchFilter
+	list       *List
+	chips      *fyne.Container
+	filtered   []int
+	active     int
+	navigating bool
+}
+
+var _ fyne.Widget = (*SelectSearch)(nil)
+var _ fyne.Tappable = (*SelectSearch)(nil)
+var _ fyne.Focusable = (*SelectSearch)(nil)
+
+// NewSelectSearch creates a new searchable select widget with the set list of
+// options and changes handler.
+//
+// Since: 2.6
+func NewSelectSearch(options []string, changed func(string)) *SelectSearch {
+	s := &SelectSearch{}
+	s.Options = options
+	s.OnChanged = changed
+	s.PlaceHolder = defaultPlaceHolder
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// Hide hides the select and its popup.
+//
+// Implements: fyne.Widget
+func (s *SelectSearch) Hide() {
+	s.hideSearchPopUp()
+	s.Select.Hide()
+}
+
+// Move changes the relative position of the select.
+//
+// Implements: fyne.Widget
+func (s *SelectSearch) Move(pos fyne.Position) {
+	s.Select.Move(pos)
+
+	if s.searchPop != nil {
+		s.searchPop.Move(s.popUpPos())
+	}
+}
+
+// SetSelections replaces the chosen options of a MultiSelect select, ignoring any
+// that are not in Options.
+//
+// Since: 2.6
+func (s *SelectSearch) SetSelections(selections []string) {
+	chosen := make([]string, 0, len(selections))
+	for _, text := range selections {
+		for _, option := range s.Options {
+			if text == option {
+				chosen = append(chosen, text)
+				break
+			}
+		}
+	}
+
+	s.Selections = chosen
+	s.selectionsUpdated()
+}
+
+// Tapped is called when a pointer tapped event is captured and opens the popup.
+func (s *SelectSearch) Tapped(*fyne.PointEvent) {
+	if s.Disabled() {
+		return
+	}
+
+	if !s.focused {
+		focusIfNotMobile(s.super())
+	}
+
+	s.tapAnimation()
+	s.Refresh()
+
+	s.showSearchPopUp()
+}
+
+// TypedKey is called if a key event happens while this SelectSearch is focused.
+//
+// Implements: fyne.Focusable
+func (s *SelectSearch) TypedKey(event *fyne.KeyEvent) {
+	switch event.Name {
+	case fyne.KeySpace, fyne.KeyUp, fyne.KeyDown:
+		s.showSearchPopUp()
+	}
+}
+
+// applyFilter narrows the visible options to those containing the typed text,
+// ignoring case.
+func (s *SelectSearch) applyFilter(text string) {
+	query := strings.ToLower(text)
+
+	s.filtered = s.filtered[:0]
+	for i, option := range s.Options {
+		if query == "" || strings.Contains(strings.ToLower(option), query) {
+			s.filtered = append(s.filtered, i)
+		}
+	}
+
+	s.setActive(0)
+	if s.list != nil {
+		s.list.Refresh()
+		s.list.ScrollToTop()
+	}
+}
+
+// choose reacts to the option at the passed index into Options being picked.
+func (s *SelectSearch) choose(index int) {
+	text := s.Options[index]
+	if s.MultiSelect {
+		s.toggleSelection(text)
+		return
+	}
+
+	s.hideSearchPopUp()
+	s.updateSelected(text)
+}
+
+func (s *SelectSearch) hideSearchPopUp() {
+	if s.searchPop == nil {
+		return
+	}
+
+	s.searchPop.Hide()
+	s.searchPop = nil
+}
+
+// isChosen returns whether the passed option is in Selections.
+func (s *SelectSearch) isChosen(text string) bool {
+	for _, chosen := range s.Selections {
+		if chosen == text {
+			return true
+		}
+	}
+	return false
+}
+
+// moveActive moves the keyboard highlight up or down the filtered list.
+func (s *SelectSearch) moveActive(delta int) {
+	if s.searchPop == nil || len(s.filtered) == 0 {
+		return
+	}
+
+	s.setActive(s.active + delta)
+}
+
+// refreshChips rebuilds the chip row to match Selections, each chip removing its
+// option when tapped.
+func (s *SelectSearch) refreshChips() {
+	if s.chips == nil {
+		return
+	}
+
+	s.chips.Objects = nil
+	for _, text := range s.Selections {
+		chip := text // capture
+		button := NewButtonWithIcon(chip, theme.CancelIcon(), func() {
+			s.toggleSelection(chip)
+		})
+		button.IconPlacement = ButtonIconTrailingText
+		s.chips.Objects = append(s.chips.Objects, button)
+	}
+
+	s.chips.Hidden = len(s.chips.Objects) == 0
+	s.chips.Refresh()
+}
+
+func (s *SelectSearch) selectionsUpdated() {
+	s.Selected = strings.Join(s.Selections, ", ")
+	if s.OnSelectionsChanged != nil {
+		s.OnSelectionsChanged(s.Selections)
+	}
+
+	s.refreshChips()
+	if s.list != nil {
+		s.list.Refresh()
+	}
+	s.Refresh()
+}
+
+// setActive highlights the row at the passed index into the filtered list, without
+// choosing it.
+func (s *SelectSearch) setActive(index int) {
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(s.filtered) {
+		index = len(s.filtered) - 1
+	}
+
+	s.active = index
+	if s.list == nil || index < 0 {
+		return
+	}
+	s.navigating = true
+	s.list.Select(index)
+	s.navigating = false
+}
+
+func (s *SelectSearch) showSearchPopUp() {
+	s.hideSearchPopUp()
+	c := fyne.CurrentApp().Driver().CanvasForObject(s.super())
+
+	s.filter = newSelectSearchFilter(s)
+	s.list = NewList(
+		func() int { return len(s.filtered) },
+		func() fyne.CanvasObject { return NewLabel("template") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			label := item.(*Label)
+			label.Text = s.Options[s.filtered[id]]
+			label.TextStyle.Bold = s.MultiSelect && s.isChosen(label.Text)
+			label.Refresh()
+		})
+	s.list.OnSelected = func(id ListItemID) {
+		s.active = id
+		if s.navigating {
+			return
+		}
+		s.choose(s.filtered[id])
+		if s.MultiSelect {
+			s.list.Unselect(id)
+		}
+	}
+	s.chips = &fyne.Container{Layout: layout.NewHBoxLayout()}
+	s.applyFilter("")
+	s.refreshChips()
+
+	top := &fyne.Container{Layout: layout.NewVBoxLayout(),
+		Objects: []fyne.CanvasObject{s.chips, s.filter}}
+	content := &fyne.Container{Layout: layout.NewBorderLayout(top, nil, nil, nil),
+		Objects: []fyne.CanvasObject{top, s.list}}
+
+	pop := NewPopUp(content, c)
+	s.searchPop = pop
+	pop.ShowAtPosition(s.popUpPos())
+
+	th := s.Theme()
+	pad := th.Size(theme.SizeNamePadding)
+	rows := len(s.filtered)
+	if rows > maxSelectSearchRows {
+		rows = maxSelectSearchRows
+	}
+	listHeight := float32(rows) * (NewLabel("template").MinSize().Height + pad)
+	pop.Resize(fyne.NewSize(s.Size().Width, top.MinSize().Height+listHeight+pad*2))
+
+	c.Focus(s.filter)
+}
+
+// toggleSelection adds the passed option to Selections, or removes it when already
+// chosen.
+func (s *SelectSearch) toggleSelection(text string) {
+	if s.isChosen(text) {
+		chosen := make([]string, 0, len(s.Selections)-1)
+		for _, option := range s.Selections {
+			if option != text {
+				chosen = append(chosen, option)
+			}
+		}
+		s.Selections = chosen
+	} else {
+		s.Selections = append(s.Selections, text)
+	}
+
+	s.selectionsUpdated()
+}
+
+// selectSearchFilter is the entry in a SelectSearch popup, forwarding navigation
+// keys to the list of options.
+type selectSearchFilter struct {
+	Entry
+
+	search *SelectSearch
+}
+
+func newSelectSearchFilter(s *SelectSearch) *selectSearchFilter {
+	f := &selectSearchFilter{search: s}
+	f.PlaceHolder = lang.L("Search")
+	f.OnChanged = s.applyFilter
+	f.OnSubmitted = func(string) {
+		if s.active >= 0 && s.active < len(s.filtered) {
+			s.choose(s.filtered[s.active])
+		}
+	}
+	f.ExtendBaseWidget(f)
+	return f
+}
+
+func (f *selectSearchFilter) TypedKey(key *fyne.KeyEvent) {
+	switch key.Name {
+	case fyne.KeyDown:
+		f.search.moveActive(1)
+	case fyne.KeyUp:
+		f.search.moveActive(-1)
+	case fyne.KeyEscape:
+		f.search.hideSearchPopUp()
+	default:
+		f.Entry.TypedKey(key)
+	}
+}
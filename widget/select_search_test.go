@@ -0,0 +1,90 @@
+package widget
+
+import (
+	"fmt"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func searchSelectOptions(count int) []string {
+	options := make([]string, count)
+	for i := range options {
+		options[i] = fmt.Sprintf("Option %d", i)
+	}
+	return options
+}
+
+func TestSelectSearch_Filter(t *testing.T) {
+	sel := NewSelectSearch(searchSelectOptions(10000), nil)
+	w := test.NewTempWindow(t, sel)
+	w.Resize(fyne.NewSize(200, 300))
+
+	test.Tap(sel)
+	assert.NotNil(t, sel.searchPop)
+	assert.Len(t, sel.filtered, 10000)
+
+	test.Type(sel.filter, "Option 999")
+	assert.Len(t, sel.filtered, 11, "999 and 9990-9999")
+}
+
+func TestSelectSearch_Choose(t *testing.T) {
+	chosen := ""
+	sel := NewSelectSearch(searchSelectOptions(100), func(s string) { chosen = s })
+	w := test.NewTempWindow(t, sel)
+	w.Resize(fyne.NewSize(200, 300))
+
+	test.Tap(sel)
+	test.Type(sel.filter, "Option 42")
+	sel.filter.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+
+	assert.Equal(t, "Option 42", chosen)
+	assert.Equal(t, "Option 42", sel.Selected)
+	assert.Nil(t, sel.searchPop)
+}
+
+func TestSelectSearch_Keyboard(t *testing.T) {
+	sel := NewSelectSearch([]string{"apple", "banana", "cherry"}, nil)
+	w := test.NewTempWindow(t, sel)
+	w.Resize(fyne.NewSize(200, 300))
+
+	test.Tap(sel)
+	sel.filter.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	sel.filter.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	assert.Equal(t, 2, sel.active)
+
+	sel.filter.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	sel.filter.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, "banana", sel.Selected)
+
+	test.Tap(sel)
+	sel.filter.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEscape})
+	assert.Nil(t, sel.searchPop)
+}
+
+func TestSelectSearch_MultiSelect(t *testing.T) {
+	var selections []string
+	sel := NewSelectSearch([]string{"apple", "banana", "cherry"}, nil)
+	sel.MultiSelect = true
+	sel.OnSelectionsChanged = func(s []string) { selections = s }
+	w := test.NewTempWindow(t, sel)
+	w.Resize(fyne.NewSize(200, 300))
+
+	test.Tap(sel)
+	sel.choose(0)
+	sel.choose(2)
+	assert.Equal(t, []string{"apple", "cherry"}, selections)
+	assert.Equal(t, "apple, cherry", sel.Selected)
+	assert.NotNil(t, sel.searchPop, "the popup stays open while multi-selecting")
+	assert.Len(t, sel.chips.Objects, 2)
+
+	// tapping a chip removes its selection
+	test.Tap(sel.chips.Objects[0].(*Button))
+	assert.Equal(t, []string{"cherry"}, selections)
+
+	sel.SetSelections([]string{"banana", "durian"})
+	assert.Equal(t, []string{"banana"}, sel.Selections, "unknown options are dropped")
+}
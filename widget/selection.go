@@ -0,0 +1,190 @@
+package widget
+
+import (
+	"sort"
+
+	"fyne.io/fyne/v2"
+)
+
+// SelectionMode determines how many items of a collection widget may be selected at once.
+//
+// Since: 2.6
+type SelectionMode int
+
+const (
+	// SelectionModeSingle allows at most one item to be selected at a time.
+	// This is the default behaviour of the collection widgets.
+	//
+	// Since: 2.6
+	SelectionModeSingle SelectionMode = iota
+	// SelectionModeMulti allows many items to be selected together,
+	// using Ctrl-click to toggle and Shift-click to extend a range.
+	//
+	// Since: 2.6
+	SelectionModeMulti
+)
+
+// SelectionModel tracks which indexed items of a collection widget are selected.
+// It is shared by List, GridWrap and the row selection of Table, supporting single,
+// toggled (Ctrl-click) and range (Shift-click) selection of items.
+//
+// Since: 2.6
+type SelectionModel struct {
+	mode     SelectionMode
+	selected map[int]struct{}
+	anchor   int
+
+	// onChanged is invoked by the model with the items that became selected and
+	// unselected, so the owning widget can refresh and fire its own callbacks.
+	onChanged func(selected, unselected []int)
+}
+
+func newSelectionModel(onChanged func(selected, unselected []int)) *SelectionModel {
+	return &SelectionModel{selected: make(map[int]struct{}), anchor: -1, onChanged: onChanged}
+}
+
+// Mode returns how many items this model allows to be selected at once.
+func (s *SelectionModel) Mode() SelectionMode {
+	return s.mode
+}
+
+// SetMode updates how many items this model allows to be selected at once.
+// Switching to SelectionModeSingle keeps only the most recently selected item.
+func (s *SelectionModel) SetMode(mode SelectionMode) {
+	s.mode = mode
+	if mode == SelectionModeSingle && len(s.selected) > 1 {
+		keep := s.anchor
+		if _, ok := s.selected[keep]; !ok {
+			keep = s.Selected()[0]
+		}
+		s.apply(map[int]struct{}{keep: {}})
+	}
+}
+
+// Select makes the given item the only selected item, replacing any existing selection.
+func (s *SelectionModel) Select(id int) {
+	s.anchor = id
+	s.apply(map[int]struct{}{id: {}})
+}
+
+// Toggle inverts the selected state of the given item, keeping the rest of the selection.
+// In single selection mode this selects the item, or clears the selection if it was selected.
+func (s *SelectionModel) Toggle(id int) {
+	if s.mode == SelectionModeSingle {
+		if _, ok := s.selected[id]; ok {
+			s.UnselectAll()
+		} else {
+			s.Select(id)
+		}
+		return
+	}
+
+	next := s.copySelection()
+	if _, ok := next[id]; ok {
+		delete(next, id)
+	} else {
+		next[id] = struct{}{}
+		s.anchor = id
+	}
+	s.apply(next)
+}
+
+// Extend selects the range of items between the most recently selected item and the
+// given one, inclusive. In single selection mode this behaves like Select.
+func (s *SelectionModel) Extend(id int) {
+	if s.mode == SelectionModeSingle || s.anchor == -1 {
+		s.Select(id)
+		return
+	}
+
+	lo, hi := s.anchor, id
+	if hi < lo {
+		lo, hi = hi, lo
+	}
+	next := make(map[int]struct{}, hi-lo+1)
+	for i := lo; i <= hi; i++ {
+		next[i] = struct{}{}
+	}
+	s.apply(next)
+}
+
+// Unselect removes the given item from the selection.
+func (s *SelectionModel) Unselect(id int) {
+	if _, ok := s.selected[id]; !ok {
+		return
+	}
+
+	next := s.copySelection()
+	delete(next, id)
+	s.apply(next)
+}
+
+// UnselectAll removes all items from the selection.
+func (s *SelectionModel) UnselectAll() {
+	s.anchor = -1
+	s.apply(map[int]struct{}{})
+}
+
+// Selected returns the selected item indices in ascending order.
+func (s *SelectionModel) Selected() []int {
+	if len(s.selected) == 0 {
+		return nil
+	}
+
+	out := make([]int, 0, len(s.selected))
+	for id := range s.selected {
+		out = append(out, id)
+	}
+	sort.Ints(out)
+	return out
+}
+
+// IsSelected reports whether the given item is part of the selection.
+func (s *SelectionModel) IsSelected(id int) bool {
+	_, ok := s.selected[id]
+	return ok
+}
+
+// tap applies a pointer tap on the given item, honouring any held modifier keys.
+func (s *SelectionModel) tap(id int, mod fyne.KeyModifier) {
+	if s.mode == SelectionModeMulti && mod&fyne.KeyModifierShortcutDefault != 0 {
+		s.Toggle(id)
+	} else if s.mode == SelectionModeMulti && mod&fyne.KeyModifierShift != 0 {
+		s.Extend(id)
+	} else {
+		s.Select(id)
+	}
+}
+
+func (s *SelectionModel) copySelection() map[int]struct{} {
+	next := make(map[int]struct{}, len(s.selected))
+	for id := range s.selected {
+		next[id] = struct{}{}
+	}
+	return next
+}
+
+// apply installs the new selection and reports the difference to the owning widget.
+func (s *SelectionModel) apply(next map[int]struct{}) {
+	var added, removed []int
+	for id := range next {
+		if _, ok := s.selected[id]; !ok {
+			added = append(added, id)
+		}
+	}
+	for id := range s.selected {
+		if _, ok := next[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+	sort.Ints(added)
+	sort.Ints(removed)
+
+	s.selected = next
+	if s.onChanged != nil {
+		s.onChanged(added, removed)
+	}
+}
@@ -0,0 +1,101 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectionModel_Multi(t *testing.T) {
+	sel := newSelectionModel(nil)
+	sel.SetMode(SelectionModeMulti)
+
+	sel.Select(2)
+	sel.Toggle(4)
+	assert.Equal(t, []int{2, 4}, sel.Selected())
+	assert.True(t, sel.IsSelected(4))
+
+	sel.Toggle(2)
+	assert.Equal(t, []int{4}, sel.Selected())
+
+	sel.Extend(7)
+	assert.Equal(t, []int{4, 5, 6, 7}, sel.Selected())
+
+	sel.Select(1)
+	assert.Equal(t, []int{1}, sel.Selected())
+
+	sel.UnselectAll()
+	assert.Nil(t, sel.Selected())
+}
+
+func TestSelectionModel_SetMode(t *testing.T) {
+	sel := newSelectionModel(nil)
+	sel.SetMode(SelectionModeMulti)
+	sel.Select(1)
+	sel.Extend(3)
+
+	sel.SetMode(SelectionModeSingle)
+	assert.Equal(t, []int{1}, sel.Selected()) // only the anchor remains
+
+	sel.Toggle(1)
+	assert.Nil(t, sel.Selected())
+}
+
+func TestSelectionModel_Tap(t *testing.T) {
+	changes := 0
+	sel := newSelectionModel(func(selected, unselected []int) {
+		changes++
+	})
+	sel.SetMode(SelectionModeMulti)
+
+	sel.tap(1, 0)
+	sel.tap(3, fyne.KeyModifierShortcutDefault)
+	assert.Equal(t, []int{1, 3}, sel.Selected())
+
+	sel.tap(5, fyne.KeyModifierShift)
+	assert.Equal(t, []int{3, 4, 5}, sel.Selected())
+	assert.Equal(t, 3, changes)
+}
+
+func TestList_MultiSelect(t *testing.T) {
+	l := NewList(
+		func() int { return 10 },
+		func() fyne.CanvasObject { return NewLabel("item") },
+		func(ListItemID, fyne.CanvasObject) {})
+
+	selected := []ListItemID{}
+	unselected := []ListItemID{}
+	l.OnSelected = func(id ListItemID) { selected = append(selected, id) }
+	l.OnUnselected = func(id ListItemID) { unselected = append(unselected, id) }
+	l.Selection().SetMode(SelectionModeMulti)
+
+	l.Select(2)
+	l.Selection().Toggle(4)
+	assert.Equal(t, []ListItemID{2, 4}, l.selected)
+	assert.Equal(t, []ListItemID{2, 4}, selected)
+
+	l.Selection().Extend(6)
+	assert.Equal(t, []ListItemID{4, 5, 6}, l.selected)
+	assert.Equal(t, []ListItemID{2}, unselected)
+
+	l.UnselectAll()
+	assert.Nil(t, l.selected)
+	assert.Equal(t, []ListItemID{2, 4, 5, 6}, unselected)
+}
+
+func TestTable_RowSelection(t *testing.T) {
+	table := NewTable(
+		func() (int, int) { return 5, 3 },
+		func() fyne.CanvasObject { return NewLabel("cell") },
+		func(TableCellID, fyne.CanvasObject) {})
+
+	sel := table.RowSelection()
+	sel.SetMode(SelectionModeMulti)
+	sel.Select(1)
+	sel.Toggle(3)
+
+	assert.Equal(t, []int{1, 3}, sel.Selected())
+	assert.True(t, sel.IsSelected(3))
+	assert.False(t, sel.IsSelected(2))
+}
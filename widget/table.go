@@ -1,11 +1,18 @@
 package widget
 
 import (
+	"encoding/csv"
+	"fmt"
+	"io"
 	"math"
+	"reflect"
 	"strconv"
+	"strings"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/data/binding"
 	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/driver/mobile"
 	"fyne.io/fyne/v2/internal/async"
@@ -16,17 +23,69 @@ import (
 
 const noCellMatch = math.MaxInt
 
+// typeAheadTimeout is how long after the last key press typed characters still extend
+// the type-ahead search prefix instead of starting a new one.
+const typeAheadTimeout = time.Second
+
 // allTableCellsID represents all table cells when refreshing requested cells
 var allTableCellsID = TableCellID{-1, -1}
 
 // Declare conformity with interfaces
 var _ desktop.Cursorable = (*Table)(nil)
+var _ fyne.DoubleTappable = (*Table)(nil)
 var _ fyne.Draggable = (*Table)(nil)
 var _ fyne.Focusable = (*Table)(nil)
 var _ desktop.Hoverable = (*Table)(nil)
+var _ fyne.Shortcutable = (*Table)(nil)
 var _ fyne.Tappable = (*Table)(nil)
 var _ fyne.Widget = (*Table)(nil)
 
+// tableWidthLimit holds the user-resize bounds of a column, a zero value leaves that bound unset.
+type tableWidthLimit struct {
+	min, max float32
+}
+
+// tableCellSpan holds how many rows and columns a merged cell stretches across.
+type tableCellSpan struct {
+	rows, cols int
+}
+
+// tableCellEditor is the default Entry used to edit a table cell, adding cancel
+// and next-cell navigation keys on top of the regular entry behaviour.
+type tableCellEditor struct {
+	Entry
+	onCancel func()
+	onNext   func()
+}
+
+func newTableCellEditor() *tableCellEditor {
+	e := &tableCellEditor{}
+	e.ExtendBaseWidget(e)
+	return e
+}
+
+// AcceptsTab ensures Tab key presses are delivered to this editor instead of moving focus.
+func (e *tableCellEditor) AcceptsTab() bool {
+	return true
+}
+
+// TypedKey handles the editing shortcuts before passing other keys to the embedded entry.
+func (e *tableCellEditor) TypedKey(key *fyne.KeyEvent) {
+	switch key.Name {
+	case fyne.KeyEscape:
+		if e.onCancel != nil {
+			e.onCancel()
+			return
+		}
+	case fyne.KeyTab:
+		if e.onNext != nil {
+			e.onNext()
+			return
+		}
+	}
+	e.Entry.TypedKey(key)
+}
+
 // TableCellID is a type that represents a cell's position in a table based on its row and column location.
 type TableCellID struct {
 	Row int
@@ -72,13 +131,13 @@ type Table struct {
 	UpdateHeader func(id TableCellID, template fyne.CanvasObject) `json:"-"`
 
 	// StickyRowCount specifies how many data rows should not scroll when the content moves.
-	// If `ShowHeaderRow` us `true` then the stuck row will appear immediately underneath.
+	// If `ShowHeaderRow` is `true` then the stuck row will appear immediately underneath.
 	//
 	// Since: 2.4
 	StickyRowCount int
 
 	// StickyColumnCount specifies how many data columns should not scroll when the content moves.
-	// If `ShowHeaderColumn` us `true` then the stuck column will appear immediately next to the header.
+	// If `ShowHeaderColumn` is `true` then the stuck column will appear immediately next to the header.
 	//
 	// Since: 2.4
 	StickyColumnCount int
@@ -88,11 +147,77 @@ type Table struct {
 	// Since: 2.5
 	HideSeparators bool
 
+	// AutoRowHeight sizes each row to its tallest cell instead of the template height,
+	// word wrapping label content that is wider than its column. Heights are recomputed
+	// whenever the table refreshes or is resized.
+	//
+	// Since: 2.6
+	AutoRowHeight bool
+
+	// OnHeaderTapped is an optional callback fired when a header cell is tapped.
+	// The `id` parameter will have a `-1` value for the Row when a column header is tapped,
+	// and for the Col when a row header is tapped. This can be used to implement sorting
+	// or other header driven actions.
+	//
+	// Since: 2.6
+	OnHeaderTapped func(id TableCellID) `json:"-"`
+
+	// OnColumnResized is an optional callback fired when the user finishes resizing a column
+	// by dragging its divider, or auto-fits it with a double tap. This can be used to persist
+	// user chosen column widths.
+	//
+	// Since: 2.6
+	OnColumnResized func(col int, width float32) `json:"-"`
+
+	// OnRowResized is an optional callback fired when the user finishes resizing a row by
+	// dragging its divider. This can be used to persist user chosen row heights.
+	//
+	// Since: 2.6
+	OnRowResized func(row int, height float32) `json:"-"`
+
+	// Editable enables in-place editing of cells. Double tapping a cell, or pressing Enter
+	// on the focused cell, opens an editor which commits on Enter and cancels on Escape,
+	// while Tab commits and moves the editor to the next cell.
+	//
+	// Since: 2.6
+	Editable bool
+
+	// CellValue is an optional callback returning the current text of a cell,
+	// used to pre-fill the editor when editing starts.
+	//
+	// Since: 2.6
+	CellValue func(id TableCellID) string `json:"-"`
+
+	// OnCellChanged is an optional callback fired when a cell edit is committed with the new value.
+	//
+	// Since: 2.6
+	OnCellChanged func(id TableCellID, value string) `json:"-"`
+
+	// CreateCellEditor is an optional callback returning the Entry used to edit the given cell,
+	// allowing different editors per column. Custom editors commit through their submit action
+	// and are cancelled by dismissing the editor. When nil a standard Entry is used.
+	//
+	// Since: 2.6
+	CreateCellEditor func(id TableCellID) *Entry `json:"-"`
+
 	currentFocus              TableCellID
 	focused                   bool
+	typedChars                []rune
+	typedTime                 time.Time
 	selectedCell, hoveredCell *TableCellID
+	rowSelection              *SelectionModel
+	tapModifier               fyne.KeyModifier
+	filter                    func(row int) bool
+	filteredRows              []int
+	cellSpans                 map[TableCellID]tableCellSpan
+	srcLength                 func() (rows int, cols int)
+	srcUpdate                 func(id TableCellID, template fyne.CanvasObject)
+	pageSize, page            int
+	pageSrcLength             func() (rows int, cols int)
+	pageSrcUpdate             func(id TableCellID, template fyne.CanvasObject)
 	cells                     *tableCells
 	columnWidths, rowHeights  map[int]float32
+	columnLimits              map[int]tableWidthLimit
 	moveCallback              func()
 	offset                    fyne.Position
 	content                   *widget.Scroll
@@ -131,6 +256,111 @@ func NewTableWithHeaders(length func() (rows int, cols int), create func() fyne.
 	return t
 }
 
+// TableColumn describes how one column of a table built with NewTableWithData
+// presents the bound row values.
+//
+// Since: 2.6
+type TableColumn struct {
+	// Title is the text shown in the header row for this column.
+	Title string
+	// Key selects a value from each row, indexing map rows or naming a struct field.
+	Key string
+	// Format converts a row into the text shown in this column's cells.
+	// If nil, the value selected by Key is formatted with the %v verb.
+	Format func(row any) string `json:"-"`
+}
+
+// NewTableWithData returns a table widget with a header row that presents one row
+// of cells for each item of the passed data binding, described by the column specs.
+// The table refreshes when the bound data changes.
+//
+// Since: 2.6
+func NewTableWithData(data binding.DataList, columns []TableColumn) *Table {
+	t := NewTable(
+		func() (rows int, cols int) { return data.Length(), len(columns) },
+		func() fyne.CanvasObject { return NewLabel("") },
+		func(id TableCellID, o fyne.CanvasObject) {
+			item, err := data.GetItem(id.Row)
+			if err != nil {
+				fyne.LogError(fmt.Sprintf("Error getting data item %d", id.Row), err)
+				return
+			}
+			o.(*Label).SetText(columns[id.Col].cellText(bindingItemValue(item)))
+		})
+	t.ShowHeaderRow = true
+	t.UpdateHeader = func(id TableCellID, template fyne.CanvasObject) {
+		label := template.(*Label)
+		if id.Row == -1 && id.Col >= 0 && id.Col < len(columns) {
+			label.SetText(columns[id.Col].Title)
+		} else {
+			label.SetText("")
+		}
+	}
+
+	data.AddListener(binding.NewDataListener(t.Refresh))
+	return t
+}
+
+// cellText resolves the text a column shows for the given row value.
+func (c TableColumn) cellText(row any) string {
+	if f := c.Format; f != nil {
+		return f(row)
+	}
+
+	value := row
+	if c.Key != "" {
+		value = rowValueForKey(row, c.Key)
+	}
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// rowValueForKey looks up the named entry of a map row, or field of a struct row.
+func rowValueForKey(row any, key string) any {
+	v := reflect.ValueOf(row)
+	for v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		item := v.MapIndex(reflect.ValueOf(key))
+		if item.IsValid() {
+			return item.Interface()
+		}
+	case reflect.Struct:
+		field := v.FieldByName(key)
+		if field.IsValid() && field.CanInterface() {
+			return field.Interface()
+		}
+	}
+	return nil
+}
+
+// bindingItemValue extracts the current value of common bindable data items.
+func bindingItemValue(item binding.DataItem) any {
+	var value any
+	var err error
+	switch v := item.(type) {
+	case binding.Untyped:
+		value, err = v.Get()
+	case binding.String:
+		value, err = v.Get()
+	case binding.Int:
+		value, err = v.Get()
+	case binding.Float:
+		value, err = v.Get()
+	case binding.Bool:
+		value, err = v.Get()
+	}
+	if err != nil {
+		fyne.LogError("Error getting bound value", err)
+		return nil
+	}
+	return value
+}
+
 // CreateRenderer returns a new renderer for the table.
 //
 // Implements: fyne.Widget
@@ -195,7 +425,7 @@ func (t *Table) Dragged(e *fyne.DragEvent) {
 		if newSize < min.Width {
 			newSize = min.Width
 		}
-		t.SetColumnWidth(t.dragCol, newSize)
+		t.SetColumnWidth(t.dragCol, t.clampColumnWidth(col, newSize))
 	}
 	if row != noCellMatch {
 		newSize := startSize + (e.Position.Y - startPos.Y)
@@ -207,8 +437,120 @@ func (t *Table) Dragged(e *fyne.DragEvent) {
 }
 
 func (t *Table) DragEnd() {
+	col, row := t.dragCol, t.dragRow
 	t.dragCol = noCellMatch
 	t.dragRow = noCellMatch
+
+	t.propertyLock.RLock()
+	width, height := t.columnWidths[col], t.rowHeights[row]
+	t.propertyLock.RUnlock()
+	if col != noCellMatch {
+		if f := t.OnColumnResized; f != nil {
+			f(col, width)
+		}
+	}
+	if row != noCellMatch {
+		if f := t.OnRowResized; f != nil {
+			f(row, height)
+		}
+	}
+}
+
+// DoubleTapped is called when the user double taps the table.
+// Double tapping a column divider in the header row sizes the column to fit its visible content,
+// and double tapping a cell opens an editor if the table is Editable.
+//
+// Implements: fyne.DoubleTappable
+//
+// Since: 2.6
+func (t *Table) DoubleTapped(e *fyne.PointEvent) {
+	if t.hoverHeaderCol != noCellMatch {
+		t.autoFitColumn(t.hoverHeaderCol)
+		return
+	}
+
+	if !t.Editable {
+		return
+	}
+	col := t.columnAt(e.Position)
+	row := t.rowAt(e.Position)
+	if col < 0 || row < 0 {
+		return
+	}
+	t.EditCell(TableCellID{row, col})
+}
+
+// EditCell opens an editor over the specified cell, committing any new value through
+// the OnCellChanged callback. It does nothing unless the table is Editable.
+//
+// Since: 2.6
+func (t *Table) EditCell(id TableCellID) {
+	if !t.Editable {
+		return
+	}
+	c := fyne.CurrentApp().Driver().CanvasForObject(t)
+	if c == nil {
+		return
+	}
+	t.ScrollTo(id)
+
+	var entry *Entry
+	var focus fyne.Focusable
+	editor := newTableCellEditor()
+	if f := t.CreateCellEditor; f != nil {
+		entry = f(id)
+		focus = entry
+	} else {
+		entry = &editor.Entry
+		focus = editor
+	}
+	if f := t.CellValue; f != nil {
+		entry.SetText(f(id))
+	}
+
+	pop := NewPopUp(focus.(fyne.CanvasObject), c)
+	commit := func() {
+		value := entry.Text
+		pop.Hide()
+		if f := t.OnCellChanged; f != nil {
+			f(id, value)
+		}
+		t.RefreshItem(id)
+	}
+	entry.OnSubmitted = func(string) {
+		commit()
+	}
+	editor.onCancel = pop.Hide
+	editor.onNext = func() {
+		commit()
+		t.editNextCell(id)
+	}
+
+	cellX, cellWidth := t.findX(id.Col)
+	cellY, cellHeight := t.findY(id.Row)
+	rel := t.content.Position().Add(fyne.NewPos(cellX-t.offset.X, cellY-t.offset.Y))
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(t).Add(rel)
+	pop.ShowAtPosition(pos)
+	pop.Resize(fyne.NewSize(fyne.Max(cellWidth, entry.MinSize().Width),
+		fyne.Max(cellHeight, entry.MinSize().Height)))
+	c.Focus(focus)
+}
+
+// editNextCell opens the editor on the cell after the given one, wrapping to the next row.
+func (t *Table) editNextCell(id TableCellID) {
+	rows, cols := 0, 0
+	if f := t.Length; f != nil {
+		rows, cols = f()
+	}
+	id.Col++
+	if id.Col >= cols {
+		id.Col = 0
+		id.Row++
+	}
+	if id.Row >= rows {
+		return
+	}
+	t.EditCell(id)
 }
 
 // FocusGained is called after this table has gained focus.
@@ -234,6 +576,7 @@ func (t *Table) MouseIn(ev *desktop.MouseEvent) {
 
 // MouseDown response to desktop mouse event
 func (t *Table) MouseDown(e *desktop.MouseEvent) {
+	t.tapModifier = e.Modifier
 	t.tapped(e.Position)
 }
 
@@ -264,6 +607,20 @@ func (t *Table) RefreshItem(id TableCellID) {
 	r.(*tableCellsRenderer).refreshForID(id)
 }
 
+// RowSelection returns the model that tracks which rows of this table are selected.
+// Use its SetMode method to allow more than one row to be selected at a time,
+// with Ctrl-click toggling and Shift-click extending the selected rows.
+//
+// Since: 2.6
+func (t *Table) RowSelection() *SelectionModel {
+	if t.rowSelection == nil {
+		t.rowSelection = newSelectionModel(func(selected, unselected []int) {
+			t.Refresh()
+		})
+	}
+	return t.rowSelection
+}
+
 // Select will mark the specified cell as selected.
 func (t *Table) Select(id TableCellID) {
 	if t.Length == nil {
@@ -319,6 +676,415 @@ func (t *Table) SetColumnWidth(id int, width float32) {
 	t.Refresh()
 }
 
+// SetColumnWidthLimits constrains user-driven resizing of the specified column to the given
+// minimum and maximum widths. Passing 0 for either value leaves that bound unset.
+// The limits do not apply to widths set programmatically with SetColumnWidth.
+//
+// Since: 2.6
+func (t *Table) SetColumnWidthLimits(id int, min, max float32) {
+	t.propertyLock.Lock()
+	if t.columnLimits == nil {
+		t.columnLimits = make(map[int]tableWidthLimit)
+	}
+	t.columnLimits[id] = tableWidthLimit{min: min, max: max}
+	t.propertyLock.Unlock()
+}
+
+// SetCellSpan merges the cell identified by id so it stretches across the given number
+// of rows and columns. The covered neighbour cells are no longer drawn and taps within
+// the merged area select the spanning cell. A span of 1, 1 restores the regular layout.
+//
+// Since: 2.6
+func (t *Table) SetCellSpan(id TableCellID, rowSpan, colSpan int) {
+	t.propertyLock.Lock()
+	if rowSpan <= 1 && colSpan <= 1 {
+		delete(t.cellSpans, id)
+	} else {
+		if t.cellSpans == nil {
+			t.cellSpans = make(map[TableCellID]tableCellSpan)
+		}
+		if rowSpan < 1 {
+			rowSpan = 1
+		}
+		if colSpan < 1 {
+			colSpan = 1
+		}
+		t.cellSpans[id] = tableCellSpan{rows: rowSpan, cols: colSpan}
+	}
+	t.propertyLock.Unlock()
+	t.Refresh()
+}
+
+// spanAnchor returns the cell whose span covers id, which is id itself when not merged.
+func (t *Table) spanAnchor(id TableCellID) TableCellID {
+	for anchor, span := range t.cellSpans {
+		if id.Row >= anchor.Row && id.Row < anchor.Row+span.rows &&
+			id.Col >= anchor.Col && id.Col < anchor.Col+span.cols {
+			return anchor
+		}
+	}
+	return id
+}
+
+// SetFilter hides the rows for which the passed function returns false, without the
+// app rebuilding its data callbacks. The filter is re-applied whenever the table
+// refreshes, and a nil filter shows every row again.
+// Use SourceRow to map the visible row indices back to the unfiltered data.
+//
+// Since: 2.6
+func (t *Table) SetFilter(filter func(row int) bool) {
+	paged := t.pageSrcLength != nil // the filter layer sits below any paging wrapper
+	if filter == nil {
+		if t.filter != nil {
+			if paged {
+				t.pageSrcLength, t.pageSrcUpdate = t.srcLength, t.srcUpdate
+			} else {
+				t.Length, t.UpdateCell = t.srcLength, t.srcUpdate
+			}
+			t.filter, t.filteredRows = nil, nil
+			t.srcLength, t.srcUpdate = nil, nil
+			t.Refresh()
+		}
+		return
+	}
+
+	if t.filter == nil {
+		if paged {
+			t.srcLength, t.srcUpdate = t.pageSrcLength, t.pageSrcUpdate
+		} else {
+			t.srcLength, t.srcUpdate = t.Length, t.UpdateCell
+		}
+		length := func() (rows int, cols int) {
+			cols = 0
+			if f := t.srcLength; f != nil {
+				_, cols = f()
+			}
+			return len(t.filteredRows), cols
+		}
+		update := func(id TableCellID, template fyne.CanvasObject) {
+			if f := t.srcUpdate; f != nil {
+				f(TableCellID{Row: t.SourceRow(id.Row), Col: id.Col}, template)
+			}
+		}
+		if paged {
+			t.pageSrcLength, t.pageSrcUpdate = length, update
+		} else {
+			t.Length, t.UpdateCell = length, update
+		}
+	}
+	t.filter = filter
+	if paged {
+		t.refilter()
+		t.SetPage(t.page) // fewer visible rows may shrink the page count
+	} else {
+		t.Refresh()
+	}
+}
+
+// SourceRow maps a visible row index back to the matching row of the unfiltered data.
+// Without an active filter it returns the passed index unchanged.
+//
+// Since: 2.6
+func (t *Table) SourceRow(row int) int {
+	if t.filter == nil {
+		return row
+	}
+	if row < 0 || row >= len(t.filteredRows) {
+		return noCellMatch
+	}
+	return t.filteredRows[row]
+}
+
+// ColumnTextFilter returns a filter for SetFilter that matches rows whose cell text
+// in the given column contains the query, ignoring case. The cell text is resolved
+// through the CellValue callback if set, falling back to rendering the cell.
+//
+// Since: 2.6
+func (t *Table) ColumnTextFilter(col int, query string) func(row int) bool {
+	query = strings.ToLower(query)
+
+	var template fyne.CanvasObject
+	return func(row int) bool {
+		update := t.UpdateCell
+		if t.srcUpdate != nil {
+			update = t.srcUpdate
+		}
+		id := TableCellID{Row: row, Col: col}
+		text := ""
+		if f := t.CellValue; f != nil {
+			text = f(id)
+		} else if update != nil {
+			if template == nil && t.CreateCell != nil {
+				template = createItemAndApplyThemeScope(t.CreateCell, t) // don't use cache, we need new template
+			}
+			if template != nil {
+				update(id, template)
+				text = renderedText(template)
+			}
+		}
+		return strings.Contains(strings.ToLower(text), query)
+	}
+}
+
+// Page returns the page of rows currently shown, counting from 0.
+// It is always 0 until paging is enabled with SetPageSize.
+//
+// Since: 2.6
+func (t *Table) Page() int {
+	return t.page
+}
+
+// PageCount returns how many pages the rows are split across, at least 1.
+//
+// Since: 2.6
+func (t *Table) PageCount() int {
+	if t.pageSize <= 0 {
+		return 1
+	}
+	rows := 0
+	if f := t.pageSrcLength; f != nil {
+		rows, _ = f()
+	}
+	if rows <= 0 {
+		return 1
+	}
+	return (rows + t.pageSize - 1) / t.pageSize
+}
+
+// SetPage shows the given page of rows, clamped to the valid page range.
+// It does nothing until paging is enabled with SetPageSize.
+//
+// Since: 2.6
+func (t *Table) SetPage(page int) {
+	if t.pageSize <= 0 {
+		return
+	}
+	if max := t.PageCount() - 1; page > max {
+		page = max
+	}
+	if page < 0 {
+		page = 0
+	}
+	t.page = page
+	t.ScrollToTop()
+	t.Refresh()
+}
+
+// SetPageSize splits the rows of this table into pages of the given size, showing a
+// single page at a time as an alternative to scrolling the whole set. The Length and
+// UpdateCell callbacks keep describing the full data, and any row filter applies
+// before the rows are split, whichever of the two is enabled first. Use SetPage and
+// PageCount, or a PagerControls widget, to navigate. A size of 0 or less returns the
+// table to continuous scrolling.
+//
+// Since: 2.6
+func (t *Table) SetPageSize(size int) {
+	if size <= 0 {
+		if t.pageSrcLength != nil {
+			t.Length, t.UpdateCell = t.pageSrcLength, t.pageSrcUpdate
+			t.pageSrcLength, t.pageSrcUpdate = nil, nil
+		}
+		t.pageSize = 0
+		t.page = 0
+		t.Refresh()
+		return
+	}
+
+	if t.pageSrcLength == nil {
+		t.pageSrcLength, t.pageSrcUpdate = t.Length, t.UpdateCell
+		t.Length = func() (rows int, cols int) {
+			if f := t.pageSrcLength; f != nil {
+				rows, cols = f()
+			}
+			remain := rows - t.page*t.pageSize
+			if remain <= 0 {
+				return 0, cols
+			}
+			if remain > t.pageSize {
+				return t.pageSize, cols
+			}
+			return remain, cols
+		}
+		t.UpdateCell = func(id TableCellID, template fyne.CanvasObject) {
+			if f := t.pageSrcUpdate; f != nil {
+				f(TableCellID{Row: t.SourceIndex(id.Row), Col: id.Col}, template)
+			}
+		}
+	}
+	t.pageSize = size
+	t.SetPage(t.page)
+}
+
+// SourceIndex returns the row index in the paged data of the row shown at the given
+// visible position, accounting for any active paging. An active row filter is not
+// undone here, pass the result through SourceRow for the unfiltered row.
+//
+// Since: 2.6
+func (t *Table) SourceIndex(row int) int {
+	if t.pageSize <= 0 {
+		return row
+	}
+	return t.page*t.pageSize + row
+}
+
+// refilter recomputes the mapping of visible rows to data rows for the active filter.
+func (t *Table) refilter() {
+	if t.filter == nil {
+		return
+	}
+
+	rows := 0
+	if f := t.srcLength; f != nil {
+		rows, _ = f()
+	}
+	visible := make([]int, 0, rows)
+	for i := 0; i < rows; i++ {
+		if t.filter(i) {
+			visible = append(visible, i)
+		}
+	}
+	t.filteredRows = visible
+}
+
+// Refresh updates this table to the latest data, re-applying any active row filter.
+func (t *Table) Refresh() {
+	t.refilter()
+	t.measureRowHeights()
+	t.BaseWidget.Refresh()
+}
+
+// Resize updates the size of this table, recomputing any automatic row heights.
+func (t *Table) Resize(size fyne.Size) {
+	resized := size != t.Size()
+	t.BaseWidget.Resize(size)
+	if resized && t.AutoRowHeight {
+		t.Refresh()
+	}
+}
+
+// measureRowHeights sizes every row to its tallest cell when AutoRowHeight is set.
+func (t *Table) measureRowHeights() {
+	if !t.AutoRowHeight {
+		return
+	}
+	create, update := t.CreateCell, t.UpdateCell
+	if create == nil || update == nil {
+		return
+	}
+	rows, cols := 0, 0
+	if f := t.Length; f != nil {
+		rows, cols = f()
+	}
+
+	template := createItemAndApplyThemeScope(create, t) // don't use cache, we need new template
+	base := template.MinSize().Height
+	heights := make(map[int]float32, rows)
+	for row := 0; row < rows; row++ {
+		height := base
+		for col := 0; col < cols; col++ {
+			width := t.cellSize.Width
+			t.propertyLock.RLock()
+			if set, ok := t.columnWidths[col]; ok {
+				width = set
+			}
+			t.propertyLock.RUnlock()
+
+			update(TableCellID{row, col}, template)
+			height = fyne.Max(height, t.cellContentHeight(template, width))
+		}
+		heights[row] = height
+	}
+
+	t.propertyLock.Lock()
+	if t.rowHeights == nil {
+		t.rowHeights = make(map[int]float32)
+	}
+	for row, height := range heights {
+		t.rowHeights[row] = height
+	}
+	t.propertyLock.Unlock()
+}
+
+// cellContentHeight measures the height the given cell template requires at the chosen
+// column width, enabling word wrapping on label content narrower than its natural size.
+func (t *Table) cellContentHeight(cell fyne.CanvasObject, width float32) float32 {
+	min := cell.MinSize()
+	if width <= 0 || min.Width <= width {
+		return min.Height
+	}
+
+	label, ok := cell.(*Label)
+	if !ok {
+		return min.Height
+	}
+	wrapping := label.Wrapping
+	label.Wrapping = fyne.TextWrapWord
+	label.Refresh() // sync the wrap mode to the text before laying out
+	label.Resize(fyne.NewSize(width, min.Height))
+	height := cache.Renderer(label).MinSize().Height
+	label.Wrapping = wrapping
+	label.Refresh()
+	return height
+}
+
+// clampAdd returns v moved by delta, kept within 0 to max and safe from overflow.
+func clampAdd(v, delta, max int) int {
+	if delta > 0 {
+		if delta >= max-v {
+			return max
+		}
+	} else if -delta >= v {
+		return 0
+	}
+	return v + delta
+}
+
+// clampColumnWidth applies any width limits configured for the given column.
+func (t *Table) clampColumnWidth(id int, width float32) float32 {
+	t.propertyLock.RLock()
+	lim, ok := t.columnLimits[id]
+	t.propertyLock.RUnlock()
+	if !ok {
+		return width
+	}
+	if lim.min > 0 && width < lim.min {
+		width = lim.min
+	}
+	if lim.max > 0 && width > lim.max {
+		width = lim.max
+	}
+	return width
+}
+
+// autoFitColumn sizes the given column to fit the widest of its currently visible cells.
+func (t *Table) autoFitColumn(id int) {
+	create, update := t.CreateCell, t.UpdateCell
+	if create == nil || update == nil {
+		return
+	}
+	rows := 0
+	if f := t.Length; f != nil {
+		rows, _ = f()
+	}
+
+	template := createItemAndApplyThemeScope(create, t) // don't use cache, we need new template
+	width := float32(0)
+	_, _, minRow, maxRow := t.visibleRowHeights(t.cellSize.Height, rows)
+	for row := minRow; row < maxRow; row++ {
+		update(TableCellID{row, id}, template)
+		width = fyne.Max(width, template.MinSize().Width)
+	}
+	if width == 0 {
+		return
+	}
+
+	width = t.clampColumnWidth(id, width)
+	t.SetColumnWidth(id, width)
+	if f := t.OnColumnResized; f != nil {
+		f(id, width)
+	}
+}
+
 // SetRowHeight supports changing the height of the specified row. Rows normally take the height of the template
 // cell returned from the CreateCell callback. The height parameter uses the same units as a fyne.Size type and refers
 // to the internal content height not including the divider size.
@@ -368,6 +1134,10 @@ func (t *Table) TypedKey(event *fyne.KeyEvent) {
 	switch event.Name {
 	case fyne.KeySpace:
 		t.Select(t.currentFocus)
+	case fyne.KeyReturn, fyne.KeyEnter:
+		if t.Editable {
+			t.EditCell(t.currentFocus)
+		}
 	case fyne.KeyDown:
 		if f := t.Length; f != nil {
 			rows, _ := f()
@@ -406,14 +1176,223 @@ func (t *Table) TypedKey(event *fyne.KeyEvent) {
 		t.currentFocus.Row--
 		t.ScrollTo(t.currentFocus)
 		t.RefreshItem(t.currentFocus)
+	case fyne.KeyPageDown:
+		t.moveFocus(t.pageRowCount(), 0)
+	case fyne.KeyPageUp:
+		t.moveFocus(-t.pageRowCount(), 0)
+	case fyne.KeyHome:
+		t.moveFocus(-math.MaxInt, 0)
+	case fyne.KeyEnd:
+		t.moveFocus(math.MaxInt, 0)
 	}
 }
 
 // TypedRune is called if a text event happens while this Table is focused.
+// Typing jumps to and selects the next cell whose content starts with the typed text.
 //
 // Implements: fyne.Focusable
-func (t *Table) TypedRune(_ rune) {
-	// intentionally left blank
+func (t *Table) TypedRune(r rune) {
+	now := time.Now()
+	if now.Sub(t.typedTime) > typeAheadTimeout {
+		t.typedChars = t.typedChars[:0]
+	}
+	t.typedTime = now
+	t.typedChars = append(t.typedChars, r)
+
+	if id, ok := t.findTyped(string(t.typedChars)); ok {
+		t.RefreshItem(t.currentFocus)
+		t.currentFocus = id
+		t.ScrollTo(id)
+		t.Select(id)
+	}
+}
+
+// findTyped looks for the first cell at or after the focused one whose text starts with
+// the given prefix, wrapping around the end of the data.
+func (t *Table) findTyped(prefix string) (TableCellID, bool) {
+	rows, cols := 0, 0
+	if f := t.Length; f != nil {
+		rows, cols = f()
+	}
+	if rows == 0 || cols == 0 {
+		return TableCellID{}, false
+	}
+
+	var template fyne.CanvasObject
+	if t.CellValue == nil {
+		if t.CreateCell == nil {
+			return TableCellID{}, false
+		}
+		template = createItemAndApplyThemeScope(t.CreateCell, t) // don't use cache, we need new template
+	}
+
+	prefix = strings.ToLower(prefix)
+	start := t.currentFocus.Row*cols + t.currentFocus.Col
+	count := rows * cols
+	for i := 0; i < count; i++ {
+		offset := (start + i) % count
+		id := TableCellID{Row: offset / cols, Col: offset % cols}
+		if strings.HasPrefix(strings.ToLower(t.cellText(id, template)), prefix) {
+			return id, true
+		}
+	}
+	return TableCellID{}, false
+}
+
+// moveFocus shifts the focused cell by the given number of rows and columns, clamping
+// to the data size, then scrolls the new focus into view.
+func (t *Table) moveFocus(rowDelta, colDelta int) {
+	rows, cols := 0, 0
+	if f := t.Length; f != nil {
+		rows, cols = f()
+	}
+	if rows == 0 || cols == 0 {
+		return
+	}
+
+	next := TableCellID{Row: clampAdd(t.currentFocus.Row, rowDelta, rows-1),
+		Col: clampAdd(t.currentFocus.Col, colDelta, cols-1)}
+	if next == t.currentFocus {
+		return
+	}
+	t.RefreshItem(t.currentFocus)
+	t.currentFocus = next
+	t.ScrollTo(next)
+	t.RefreshItem(next)
+}
+
+// pageRowCount returns the number of rows that fit in the visible part of the table,
+// used as the step for the page up and down keys.
+func (t *Table) pageRowCount() int {
+	if t.content == nil || t.cellSize.Height <= 0 {
+		return 1
+	}
+
+	count := int(t.content.Size().Height / (t.cellSize.Height + t.Theme().Size(theme.SizeNamePadding)))
+	if count < 1 {
+		return 1
+	}
+	return count
+}
+
+// TypedShortcut is called if a shortcut was received while this Table is focused,
+// copying the selection to the clipboard for the copy shortcut.
+//
+// Implements: fyne.Shortcutable
+//
+// Since: 2.6
+func (t *Table) TypedShortcut(s fyne.Shortcut) {
+	if sc, ok := s.(*fyne.ShortcutCopy); ok && sc.Clipboard != nil {
+		var buf strings.Builder
+		if err := t.exportSeparated(&buf, '\t'); err == nil {
+			sc.Clipboard.SetContent(buf.String())
+		}
+	}
+}
+
+// CopySelection copies the selected rows, or the row of the selected cell, to the
+// system clipboard as tab separated text ready to paste into spreadsheet applications.
+// When nothing is selected the whole table is copied.
+//
+// Since: 2.6
+func (t *Table) CopySelection() {
+	windows := fyne.CurrentApp().Driver().AllWindows()
+	if len(windows) == 0 {
+		return
+	}
+
+	var buf strings.Builder
+	if err := t.exportSeparated(&buf, '\t'); err != nil {
+		fyne.LogError("Failed to serialize table selection", err)
+		return
+	}
+	windows[0].Clipboard().SetContent(buf.String())
+}
+
+// ExportCSV writes the selected rows, or the whole table when nothing is selected,
+// to the given writer as comma separated values using the existing data callbacks.
+//
+// Since: 2.6
+func (t *Table) ExportCSV(w io.Writer) error {
+	return t.exportSeparated(w, ',')
+}
+
+// exportSeparated serializes the selected rows, or every row, with the given separator.
+func (t *Table) exportSeparated(w io.Writer, comma rune) error {
+	rows, cols := 0, 0
+	if f := t.Length; f != nil {
+		rows, cols = f()
+	}
+
+	var exported []int
+	if sel := t.rowSelection; sel != nil && len(sel.Selected()) > 0 {
+		exported = sel.Selected()
+	} else if t.selectedCell != nil {
+		exported = []int{t.selectedCell.Row}
+	} else {
+		exported = make([]int, rows)
+		for i := range exported {
+			exported[i] = i
+		}
+	}
+
+	var template fyne.CanvasObject
+	if t.CellValue == nil && t.CreateCell != nil {
+		template = createItemAndApplyThemeScope(t.CreateCell, t) // don't use cache, we need new template
+	}
+
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+	record := make([]string, cols)
+	for _, row := range exported {
+		if row < 0 || row >= rows {
+			continue
+		}
+		for col := 0; col < cols; col++ {
+			record[col] = t.cellText(TableCellID{Row: row, Col: col}, template)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// cellText resolves the textual content of a cell, preferring the CellValue callback
+// and falling back to rendering the cell into the given template.
+func (t *Table) cellText(id TableCellID, template fyne.CanvasObject) string {
+	if f := t.CellValue; f != nil {
+		return f(id)
+	}
+	if f := t.UpdateCell; f != nil && template != nil {
+		f(id, template)
+		return renderedText(template)
+	}
+	return ""
+}
+
+// renderedText extracts the visible text from common cell content objects.
+func renderedText(o fyne.CanvasObject) string {
+	switch c := o.(type) {
+	case *Label:
+		return c.Text
+	case *Entry:
+		return c.Text
+	case *RichText:
+		return c.String()
+	case *canvas.Text:
+		return c.Text
+	case *fyne.Container:
+		var parts []string
+		for _, child := range c.Objects {
+			if text := renderedText(child); text != "" {
+				parts = append(parts, text)
+			}
+		}
+		return strings.Join(parts, " ")
+	}
+	return ""
 }
 
 // Unselect will mark the cell provided by id as unselected.
@@ -590,6 +1569,21 @@ func (t *Table) Tapped(e *fyne.PointEvent) {
 		return
 	}
 
+	if f := t.OnHeaderTapped; f != nil {
+		if t.ShowHeaderRow && e.Position.Y < t.headerSize.Height {
+			if col := t.columnAt(e.Position); col >= 0 {
+				f(TableCellID{Row: -1, Col: col})
+				return
+			}
+		}
+		if t.ShowHeaderColumn && e.Position.X < t.headerSize.Width {
+			if row := t.rowAt(e.Position); row >= 0 {
+				f(TableCellID{Row: row, Col: -1})
+				return
+			}
+		}
+	}
+
 	col := t.columnAt(e.Position)
 	if col == noCellMatch {
 		return // out of col range
@@ -598,6 +1592,16 @@ func (t *Table) Tapped(e *fyne.PointEvent) {
 	if row == noCellMatch {
 		return // out of row range
 	}
+	if anchor := t.spanAnchor(TableCellID{row, col}); anchor.Row != row || anchor.Col != col {
+		row, col = anchor.Row, anchor.Col // merged cells select their spanning cell
+	}
+	if sel := t.rowSelection; sel != nil {
+		if sel.Mode() == SelectionModeMulti && t.tapModifier != 0 {
+			sel.tap(row, t.tapModifier)
+			return
+		}
+		sel.Select(row)
+	}
 	t.Select(TableCellID{row, col})
 
 	if !fyne.CurrentDevice().IsMobile() {
@@ -1202,6 +2206,7 @@ type tableCellsRenderer struct {
 	pool, headerPool async.Pool[fyne.CanvasObject]
 	visible, headers map[TableCellID]fyne.CanvasObject
 	hover, marker    *canvas.Rectangle
+	rowMarkers       []*canvas.Rectangle
 	dividers         []fyne.CanvasObject
 
 	headColBG, headRowBG, headRowStickyBG, headColStickyBG *canvas.Rectangle
@@ -1314,6 +2319,10 @@ func (r *tableCellsRenderer) refreshForID(toDraw TableCellID) {
 	displayCol := func(row, col int, rowHeight float32, cells *[]fyne.CanvasObject) {
 		id := TableCellID{row, col}
 		colWidth := visibleColWidths[col]
+		if anchor := r.cells.t.spanAnchor(id); anchor != id {
+			cellXOffset += colWidth + separatorThickness // covered by a merged neighbour
+			return
+		}
 		c, ok := wasVisible[id]
 		if !ok {
 			c = r.pool.Get()
@@ -1325,8 +2334,18 @@ func (r *tableCellsRenderer) refreshForID(toDraw TableCellID) {
 			}
 		}
 
+		cellWidth, cellHeight := colWidth, rowHeight
+		if span, ok := r.cells.t.cellSpans[id]; ok {
+			for i := 1; i < span.cols; i++ {
+				cellWidth += separatorThickness + visibleColWidths[col+i]
+			}
+			for i := 1; i < span.rows; i++ {
+				cellHeight += separatorThickness + visibleRowHeights[row+i]
+			}
+		}
+
 		c.Move(fyne.NewPos(cellXOffset, cellYOffset))
-		c.Resize(fyne.NewSize(colWidth, rowHeight))
+		c.Resize(fyne.NewSize(cellWidth, cellHeight))
 
 		r.visible[id] = c
 		*cells = append(*cells, c)
@@ -1415,6 +2434,10 @@ func (r *tableCellsRenderer) refreshForID(toDraw TableCellID) {
 	r.hover.FillColor = th.Color(theme.ColorNameHover, v)
 	r.hover.CornerRadius = th.Size(theme.SizeNameSelectionRadius)
 	r.hover.Refresh()
+	for _, m := range r.rowMarkers {
+		m.FillColor = th.Color(theme.ColorNameSelection, v)
+		m.Refresh()
+	}
 }
 
 func (r *tableCellsRenderer) moveIndicators() {
@@ -1438,6 +2461,7 @@ func (r *tableCellsRenderer) moveIndicators() {
 	if r.cells.t.ShowHeaderRow {
 		offY += r.cells.t.headerSize.Height
 	}
+	r.moveRowMarkers(offY, minRow, visibleRowHeights)
 	if r.cells.t.selectedCell == nil {
 		r.moveMarker(r.marker, -1, -1, offX, offY, minCol, minRow, visibleColWidths, visibleRowHeights)
 	} else {
@@ -1472,9 +2496,7 @@ func (r *tableCellsRenderer) moveIndicators() {
 			r.dividers = append(r.dividers, NewSeparator())
 		}
 
-		objs := []fyne.CanvasObject{r.marker, r.hover}
-		r.cells.t.dividerLayer.Content.(*fyne.Container).Objects = append(objs, r.dividers...)
-		r.cells.t.dividerLayer.Content.Refresh()
+		r.refreshDividerLayer()
 	}
 
 	size := r.cells.t.size.Load()
@@ -1531,6 +2553,93 @@ func (r *tableCellsRenderer) moveIndicators() {
 	}
 }
 
+// refreshDividerLayer rebuilds the layer holding the selection indicators and dividers,
+// keeping the row markers below the cell marker so both remain visible.
+func (r *tableCellsRenderer) refreshDividerLayer() {
+	objs := make([]fyne.CanvasObject, 0, len(r.rowMarkers)+2+len(r.dividers))
+	for _, m := range r.rowMarkers {
+		objs = append(objs, m)
+	}
+	objs = append(objs, r.marker, r.hover)
+	r.cells.t.dividerLayer.Content.(*fyne.Container).Objects = append(objs, r.dividers...)
+	r.cells.t.dividerLayer.Content.Refresh()
+}
+
+// moveRowMarkers positions a highlight behind each selected row, pooling the rectangles.
+func (r *tableCellsRenderer) moveRowMarkers(offY float32, minRow int, heights map[int]float32) {
+	var selected []int
+	if sel := r.cells.t.rowSelection; sel != nil {
+		selected = sel.Selected()
+	}
+	if len(selected) == 0 && len(r.rowMarkers) == 0 {
+		return
+	}
+
+	if len(r.rowMarkers) < len(selected) {
+		th := r.cells.t.Theme()
+		v := fyne.CurrentApp().Settings().ThemeVariant()
+		for i := len(r.rowMarkers); i < len(selected); i++ {
+			m := canvas.NewRectangle(th.Color(theme.ColorNameSelection, v))
+			m.CornerRadius = th.Size(theme.SizeNameSelectionRadius)
+			r.rowMarkers = append(r.rowMarkers, m)
+		}
+		r.refreshDividerLayer()
+	}
+	for i, m := range r.rowMarkers {
+		if i >= len(selected) {
+			m.Hide()
+			m.Refresh()
+			continue
+		}
+		r.moveRowMarker(m, selected[i], offY, minRow, heights)
+	}
+}
+
+// moveRowMarker positions one row highlight, spanning the full width of the table.
+func (r *tableCellsRenderer) moveRowMarker(marker fyne.CanvasObject, row int, offY float32, minRow int, heights map[int]float32) {
+	if _, ok := heights[row]; !ok {
+		marker.Hide()
+		marker.Refresh()
+		return
+	}
+
+	padding := r.cells.t.Theme().Size(theme.SizeNamePadding)
+	yPos := offY
+	stickRows := r.cells.t.StickyRowCount
+	if row < stickRows {
+		if r.cells.t.ShowHeaderRow {
+			yPos = r.cells.t.stuckYOff
+		} else {
+			yPos = 0
+		}
+		minRow = 0
+	}
+	for i := minRow; i < row; i++ {
+		yPos += heights[i]
+		yPos += padding
+	}
+	y1 := yPos
+	if row >= stickRows {
+		y1 -= r.cells.t.content.Offset.Y
+	}
+	y2 := y1 + heights[row]
+
+	size := r.cells.t.size.Load()
+	if y2 < 0 || y1 > size.Height {
+		marker.Hide()
+	} else {
+		top := y1
+		if row >= stickRows { // clip Y
+			top = fyne.Max(r.cells.t.stuckYOff+r.cells.t.stuckHeight, y1)
+		}
+		marker.Move(fyne.NewPos(0, top))
+		marker.Resize(fyne.NewSize(size.Width, y2-top))
+
+		marker.Show()
+	}
+	marker.Refresh()
+}
+
 func (r *tableCellsRenderer) moveMarker(marker fyne.CanvasObject, row, col int, offX, offY float32, minCol, minRow int, widths, heights map[int]float32) {
 	if col == -1 || row == -1 {
 		marker.Hide()
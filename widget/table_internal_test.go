@@ -1,12 +1,15 @@
 package widget
 
 import (
+	"bytes"
 	"fmt"
 	"image/color"
 	"testing"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/data/binding"
 	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/test"
 	"fyne.io/fyne/v2/theme"
@@ -783,6 +786,90 @@ func TestTable_SetColumnWidth_Dragged(t *testing.T) {
 	assert.Equal(t, table.cellSize.Width+5, table.columnWidths[1])
 }
 
+func TestTable_SetColumnWidthLimits_Dragged(t *testing.T) {
+	test.NewApp()
+
+	table := NewTableWithHeaders(
+		func() (int, int) { return 5, 5 },
+		func() fyne.CanvasObject {
+			return NewLabel("")
+		},
+		func(id TableCellID, obj fyne.CanvasObject) {
+		})
+	table.ShowHeaderColumn = false
+	table.StickyColumnCount = 0
+	resized := make(map[int]float32)
+	table.OnColumnResized = func(col int, width float32) {
+		resized[col] = width
+	}
+	table.Refresh()
+
+	c := test.NewCanvas()
+	c.SetPadded(false)
+	c.SetContent(table)
+	c.Resize(fyne.NewSize(120, 120))
+	table.SetColumnWidthLimits(1, 0, table.cellSize.Width+10)
+
+	dragPos := fyne.NewPos(table.cellSize.Width*2+theme.Padding()+2, 2) // gap between col 1 and 2
+	table.MouseMoved(&desktop.MouseEvent{PointEvent: fyne.PointEvent{Position: dragPos}})
+	table.MouseDown(&desktop.MouseEvent{PointEvent: fyne.PointEvent{Position: dragPos}})
+	test.Drag(c, dragPos.AddXY(25, 0), 25, 0) // try to expand past the max
+
+	assert.Equal(t, table.cellSize.Width+10, table.columnWidths[1])
+	assert.Equal(t, table.cellSize.Width+10, resized[1])
+}
+
+func TestTable_EditCell(t *testing.T) {
+	test.NewApp()
+
+	values := map[TableCellID]string{}
+	table := NewTable(
+		func() (int, int) { return 3, 3 },
+		func() fyne.CanvasObject {
+			return NewLabel("")
+		},
+		func(id TableCellID, obj fyne.CanvasObject) {
+		})
+	table.Editable = true
+	table.CellValue = func(id TableCellID) string {
+		return fmt.Sprintf("Cell %d, %d", id.Row, id.Col)
+	}
+	table.OnCellChanged = func(id TableCellID, value string) {
+		values[id] = value
+	}
+
+	w := test.NewWindow(table)
+	defer w.Close()
+	w.Resize(fyne.NewSize(180, 180))
+	c := w.Canvas()
+
+	table.EditCell(TableCellID{1, 1})
+	pop, ok := c.Overlays().Top().(*PopUp)
+	if !ok {
+		t.Fatal("Editing a cell should show an editor pop-up")
+	}
+	editor := pop.Content.(*tableCellEditor)
+	assert.Equal(t, "Cell 1, 1", editor.Text)
+
+	editor.SetText("edited")
+	editor.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, "edited", values[TableCellID{1, 1}])
+	assert.Nil(t, c.Overlays().Top())
+
+	table.EditCell(TableCellID{0, 0})
+	editor = c.Overlays().Top().(*PopUp).Content.(*tableCellEditor)
+	editor.TypedKey(&fyne.KeyEvent{Name: fyne.KeyTab}) // commit and move to the next cell
+
+	assert.Equal(t, "Cell 0, 0", values[TableCellID{0, 0}])
+	editor = c.Overlays().Top().(*PopUp).Content.(*tableCellEditor)
+	assert.Equal(t, "Cell 0, 1", editor.Text)
+
+	editor.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEscape}) // cancel does not commit
+	assert.Nil(t, c.Overlays().Top())
+	_, changed := values[TableCellID{0, 1}]
+	assert.False(t, changed)
+}
+
 func TestTable_SetRowHeight(t *testing.T) {
 	test.NewTempApp(t)
 	test.ApplyTheme(t, test.Theme())
@@ -916,3 +1003,298 @@ func areaContainsLabel(list []fyne.CanvasObject, text string) bool {
 	}
 	return false
 }
+
+func TestNewTableWithData(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+	data := binding.NewUntypedList()
+	data.Append(person{Name: "Alice", Age: 30})
+	data.Append(map[string]any{"Name": "Bob", "Age": 25})
+
+	table := NewTableWithData(data, []TableColumn{
+		{Title: "Name", Key: "Name"},
+		{Title: "Age", Key: "Age", Format: func(row any) string {
+			if p, ok := row.(person); ok {
+				return fmt.Sprintf("%d years", p.Age)
+			}
+			return "unknown"
+		}},
+	})
+
+	rows, cols := table.Length()
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, 2, cols)
+	assert.True(t, table.ShowHeaderRow)
+
+	cell := table.CreateCell()
+	table.UpdateCell(TableCellID{Row: 0, Col: 0}, cell)
+	assert.Equal(t, "Alice", cell.(*Label).Text)
+	table.UpdateCell(TableCellID{Row: 1, Col: 0}, cell)
+	assert.Equal(t, "Bob", cell.(*Label).Text)
+	table.UpdateCell(TableCellID{Row: 0, Col: 1}, cell)
+	assert.Equal(t, "30 years", cell.(*Label).Text)
+
+	header := table.createHeader()
+	table.UpdateHeader(TableCellID{Row: -1, Col: 1}, header)
+	assert.Equal(t, "Age", header.(*Label).Text)
+}
+
+func TestTable_ExportCSV(t *testing.T) {
+	table := NewTable(
+		func() (int, int) { return 3, 2 },
+		func() fyne.CanvasObject { return NewLabel("") },
+		func(id TableCellID, o fyne.CanvasObject) {
+			o.(*Label).SetText(fmt.Sprintf("r%dc%d", id.Row, id.Col))
+		})
+
+	var buf bytes.Buffer
+	assert.NoError(t, table.ExportCSV(&buf))
+	assert.Equal(t, "r0c0,r0c1\nr1c0,r1c1\nr2c0,r2c1\n", buf.String())
+
+	sel := table.RowSelection()
+	sel.SetMode(SelectionModeMulti)
+	sel.Select(0)
+	sel.Toggle(2)
+	buf.Reset()
+	assert.NoError(t, table.ExportCSV(&buf))
+	assert.Equal(t, "r0c0,r0c1\nr2c0,r2c1\n", buf.String())
+}
+
+func TestTable_CopyShortcut(t *testing.T) {
+	table := NewTable(
+		func() (int, int) { return 2, 2 },
+		func() fyne.CanvasObject { return NewLabel("") },
+		func(id TableCellID, o fyne.CanvasObject) {
+			o.(*Label).SetText(fmt.Sprintf("r%dc%d", id.Row, id.Col))
+		})
+	table.Select(TableCellID{Row: 1, Col: 0})
+
+	cb := &fakeClipboard{}
+	table.TypedShortcut(&fyne.ShortcutCopy{Clipboard: cb})
+	assert.Equal(t, "r1c0\tr1c1\n", cb.content)
+}
+
+type fakeClipboard struct {
+	content string
+}
+
+func (c *fakeClipboard) Content() string           { return c.content }
+func (c *fakeClipboard) SetContent(content string) { c.content = content }
+
+func TestTable_SetFilter(t *testing.T) {
+	table := NewTable(
+		func() (int, int) { return 4, 2 },
+		func() fyne.CanvasObject { return NewLabel("") },
+		func(id TableCellID, o fyne.CanvasObject) {
+			o.(*Label).SetText(fmt.Sprintf("r%dc%d", id.Row, id.Col))
+		})
+
+	table.SetFilter(func(row int) bool { return row%2 == 0 })
+	rows, cols := table.Length()
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, 2, cols)
+	assert.Equal(t, 2, table.SourceRow(1))
+
+	cell := NewLabel("")
+	table.UpdateCell(TableCellID{Row: 1, Col: 0}, cell)
+	assert.Equal(t, "r2c0", cell.Text)
+
+	table.SetFilter(table.ColumnTextFilter(0, "R3"))
+	rows, _ = table.Length()
+	assert.Equal(t, 1, rows)
+	assert.Equal(t, 3, table.SourceRow(0))
+
+	table.SetFilter(nil)
+	rows, _ = table.Length()
+	assert.Equal(t, 4, rows)
+	assert.Equal(t, 1, table.SourceRow(1))
+}
+
+func TestTable_SetFilterWithPaging(t *testing.T) {
+	table := NewTable(
+		func() (int, int) { return 10, 2 },
+		func() fyne.CanvasObject { return NewLabel("") },
+		func(id TableCellID, o fyne.CanvasObject) {
+			o.(*Label).SetText(fmt.Sprintf("r%dc%d", id.Row, id.Col))
+		})
+
+	table.SetFilter(func(row int) bool { return row%2 == 0 })
+	table.SetPageSize(2)
+	assert.Equal(t, 3, table.PageCount()) // 5 filtered rows in pages of 2
+
+	// clearing the filter must keep the paging wrapper in place
+	table.SetFilter(nil)
+	rows, _ := table.Length()
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, 5, table.PageCount())
+	table.SetPage(1)
+	cell := NewLabel("")
+	table.UpdateCell(TableCellID{Row: 0, Col: 0}, cell)
+	assert.Equal(t, "r2c0", cell.Text)
+
+	// a filter installed while paging is active applies below the pages
+	table.SetFilter(func(row int) bool { return row >= 6 })
+	assert.Equal(t, 2, table.PageCount())
+	assert.Equal(t, 1, table.Page()) // the page is clamped to the new count
+	table.SetPage(0)
+	table.UpdateCell(TableCellID{Row: 1, Col: 0}, cell)
+	assert.Equal(t, "r7c0", cell.Text)
+
+	// disabling paging falls back to the filtered rows
+	table.SetPageSize(0)
+	rows, _ = table.Length()
+	assert.Equal(t, 4, rows)
+	assert.Equal(t, 6, table.SourceRow(0))
+}
+
+func TestTable_SetCellSpan(t *testing.T) {
+	table := NewTable(
+		func() (int, int) { return 3, 3 },
+		func() fyne.CanvasObject { return NewLabel("placeholder") },
+		func(id TableCellID, c fyne.CanvasObject) {
+			c.(*Label).SetText(fmt.Sprintf("Cell %d, %d", id.Row, id.Col))
+		})
+	w := test.NewWindow(table)
+	defer w.Close()
+	w.Resize(fyne.NewSize(360, 240))
+
+	table.SetCellSpan(TableCellID{Row: 0, Col: 0}, 1, 2)
+	assert.Equal(t, TableCellID{Row: 0, Col: 0}, table.spanAnchor(TableCellID{Row: 0, Col: 1}))
+	assert.Equal(t, TableCellID{Row: 2, Col: 1}, table.spanAnchor(TableCellID{Row: 2, Col: 1}))
+
+	cellRenderer := test.TempWidgetRenderer(t, table.content.Content.(*tableCells)).(*tableCellsRenderer)
+	cellRenderer.Refresh()
+
+	anchor, ok := cellRenderer.visible[TableCellID{Row: 0, Col: 0}]
+	assert.True(t, ok)
+	_, covered := cellRenderer.visible[TableCellID{Row: 0, Col: 1}]
+	assert.False(t, covered) // hidden under the merged cell
+	single := cellRenderer.visible[TableCellID{Row: 1, Col: 0}]
+	assert.Greater(t, anchor.Size().Width, single.Size().Width)
+
+	table.SetCellSpan(TableCellID{Row: 0, Col: 0}, 1, 1)
+	cellRenderer.Refresh()
+	_, covered = cellRenderer.visible[TableCellID{Row: 0, Col: 1}]
+	assert.True(t, covered)
+}
+
+func TestTable_AutoRowHeight(t *testing.T) {
+	test.NewTempApp(t)
+	test.ApplyTheme(t, test.Theme())
+
+	table := NewTable(
+		func() (int, int) { return 3, 2 },
+		func() fyne.CanvasObject {
+			return NewLabel("placeholder")
+		},
+		func(id TableCellID, obj fyne.CanvasObject) {
+			if id.Row == 1 && id.Col == 0 {
+				obj.(*Label).Text = "tall\ncell"
+			} else {
+				obj.(*Label).Text = "cell"
+			}
+			obj.Refresh()
+		})
+	table.AutoRowHeight = true
+	table.Resize(fyne.NewSize(200, 200))
+
+	template := NewLabel("placeholder")
+	single := template.MinSize().Height
+	template.SetText("tall\ncell")
+	double := template.MinSize().Height
+
+	assert.Equal(t, single, table.rowHeights[0])
+	assert.Equal(t, double, table.rowHeights[1])
+	assert.Equal(t, single, table.rowHeights[2])
+}
+
+func TestTable_AutoRowHeight_Wrapped(t *testing.T) {
+	test.NewTempApp(t)
+	test.ApplyTheme(t, test.Theme())
+
+	table := NewTable(
+		func() (int, int) { return 2, 1 },
+		func() fyne.CanvasObject {
+			return NewLabel("tmpl")
+		},
+		func(id TableCellID, obj fyne.CanvasObject) {
+			if id.Row == 0 {
+				obj.(*Label).Text = "some text that is too wide for the column"
+			} else {
+				obj.(*Label).Text = "short"
+			}
+			obj.Refresh()
+		})
+	table.AutoRowHeight = true
+	table.SetColumnWidth(0, 100)
+	table.Resize(fyne.NewSize(150, 150))
+
+	short := NewLabel("short").MinSize().Height
+	assert.Equal(t, short, table.rowHeights[1])
+	assert.Greater(t, table.rowHeights[0], short)
+}
+
+func TestTable_FocusKeys(t *testing.T) {
+	test.NewTempApp(t)
+
+	table := NewTable(
+		func() (int, int) { return 50, 3 },
+		func() fyne.CanvasObject {
+			return NewLabel("placeholder")
+		},
+		func(TableCellID, fyne.CanvasObject) {})
+
+	window := test.NewWindow(table)
+	defer window.Close()
+	window.Resize(table.MinSize().Max(fyne.NewSize(300, 200)))
+	window.Canvas().(test.WindowlessCanvas).FocusNext()
+
+	table.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEnd})
+	assert.Equal(t, TableCellID{49, 0}, table.currentFocus)
+
+	table.TypedKey(&fyne.KeyEvent{Name: fyne.KeyHome})
+	assert.Equal(t, TableCellID{0, 0}, table.currentFocus)
+
+	table.TypedKey(&fyne.KeyEvent{Name: fyne.KeyPageDown})
+	page := table.pageRowCount()
+	assert.Equal(t, TableCellID{page, 0}, table.currentFocus)
+
+	table.TypedKey(&fyne.KeyEvent{Name: fyne.KeyPageUp})
+	assert.Equal(t, TableCellID{0, 0}, table.currentFocus)
+}
+
+func TestTable_TypeAhead(t *testing.T) {
+	test.NewTempApp(t)
+
+	data := [][]string{
+		{"apple", "avocado"},
+		{"banana", "blueberry"},
+		{"cherry", "apricot"},
+	}
+	table := NewTable(
+		func() (int, int) { return len(data), 2 },
+		func() fyne.CanvasObject {
+			return NewLabel("placeholder")
+		},
+		func(id TableCellID, o fyne.CanvasObject) {
+			o.(*Label).SetText(data[id.Row][id.Col])
+		})
+
+	window := test.NewWindow(table)
+	defer window.Close()
+	window.Resize(table.MinSize().Max(fyne.NewSize(300, 200)))
+	window.Canvas().(test.WindowlessCanvas).FocusNext()
+
+	table.TypedRune('b')
+	assert.Equal(t, TableCellID{1, 0}, table.currentFocus)
+	assert.Equal(t, &TableCellID{1, 0}, table.selectedCell)
+
+	table.TypedRune('l')
+	assert.Equal(t, TableCellID{1, 1}, table.currentFocus)
+
+	table.typedTime = time.Time{} // expire the prefix
+	table.TypedRune('a')
+	assert.Equal(t, TableCellID{2, 1}, table.currentFocus)
+}
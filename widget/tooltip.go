@@ -0,0 +1,186 @@
+package widget
+
+import (
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+)
+
+// toolTipDelay is how long the pointer must rest over an area before its tool tip shows.
+const toolTipDelay = 600 * time.Millisecond
+
+// ToolTipable is implemented by canvas objects that describe themselves with a
+// short hint, shown after the pointer rests over them inside a ToolTipArea.
+//
+// Since: 2.6
+type ToolTipable interface {
+	// ToolTip returns the hint text to show for this object.
+	ToolTip() string
+}
+
+// Declare conformity with interfaces
+var _ fyne.Widget = (*ToolTipArea)(nil)
+var _ desktop.Hoverable = (*ToolTipArea)(nil)
+
+// ToolTipArea wraps a canvas object so a tool tip pops up near the pointer
+// after it rests over the content. The tip is added to the overlay stack of
+// the containing canvas, so it shows above any overlapping overlays.
+//
+// Since: 2.6
+type ToolTipArea struct {
+	BaseWidget
+	Content fyne.CanvasObject
+
+	// Text is the plain text hint to show. If it is empty and Content
+	// implements ToolTipable, the text is requested from there when shown.
+	Text string
+
+	// TipContent is an optional rich tip, shown in place of Text when set.
+	TipContent fyne.CanvasObject
+
+	mu       sync.Mutex // guards the fields below, the show timer fires off the UI goroutine
+	hoverPos fyne.Position
+	pending  *time.Timer
+	tip      *PopUp
+}
+
+// NewToolTipArea wraps the given object so the specified hint text pops up
+// when the pointer rests over it.
+//
+// Since: 2.6
+func NewToolTipArea(content fyne.CanvasObject, text string) *ToolTipArea {
+	t := &ToolTipArea{Content: content, Text: text}
+	t.ExtendBaseWidget(t)
+	return t
+}
+
+// NewToolTipAreaWithContent wraps the given object so the specified rich tip
+// content pops up when the pointer rests over it.
+//
+// Since: 2.6
+func NewToolTipAreaWithContent(content, tip fyne.CanvasObject) *ToolTipArea {
+	t := &ToolTipArea{Content: content, TipContent: tip}
+	t.ExtendBaseWidget(t)
+	return t
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer
+func (t *ToolTipArea) CreateRenderer() fyne.WidgetRenderer {
+	t.ExtendBaseWidget(t)
+	return NewSimpleRenderer(t.Content)
+}
+
+// Hide this widget, canceling any visible or scheduled tool tip.
+func (t *ToolTipArea) Hide() {
+	t.HideToolTip()
+	t.BaseWidget.Hide()
+}
+
+// HideToolTip dismisses the tool tip, if showing, and cancels a scheduled one.
+//
+// Since: 2.6
+func (t *ToolTipArea) HideToolTip() {
+	t.cancelToolTip()
+
+	t.mu.Lock()
+	tip := t.tip
+	t.tip = nil
+	t.mu.Unlock()
+	if tip != nil {
+		tip.Hide()
+	}
+}
+
+// MouseIn is called when a desktop pointer enters the widget.
+// It schedules the tool tip to show after a short delay.
+func (t *ToolTipArea) MouseIn(e *desktop.MouseEvent) {
+	t.mu.Lock()
+	t.hoverPos = e.AbsolutePosition
+	t.mu.Unlock()
+	t.scheduleToolTip()
+}
+
+// MouseMoved is called when a desktop pointer hovers over the widget.
+// The delay restarts, so the tip only shows once the pointer rests.
+func (t *ToolTipArea) MouseMoved(e *desktop.MouseEvent) {
+	t.mu.Lock()
+	t.hoverPos = e.AbsolutePosition
+	showing := t.tip != nil
+	t.mu.Unlock()
+	if !showing {
+		t.scheduleToolTip()
+	}
+}
+
+// MouseOut is called when a desktop pointer exits the widget
+func (t *ToolTipArea) MouseOut() {
+	t.HideToolTip()
+}
+
+// ShowToolTip presents the tool tip immediately, near the last pointer
+// position or below the content. It can be called directly, for example when
+// the wrapped widget gains keyboard focus.
+//
+// Since: 2.6
+func (t *ToolTipArea) ShowToolTip() {
+	t.cancelToolTip()
+
+	content := t.TipContent
+	if content == nil {
+		text := t.Text
+		if tipable, ok := t.Content.(ToolTipable); ok && text == "" {
+			text = tipable.ToolTip()
+		}
+		if text == "" {
+			return
+		}
+		content = NewLabel(text)
+	}
+
+	d := fyne.CurrentApp().Driver()
+	c := d.CanvasForObject(t.super())
+	if c == nil {
+		return
+	}
+
+	t.mu.Lock()
+	pos := t.hoverPos
+	t.mu.Unlock()
+	if pos.IsZero() { // not hovered, shown for keyboard focus or directly
+		pos = d.AbsolutePositionForObject(t.super())
+		pos.Y += t.Size().Height
+	} else {
+		pos.Y += t.Theme().Size(theme.SizeNamePadding) * 4 // clear the pointer
+	}
+
+	tip := NewPopUp(content, c)
+	t.mu.Lock()
+	old := t.tip
+	t.tip = tip
+	t.mu.Unlock()
+	if old != nil {
+		old.Hide()
+	}
+	tip.ShowAtPosition(pos)
+}
+
+func (t *ToolTipArea) cancelToolTip() {
+	t.mu.Lock()
+	if t.pending != nil {
+		t.pending.Stop()
+		t.pending = nil
+	}
+	t.mu.Unlock()
+}
+
+func (t *ToolTipArea) scheduleToolTip() {
+	t.cancelToolTip()
+
+	timer := time.AfterFunc(toolTipDelay, t.ShowToolTip)
+	t.mu.Lock()
+	t.pending = timer
+	t.mu.Unlock()
+}
@@ -0,0 +1,81 @@
+package widget_test
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToolTipArea_ShowToolTip(t *testing.T) {
+	area := widget.NewToolTipArea(widget.NewLabel("Target"), "A helpful hint")
+	w := test.NewTempWindow(t, area)
+	w.Resize(fyne.NewSize(100, 100))
+
+	assert.Empty(t, w.Canvas().Overlays().List())
+
+	area.MouseIn(&desktop.MouseEvent{PointEvent: fyne.PointEvent{AbsolutePosition: fyne.NewPos(10, 10)}})
+	area.ShowToolTip() // fires immediately, the timer is for hover delay
+	assert.Len(t, w.Canvas().Overlays().List(), 1)
+
+	area.MouseOut()
+	assert.Empty(t, w.Canvas().Overlays().List())
+}
+
+func TestToolTipArea_HoverDelay(t *testing.T) {
+	area := widget.NewToolTipArea(widget.NewLabel("Target"), "A helpful hint")
+	w := test.NewTempWindow(t, area)
+	w.Resize(fyne.NewSize(100, 100))
+
+	area.MouseIn(&desktop.MouseEvent{PointEvent: fyne.PointEvent{AbsolutePosition: fyne.NewPos(10, 10)}})
+	area.MouseMoved(&desktop.MouseEvent{PointEvent: fyne.PointEvent{AbsolutePosition: fyne.NewPos(20, 10)}})
+	assert.Empty(t, w.Canvas().Overlays().List()) // not until the pointer rests
+
+	assert.Eventually(t, func() bool {
+		return len(w.Canvas().Overlays().List()) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	area.MouseOut()
+	assert.Empty(t, w.Canvas().Overlays().List())
+}
+
+// tipLabel describes itself through the ToolTipable interface.
+type tipLabel struct {
+	widget.Label
+}
+
+func (*tipLabel) ToolTip() string { return "Self describing" }
+
+func TestToolTipArea_ToolTipable(t *testing.T) {
+	l := &tipLabel{}
+	l.ExtendBaseWidget(l)
+	area := widget.NewToolTipArea(l, "")
+	w := test.NewTempWindow(t, area)
+	w.Resize(fyne.NewSize(100, 100))
+
+	area.ShowToolTip() // text requested from the wrapped widget
+	assert.Len(t, w.Canvas().Overlays().List(), 1)
+	area.HideToolTip()
+
+	area.Content = widget.NewLabel("Plain")
+	area.ShowToolTip() // no text from any source, nothing to show
+	assert.Empty(t, w.Canvas().Overlays().List())
+}
+
+func TestToolTipArea_RichContent(t *testing.T) {
+	tip := widget.NewRichTextFromMarkdown("**Bold** hint")
+	area := widget.NewToolTipAreaWithContent(widget.NewLabel("Target"), tip)
+	w := test.NewTempWindow(t, area)
+	w.Resize(fyne.NewSize(100, 100))
+
+	area.ShowToolTip()
+	assert.Len(t, w.Canvas().Overlays().List(), 1)
+
+	area.HideToolTip()
+	assert.Empty(t, w.Canvas().Overlays().List())
+}
@@ -0,0 +1,23 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// transitionsEnabled reports whether widget transition animations should run,
+// honouring the global animations setting and any reduced motion preference so
+// users who turned animations off see structural changes instantly.
+func transitionsEnabled() bool {
+	set := fyne.CurrentApp().Settings()
+	return set.ShowAnimations() && !set.ReducedMotion()
+}
+
+// animateMove slides an object to the target position, doing nothing when it
+// is already there.
+func animateMove(o fyne.CanvasObject, target fyne.Position) {
+	if o.Position() == target {
+		return
+	}
+	canvas.NewPositionAnimation(o.Position(), target, canvas.DurationShort, o.Move).Start()
+}
@@ -0,0 +1,100 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The test driver completes animations immediately, so these tests drive the
+// interpolated state by hand and otherwise check that transitions settle on
+// the same layout as an instant change.
+
+func TestAccordion_Animated(t *testing.T) {
+	a := NewAccordion(
+		NewAccordionItem("A", NewLabel("detail a")),
+		NewAccordionItem("B", NewLabel("detail b")),
+	)
+	a.Animated = true
+	w := test.NewTempWindow(t, a)
+	w.Resize(fyne.NewSize(200, 300))
+
+	a.Open(0)
+	assert.True(t, a.Items[0].Open)
+	full := a.Items[0].Detail.Size().Height
+	assert.Greater(t, full, float32(0))
+
+	a.animItem = a.Items[0]
+	a.animFraction = 0.5
+	a.Refresh()
+	assert.InDelta(t, full/2, a.Items[0].Detail.Size().Height, 0.01)
+	assert.True(t, a.Items[0].Detail.Visible())
+
+	a.animItem = nil
+	a.Refresh()
+	assert.Equal(t, full, a.Items[0].Detail.Size().Height)
+
+	a.Close(0)
+	assert.False(t, a.Items[0].Open, "the state is final as soon as Close returns")
+	assert.False(t, a.Items[0].Detail.Visible())
+}
+
+func TestAccordion_Animated_ShrinkVisible(t *testing.T) {
+	a := NewAccordion(NewAccordionItem("A", NewLabel("detail a")))
+	a.Animated = true
+	w := test.NewTempWindow(t, a)
+	w.Resize(fyne.NewSize(200, 300))
+
+	a.Open(0)
+	full := a.Items[0].Detail.Size().Height
+
+	// a closing item keeps its detail visible while the transition shrinks it
+	a.Items[0].Open = false
+	a.animItem = a.Items[0]
+	a.animFraction = 0.25
+	a.Refresh()
+	assert.True(t, a.Items[0].Detail.Visible())
+	assert.InDelta(t, full/4, a.Items[0].Detail.Size().Height, 0.01)
+
+	a.animItem = nil
+	a.Refresh()
+	assert.False(t, a.Items[0].Detail.Visible())
+}
+
+func TestTree_Animated(t *testing.T) {
+	tr := NewTreeWithStrings(map[string][]string{
+		"":        {"animals", "plants"},
+		"animals": {"cat", "dog"},
+	})
+	tr.Animated = true
+	w := test.NewTempWindow(t, tr)
+	w.Resize(fyne.NewSize(200, 300))
+
+	before := visibleTreeNode(tr, "plants").Position()
+	tr.OpenBranch("animals")
+	assert.False(t, tr.animateNextLayout, "the flag only covers the layout pass after a toggle")
+	assert.Greater(t, visibleTreeNode(tr, "plants").Position().Y, before.Y)
+
+	tr.CloseBranch("animals")
+	assert.Equal(t, before, visibleTreeNode(tr, "plants").Position())
+}
+
+func TestList_Animated(t *testing.T) {
+	l := NewList(
+		func() int { return 3 },
+		func() fyne.CanvasObject { return NewLabel("template") },
+		func(id ListItemID, o fyne.CanvasObject) {})
+	l.Animated = true
+	w := test.NewTempWindow(t, l)
+	w.Resize(fyne.NewSize(200, 300))
+
+	before := visibleListItem(l, 1).Position()
+	l.SetItemHeight(0, l.itemMin.Height*2)
+	assert.Greater(t, visibleListItem(l, 1).Position().Y, before.Y)
+
+	l.SetItemHeight(0, l.itemMin.Height)
+	assert.Equal(t, before, visibleListItem(l, 1).Position())
+}
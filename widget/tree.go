@@ -2,6 +2,7 @@ package widget
 
 import (
 	"fmt"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
@@ -45,6 +46,51 @@ type Tree struct {
 	OnUnselected   func(uid TreeNodeID)                                      `json:"-"` // Called when the Node with the given TreeNodeID is unselected.
 	UpdateNode     func(uid TreeNodeID, branch bool, node fyne.CanvasObject) `json:"-"` // Called to update the given CanvasObject to represent the data at the given TreeNodeID
 
+	// NodeMenu returns a context menu for the given node, shown by a secondary
+	// tap such as a right click or a long press on mobile.
+	//
+	// Since: 2.6
+	NodeMenu func(uid TreeNodeID) *fyne.Menu `json:"-"`
+
+	// FilterMatch overrides how Filter decides whether a node matches the query,
+	// for example by testing the text shown in the node rather than its id.
+	//
+	// Since: 2.6
+	FilterMatch func(uid TreeNodeID, query string) bool `json:"-"`
+
+	// ShowCheckboxes adds a checkbox to every node, branches deriving their state
+	// from the nodes below them and showing a partial mark when only some are
+	// checked. Toggling a branch applies the state to its whole subtree.
+	//
+	// Since: 2.6
+	ShowCheckboxes bool
+
+	// OnCheckedChanged is called when the user toggles a checkbox, with the node
+	// that was toggled and its new state.
+	//
+	// Since: 2.6
+	OnCheckedChanged func(uid TreeNodeID, checked bool) `json:"-"`
+
+	// OnNodeDropped is called after the user drags a node onto another, so the
+	// data backing the tree can be re-parented. Setting it makes nodes draggable.
+	//
+	// Since: 2.6
+	OnNodeDropped func(uid, target TreeNodeID) `json:"-"`
+
+	// CanDrop reports whether a dragged node may be dropped on the given target,
+	// all targets accepting when nil. The uid is empty for drops arriving from
+	// another widget, such as a list.
+	//
+	// Since: 2.6
+	CanDrop func(uid, target TreeNodeID) bool `json:"-"`
+
+	// Animated slides the visible nodes to their new positions when a branch is
+	// opened or closed, instead of snapping. It honours the global animations
+	// setting, so reduced motion preferences are respected.
+	//
+	// Since: 2.6
+	Animated bool
+
 	branchMinSize fyne.Size
 	currentFocus  TreeNodeID
 	focused       bool
@@ -53,6 +99,24 @@ type Tree struct {
 	open          map[TreeNodeID]bool
 	scroller      *widget.Scroll
 	selected      []TreeNodeID
+
+	filtering          bool
+	filterQuery        string
+	filterVisible      map[TreeNodeID]bool
+	filterOpen         map[TreeNodeID]bool
+	filterSrcChildUIDs func(uid TreeNodeID) []TreeNodeID
+	filterSrcUpdate    func(uid TreeNodeID, branch bool, node fyne.CanvasObject)
+
+	checked map[TreeNodeID]bool
+
+	draggingNode    bool
+	dragUID         TreeNodeID
+	dropTarget      TreeNodeID
+	dropHoverTarget TreeNodeID
+	dropHoverStart  time.Time
+	dropIndicator   *canvas.Rectangle
+
+	animateNextLayout bool
 }
 
 // NewTree returns a new performant tree widget defined by the passed functions.
@@ -72,12 +136,16 @@ func NewTree(childUIDs func(TreeNodeID) []TreeNodeID, isBranch func(TreeNodeID)
 //
 // Since: 2.4
 func NewTreeWithData(data binding.DataTree, createItem func(bool) fyne.CanvasObject, updateItem func(binding.DataItem, bool, fyne.CanvasObject)) *Tree {
+	isBranch := func(id TreeNodeID) bool {
+		children := data.ChildIDs(id)
+		return len(children) > 0
+	}
+	if checker, ok := data.(binding.DataTreeBranchChecker); ok {
+		isBranch = checker.IsBranch // children can stay unresolved until expanded
+	}
 	t := NewTree(
 		data.ChildIDs,
-		func(id TreeNodeID) bool {
-			children := data.ChildIDs(id)
-			return len(children) > 0
-		},
+		isBranch,
 		createItem,
 		func(i TreeNodeID, branch bool, o fyne.CanvasObject) {
 			item, err := data.GetItem(i)
@@ -130,6 +198,7 @@ func (t *Tree) CloseBranch(uid TreeNodeID) {
 	t.ensureOpenMap()
 	t.propertyLock.Lock()
 	t.open[uid] = false
+	t.animateNextLayout = t.Animated && transitionsEnabled()
 	t.propertyLock.Unlock()
 	if f := t.OnBranchClosed; f != nil {
 		f(uid)
@@ -230,6 +299,7 @@ func (t *Tree) OpenBranch(uid TreeNodeID) {
 	t.ensureOpenMap()
 	t.propertyLock.Lock()
 	t.open[uid] = true
+	t.animateNextLayout = t.Animated && transitionsEnabled()
 	t.propertyLock.Unlock()
 	if f := t.OnBranchOpened; f != nil {
 		f(uid)
@@ -636,6 +706,8 @@ func (r *treeContentRenderer) Layout(size fyne.Size) {
 	separatorSize := fyne.NewSize(width, separatorThickness)
 	separatorOff := (pad + separatorThickness) / 2
 	hideSeparators := r.treeContent.tree.HideSeparators
+	animate := r.treeContent.tree.animateNextLayout
+	r.treeContent.tree.animateNextLayout = false
 	y := float32(0)
 	// walkAll open branches and obtain nodes to render in scroller's viewport
 	r.treeContent.tree.walkAll(func(uid, _ string, isBranch bool, depth int) {
@@ -682,8 +754,10 @@ func (r *treeContentRenderer) Layout(size fyne.Size) {
 			}
 
 			var n fyne.CanvasObject
+			existed := false
 			if isBranch {
 				b, ok := r.branches[uid]
+				existed = ok
 				if !ok {
 					b = r.getBranch()
 					if f := r.treeContent.tree.UpdateNode; f != nil {
@@ -696,6 +770,7 @@ func (r *treeContentRenderer) Layout(size fyne.Size) {
 				r.objects = append(r.objects, b)
 			} else {
 				l, ok := r.leaves[uid]
+				existed = ok
 				if !ok {
 					l = r.getLeaf()
 					if f := r.treeContent.tree.UpdateNode; f != nil {
@@ -708,7 +783,12 @@ func (r *treeContentRenderer) Layout(size fyne.Size) {
 				r.objects = append(r.objects, l)
 			}
 			if n != nil {
-				n.Move(fyne.NewPos(0, y))
+				pos := fyne.NewPos(0, y)
+				if animate && existed {
+					animateMove(n, pos) // slide nodes revealed or hidden by a toggle
+				} else {
+					n.Move(pos)
+				}
 				n.Resize(fyne.NewSize(width, m.Height))
 			}
 		}
@@ -736,6 +816,10 @@ func (r *treeContentRenderer) Layout(size fyne.Size) {
 		}
 	}
 
+	if ind := r.treeContent.tree.dropIndicator; ind != nil && ind.Visible() {
+		r.objects = append(r.objects, ind)
+	}
+
 	r.branches = branches
 	r.leaves = leaves
 }
@@ -838,6 +922,7 @@ func (r *treeContentRenderer) getLeaf() (l *leaf) {
 var _ desktop.Hoverable = (*treeNode)(nil)
 var _ fyne.CanvasObject = (*treeNode)(nil)
 var _ fyne.Tappable = (*treeNode)(nil)
+var _ fyne.SecondaryTappable = (*treeNode)(nil)
 
 type treeNode struct {
 	BaseWidget
@@ -846,6 +931,7 @@ type treeNode struct {
 	depth    int
 	hovered  bool
 	icon     fyne.CanvasObject
+	check    *treeCheck
 	isBranch bool
 	content  fyne.CanvasObject
 }
@@ -905,6 +991,15 @@ func (n *treeNode) Tapped(*fyne.PointEvent) {
 	}
 }
 
+// TappedSecondary is called when a secondary tap is captured over this node.
+// It shows the menu provided by the tree's NodeMenu callback, if any.
+func (n *treeNode) TappedSecondary(e *fyne.PointEvent) {
+	if n.tree == nil || n.tree.NodeMenu == nil {
+		return
+	}
+	showContextMenu(n.tree.NodeMenu(n.uid), n.super(), e.AbsolutePosition)
+}
+
 func (n *treeNode) partialRefresh() {
 	if r := cache.Renderer(n.super()); r != nil {
 		r.(*treeNodeRenderer).partialRefresh()
@@ -941,6 +1036,11 @@ func (r *treeNodeRenderer) Layout(size fyne.Size) {
 	}
 	x += iconSize
 	x += pad
+	if c := r.treeNode.check; c != nil && !c.Hidden {
+		c.Move(fyne.NewPos(x, y))
+		c.Resize(fyne.NewSize(iconSize, size.Height))
+		x += iconSize + pad
+	}
 	if r.treeNode.content != nil {
 		r.treeNode.content.Move(fyne.NewPos(x, y))
 		r.treeNode.content.Resize(fyne.NewSize(size.Width-x, size.Height))
@@ -954,7 +1054,7 @@ func (r *treeNodeRenderer) MinSize() (min fyne.Size) {
 	th := r.treeNode.Theme()
 	iconSize := th.Size(theme.SizeNameInlineIcon)
 
-	min.Width += th.Size(theme.SizeNameInnerPadding) + r.treeNode.Indent() + iconSize
+	min.Width += th.Size(theme.SizeNameInnerPadding) + r.treeNode.Indent() + iconSize + r.treeNode.checkWidth(th)
 	min.Height = fyne.Max(min.Height, iconSize)
 	return
 }
@@ -967,6 +1067,9 @@ func (r *treeNodeRenderer) Objects() (objects []fyne.CanvasObject) {
 	if r.treeNode.icon != nil {
 		objects = append(objects, r.treeNode.icon)
 	}
+	if r.treeNode.check != nil {
+		objects = append(objects, r.treeNode.check)
+	}
 	return
 }
 
@@ -986,6 +1089,7 @@ func (r *treeNodeRenderer) partialRefresh() {
 	if r.treeNode.icon != nil {
 		r.treeNode.icon.Refresh()
 	}
+	r.refreshCheck(th)
 	r.background.CornerRadius = th.Size(theme.SizeNameSelectionRadius)
 	if len(r.treeNode.tree.selected) > 0 && r.treeNode.uid == r.treeNode.tree.selected[0] {
 		r.background.FillColor = th.Color(theme.ColorNameSelection, v)
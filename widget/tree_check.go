@@ -0,0 +1,194 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// TreeCheckState describes the checkbox state of a tree node, branches deriving
+// theirs from the nodes below them.
+//
+// Since: 2.6
+type TreeCheckState int
+
+const (
+	// TreeCheckOff is the state of an unchecked node, or a branch with no checked children.
+	//
+	// Since: 2.6
+	TreeCheckOff TreeCheckState = iota
+	// TreeCheckOn is the state of a checked node, or a branch whose children are all checked.
+	//
+	// Since: 2.6
+	TreeCheckOn
+	// TreeCheckPartial is the state of a branch where only some children are checked.
+	//
+	// Since: 2.6
+	TreeCheckPartial
+)
+
+// NodeCheckState returns the checkbox state of the given node. Branches report
+// TreeCheckOn when every node below them is checked and TreeCheckPartial when
+// only some are.
+//
+// Since: 2.6
+func (t *Tree) NodeCheckState(uid TreeNodeID) TreeCheckState {
+	if t.IsBranch != nil && t.ChildUIDs != nil && t.IsBranch(uid) {
+		children := t.ChildUIDs(uid)
+		if len(children) > 0 {
+			all, any := true, false
+			for _, c := range children {
+				switch t.NodeCheckState(c) {
+				case TreeCheckOn:
+					any = true
+				case TreeCheckPartial:
+					any = true
+					all = false
+				default:
+					all = false
+				}
+			}
+			if all {
+				return TreeCheckOn
+			}
+			if any {
+				return TreeCheckPartial
+			}
+			return TreeCheckOff
+		}
+	}
+	if t.checked[uid] {
+		return TreeCheckOn
+	}
+	return TreeCheckOff
+}
+
+// SetNodeChecked checks or unchecks the given node, a branch applying the state
+// to every node below it. OnCheckedChanged is called when the state changed.
+//
+// Since: 2.6
+func (t *Tree) SetNodeChecked(uid TreeNodeID, checked bool) {
+	if !t.setNodeChecked(uid, checked) {
+		return
+	}
+	if f := t.OnCheckedChanged; f != nil {
+		f(uid, checked)
+	}
+	t.Refresh()
+}
+
+func (t *Tree) setNodeChecked(uid TreeNodeID, checked bool) (changed bool) {
+	if t.IsBranch != nil && t.ChildUIDs != nil && t.IsBranch(uid) {
+		children := t.ChildUIDs(uid)
+		if len(children) > 0 {
+			for _, c := range children {
+				if t.setNodeChecked(c, checked) {
+					changed = true
+				}
+			}
+			return changed
+		}
+	}
+
+	if t.checked == nil {
+		t.checked = make(map[TreeNodeID]bool)
+	}
+	if t.checked[uid] == checked {
+		return false
+	}
+	if checked {
+		t.checked[uid] = true
+	} else {
+		delete(t.checked, uid)
+	}
+	return true
+}
+
+// CheckedUIDs returns the checked leaf nodes of this tree in traversal order,
+// including those inside closed branches.
+//
+// Since: 2.6
+func (t *Tree) CheckedUIDs() []TreeNodeID {
+	var ids []TreeNodeID
+	var walk func(uid TreeNodeID)
+	walk = func(uid TreeNodeID) {
+		if t.IsBranch != nil && t.ChildUIDs != nil && t.IsBranch(uid) {
+			if children := t.ChildUIDs(uid); len(children) > 0 {
+				for _, c := range children {
+					walk(c)
+				}
+				return
+			}
+		}
+		if t.checked[uid] {
+			ids = append(ids, uid)
+		}
+	}
+	walk(t.Root)
+	return ids
+}
+
+// SetCheckedUIDs replaces the set of checked nodes with the given leaf ids.
+//
+// Since: 2.6
+func (t *Tree) SetCheckedUIDs(uids []TreeNodeID) {
+	t.checked = make(map[TreeNodeID]bool, len(uids))
+	for _, id := range uids {
+		t.checked[id] = true
+	}
+	t.Refresh()
+}
+
+// refreshCheck lazily adds the checkbox to a node and syncs its icon with the
+// node's state, hiding it again when checkboxes are turned off.
+func (r *treeNodeRenderer) refreshCheck(th fyne.Theme) {
+	n := r.treeNode
+	if !n.tree.ShowCheckboxes {
+		if n.check != nil {
+			n.check.Hide()
+		}
+		return
+	}
+
+	if n.check == nil {
+		n.check = newTreeCheck(n)
+	}
+	name := theme.IconNameCheckButton
+	switch n.tree.NodeCheckState(n.uid) {
+	case TreeCheckOn:
+		name = theme.IconNameCheckButtonChecked
+	case TreeCheckPartial:
+		name = theme.IconNameCheckButtonFill
+	}
+	n.check.Resource = th.Icon(name)
+	n.check.Show()
+	n.check.Refresh()
+}
+
+// checkWidth is the space a node reserves for its checkbox, zero when they are off.
+func (n *treeNode) checkWidth(th fyne.Theme) float32 {
+	if !n.tree.ShowCheckboxes {
+		return 0
+	}
+	return th.Size(theme.SizeNameInlineIcon) + th.Size(theme.SizeNamePadding)
+}
+
+var _ fyne.Tappable = (*treeCheck)(nil)
+
+// treeCheck is the tappable checkbox icon shown before a node's content.
+type treeCheck struct {
+	Icon
+
+	node *treeNode
+}
+
+func newTreeCheck(node *treeNode) *treeCheck {
+	c := &treeCheck{node: node}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+// Tapped toggles this node, a partially checked branch becoming fully checked.
+func (c *treeCheck) Tapped(*fyne.PointEvent) {
+	tree := c.node.tree
+	tree.SetNodeChecked(c.node.uid, tree.NodeCheckState(c.node.uid) != TreeCheckOn)
+}
@@ -0,0 +1,57 @@
+package widget_test
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func checkTestTree() *widget.Tree {
+	tree := widget.NewTreeWithStrings(map[string][]string{
+		"":        {"animals", "plants"},
+		"animals": {"cat", "dog"},
+		"plants":  {"grass", "tree"},
+	})
+	tree.ShowCheckboxes = true
+	return tree
+}
+
+func TestTree_SetNodeChecked(t *testing.T) {
+	tree := checkTestTree()
+	changed := []string{}
+	tree.OnCheckedChanged = func(uid widget.TreeNodeID, checked bool) {
+		changed = append(changed, uid)
+	}
+
+	tree.SetNodeChecked("dog", true)
+	assert.Equal(t, widget.TreeCheckOn, tree.NodeCheckState("dog"))
+	assert.Equal(t, widget.TreeCheckOff, tree.NodeCheckState("cat"))
+	assert.Equal(t, widget.TreeCheckPartial, tree.NodeCheckState("animals"))
+	assert.Equal(t, widget.TreeCheckPartial, tree.NodeCheckState(""))
+	assert.Equal(t, []string{"dog"}, changed)
+
+	tree.SetNodeChecked("cat", true)
+	assert.Equal(t, widget.TreeCheckOn, tree.NodeCheckState("animals"))
+
+	// toggling a branch applies to the whole subtree
+	tree.SetNodeChecked("animals", false)
+	assert.Equal(t, widget.TreeCheckOff, tree.NodeCheckState("animals"))
+	assert.Empty(t, tree.CheckedUIDs())
+
+	tree.SetNodeChecked("dog", false)
+	assert.Equal(t, []string{"dog", "cat", "animals"}, changed, "no callback when the state did not change")
+}
+
+func TestTree_CheckedUIDs(t *testing.T) {
+	tree := checkTestTree()
+
+	tree.SetNodeChecked("plants", true)
+	tree.SetNodeChecked("cat", true)
+	assert.Equal(t, []string{"cat", "grass", "tree"}, tree.CheckedUIDs(), "traversal order, closed branches included")
+
+	tree.SetCheckedUIDs([]widget.TreeNodeID{"dog"})
+	assert.Equal(t, []string{"dog"}, tree.CheckedUIDs())
+	assert.Equal(t, widget.TreeCheckOff, tree.NodeCheckState("plants"))
+}
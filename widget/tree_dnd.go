@@ -0,0 +1,197 @@
+package widget
+
+import (
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// treeDropExpandDelay is how long a drag has to hover over a closed branch
+// before it is opened so the drag can continue into it.
+const treeDropExpandDelay = 600 * time.Millisecond
+
+// Declare conformity with Draggable interface, inherited by branch and leaf.
+var _ fyne.Draggable = (*treeNode)(nil)
+
+// Dragged moves this node towards a drop target, if the tree accepts drops.
+//
+// Implements: fyne.Draggable
+func (n *treeNode) Dragged(e *fyne.DragEvent) {
+	t := n.tree
+	if t.OnNodeDropped == nil {
+		return
+	}
+	if !t.draggingNode {
+		t.draggingNode = true
+		t.dragUID = n.uid
+		t.dropTarget = ""
+	}
+	t.dragOver(n.Position().Y+e.Position.Y, t.dragUID)
+}
+
+// DragEnd drops this node onto the target it was dragged over.
+//
+// Implements: fyne.Draggable
+func (n *treeNode) DragEnd() {
+	t := n.tree
+	if !t.draggingNode {
+		return
+	}
+	t.draggingNode = false
+
+	target, ok := t.endDrag()
+	if ok {
+		if f := t.OnNodeDropped; f != nil {
+			f(t.dragUID, target)
+		}
+	}
+	t.Refresh()
+}
+
+// dragOver tracks a drag at the given content offset, moving the drop indicator
+// over the node under the pointer and expanding closed branches hovered on.
+// The uid is the node being dragged, or the empty string for drops arriving
+// from outside the tree.
+func (t *Tree) dragOver(y float32, uid TreeNodeID) {
+	target, nodeY, height, ok := t.nodeAtOffset(y)
+	if ok && !t.canDropOn(uid, target) {
+		ok = false
+	}
+	if !ok {
+		t.dropTarget = ""
+		t.dropHoverTarget = ""
+		if t.dropIndicator != nil {
+			t.dropIndicator.Hide()
+		}
+		return
+	}
+
+	if target != t.dropHoverTarget {
+		t.dropHoverTarget = target
+		t.dropHoverStart = time.Now()
+	} else if t.IsBranch != nil && t.IsBranch(target) && !t.IsBranchOpen(target) &&
+		time.Since(t.dropHoverStart) >= treeDropExpandDelay {
+		t.OpenBranch(target) // hovered long enough to drag into it
+	}
+
+	t.dropTarget = target
+	t.moveDropIndicator(nodeY, height)
+}
+
+// endDrag clears the drag state, returning the target it would drop on.
+func (t *Tree) endDrag() (target TreeNodeID, ok bool) {
+	target = t.dropTarget
+	t.dropTarget = ""
+	t.dropHoverTarget = ""
+	if t.dropIndicator != nil {
+		t.dropIndicator.Hide()
+	}
+	return target, target != ""
+}
+
+// canDropOn reports whether the dragged node may be dropped on the given target.
+func (t *Tree) canDropOn(uid, target TreeNodeID) bool {
+	if target == uid {
+		return false
+	}
+	if uid != "" && t.nodeContains(uid, target) {
+		return false // cannot drop a branch into itself
+	}
+	if f := t.CanDrop; f != nil {
+		return f(uid, target)
+	}
+	return true
+}
+
+// nodeContains reports whether uid is, or is an ancestor of, the given node.
+func (t *Tree) nodeContains(uid, node TreeNodeID) bool {
+	if uid == node {
+		return true
+	}
+	if t.ChildUIDs == nil || t.IsBranch == nil || !t.IsBranch(uid) {
+		return false
+	}
+	for _, c := range t.ChildUIDs(uid) {
+		if t.nodeContains(c, node) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeAtOffset returns the visible node at the given content offset along with
+// its position and height.
+func (t *Tree) nodeAtOffset(y float32) (uid TreeNodeID, nodeY, height float32, found bool) {
+	pad := t.Theme().Size(theme.SizeNamePadding)
+	rowY := float32(0)
+	t.walkAll(func(id, _ TreeNodeID, branch bool, _ int) {
+		if found {
+			return
+		}
+		// Root node is not rendered unless it has been customized
+		if t.Root == "" && id == "" {
+			return
+		}
+		m := t.leafMinSize
+		if branch {
+			m = t.branchMinSize
+		}
+		if rowY > 0 {
+			rowY += pad
+		}
+		if y >= rowY && y < rowY+m.Height {
+			uid, nodeY, height, found = id, rowY, m.Height, true
+			return
+		}
+		rowY += m.Height
+	})
+	return
+}
+
+// moveDropIndicator outlines the row a drag would drop on, creating the
+// indicator on first use.
+func (t *Tree) moveDropIndicator(y, height float32) {
+	th := t.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	ind := t.dropIndicator
+	if ind == nil {
+		ind = canvas.NewRectangle(color.Transparent)
+		ind.StrokeWidth = th.Size(theme.SizeNameInputBorder) * 2
+		ind.CornerRadius = th.Size(theme.SizeNameSelectionRadius)
+		t.dropIndicator = ind
+	}
+	ind.StrokeColor = th.Color(theme.ColorNamePrimary, v)
+	ind.Move(fyne.NewPos(0, y))
+	ind.Resize(fyne.NewSize(t.Size().Width, height))
+	ind.Show()
+	if t.scroller != nil {
+		t.scroller.Content.Refresh()
+	}
+}
+
+// externalDragOver tracks a drag from another widget at the given absolute
+// position, highlighting the node it would drop on.
+func (t *Tree) externalDragOver(abs fyne.Position) {
+	pos, ok := t.contentPosition(abs)
+	if !ok {
+		t.dragOver(-1, "")
+		return
+	}
+	t.dragOver(pos.Y, "")
+}
+
+// contentPosition translates an absolute canvas position into tree content
+// coordinates, reporting whether it falls within the tree.
+func (t *Tree) contentPosition(abs fyne.Position) (fyne.Position, bool) {
+	treePos := fyne.CurrentApp().Driver().AbsolutePositionForObject(t.super())
+	local := abs.Subtract(treePos)
+	size := t.Size()
+	if local.X < 0 || local.Y < 0 || local.X >= size.Width || local.Y >= size.Height {
+		return fyne.Position{}, false
+	}
+	return local.Add(t.offset), true
+}
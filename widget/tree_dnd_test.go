@@ -0,0 +1,149 @@
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal/cache"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func dndTestTree() *Tree {
+	return NewTreeWithStrings(map[string][]string{
+		"":        {"animals", "plants"},
+		"animals": {"cat", "dog"},
+		"plants":  {"grass", "tree"},
+	})
+}
+
+func visibleTreeNode(tr *Tree, uid TreeNodeID) *treeNode {
+	r := cache.Renderer(tr.scroller.Content.(*treeContent)).(*treeContentRenderer)
+	if b, ok := r.branches[uid]; ok {
+		return b.treeNode
+	}
+	if l, ok := r.leaves[uid]; ok {
+		return l.treeNode
+	}
+	return nil
+}
+
+func dragNodeOver(from, over *treeNode) {
+	from.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(10, over.Position().Y-from.Position().Y+1)},
+		Dragged:    fyne.NewDelta(0, 1),
+	})
+}
+
+func TestTree_DragDrop(t *testing.T) {
+	tr := dndTestTree()
+	droppedUID, droppedTarget := "", ""
+	tr.OnNodeDropped = func(uid, target TreeNodeID) {
+		droppedUID, droppedTarget = uid, target
+	}
+	tr.OpenBranch("animals")
+	w := test.NewTempWindow(t, tr)
+	w.Resize(fyne.NewSize(200, 300))
+
+	dog := visibleTreeNode(tr, "dog")
+	plants := visibleTreeNode(tr, "plants")
+	dragNodeOver(dog, plants)
+	assert.Equal(t, "plants", tr.dropTarget)
+	assert.True(t, tr.dropIndicator.Visible())
+
+	dog.DragEnd()
+	assert.Equal(t, "dog", droppedUID)
+	assert.Equal(t, "plants", droppedTarget)
+	assert.False(t, tr.dropIndicator.Visible())
+}
+
+func TestTree_DragDrop_IntoItself(t *testing.T) {
+	tr := dndTestTree()
+	dropped := false
+	tr.OnNodeDropped = func(uid, target TreeNodeID) { dropped = true }
+	tr.OpenBranch("animals")
+	w := test.NewTempWindow(t, tr)
+	w.Resize(fyne.NewSize(200, 300))
+
+	animals := visibleTreeNode(tr, "animals")
+	cat := visibleTreeNode(tr, "cat")
+	dragNodeOver(animals, cat)
+	assert.Equal(t, "", tr.dropTarget, "a branch cannot be dropped into its own subtree")
+
+	animals.DragEnd()
+	assert.False(t, dropped)
+}
+
+func TestTree_DragDrop_CanDrop(t *testing.T) {
+	tr := dndTestTree()
+	tr.OnNodeDropped = func(uid, target TreeNodeID) {}
+	tr.CanDrop = func(uid, target TreeNodeID) bool { return target == "plants" }
+	tr.OpenBranch("animals")
+	w := test.NewTempWindow(t, tr)
+	w.Resize(fyne.NewSize(200, 300))
+
+	dog := visibleTreeNode(tr, "dog")
+	dragNodeOver(dog, visibleTreeNode(tr, "cat"))
+	assert.Equal(t, "", tr.dropTarget)
+
+	dragNodeOver(dog, visibleTreeNode(tr, "plants"))
+	assert.Equal(t, "plants", tr.dropTarget)
+	dog.DragEnd()
+}
+
+func TestTree_DragDrop_HoverExpands(t *testing.T) {
+	tr := dndTestTree()
+	tr.OnNodeDropped = func(uid, target TreeNodeID) {}
+	tr.OpenBranch("animals")
+	w := test.NewTempWindow(t, tr)
+	w.Resize(fyne.NewSize(200, 300))
+
+	dog := visibleTreeNode(tr, "dog")
+	plants := visibleTreeNode(tr, "plants")
+	dragNodeOver(dog, plants)
+	assert.False(t, tr.IsBranchOpen("plants"))
+
+	tr.dropHoverStart = time.Now().Add(-treeDropExpandDelay)
+	dragNodeOver(dog, plants)
+	assert.True(t, tr.IsBranchOpen("plants"), "hovering over a closed branch opens it")
+	dog.DragEnd()
+}
+
+func TestList_DragToTree(t *testing.T) {
+	tr := dndTestTree()
+	l := NewList(
+		func() int { return 3 },
+		func() fyne.CanvasObject { return NewLabel("template") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText("row")
+		})
+	droppedID, droppedTarget := -1, ""
+	l.DropTree = tr
+	l.OnDroppedToTree = func(id ListItemID, target TreeNodeID) {
+		droppedID, droppedTarget = id, target
+	}
+
+	split := &fyne.Container{Objects: []fyne.CanvasObject{l, tr}}
+	w := test.NewTempWindow(t, split)
+	w.Resize(fyne.NewSize(400, 300))
+	l.Resize(fyne.NewSize(200, 300))
+	tr.Resize(fyne.NewSize(200, 300))
+	tr.Move(fyne.NewPos(200, 0))
+
+	item := visibleListItem(l, 1)
+	target := visibleTreeNode(tr, "plants")
+	driver := fyne.CurrentApp().Driver()
+	abs := driver.AbsolutePositionForObject(target.super()).Add(fyne.NewPos(10, 5))
+	pos := abs.Subtract(driver.AbsolutePositionForObject(item.super()))
+	item.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: pos},
+		Dragged:    fyne.NewDelta(4, 0),
+	})
+	assert.Equal(t, "plants", tr.dropTarget)
+
+	item.DragEnd()
+	assert.Equal(t, 1, droppedID)
+	assert.Equal(t, "plants", droppedTarget)
+}
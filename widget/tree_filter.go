@@ -0,0 +1,145 @@
+package widget
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+// Filter hides the nodes of this tree that do not match the given query, keeping
+// the ancestors of every match visible and expanding them so the matches can be
+// seen. Matching is a case-insensitive substring test on the node id unless
+// FilterMatch is set, and matched label text is highlighted. An empty query
+// clears the filter, restoring the expansion state from before filtering began.
+//
+// Since: 2.6
+func (t *Tree) Filter(query string) {
+	if query == "" {
+		t.clearFilter()
+		return
+	}
+
+	if !t.filtering {
+		t.filtering = true
+		if t.filterSrcChildUIDs == nil { // the first filter installs the wrappers
+			t.filterSrcChildUIDs = t.ChildUIDs
+			t.filterSrcUpdate = t.UpdateNode
+			t.UpdateNode = t.filteredUpdateNode
+		}
+		t.ChildUIDs = t.filteredChildUIDs
+
+		t.ensureOpenMap()
+		t.propertyLock.RLock()
+		saved := make(map[TreeNodeID]bool, len(t.open))
+		for id, open := range t.open {
+			saved[id] = open
+		}
+		t.propertyLock.RUnlock()
+		t.filterOpen = saved // restored when the filter is cleared
+	}
+
+	t.filterQuery = query
+	t.rebuildFilter()
+	t.Refresh()
+}
+
+// clearFilter returns the tree to its unfiltered nodes and expansion state.
+func (t *Tree) clearFilter() {
+	if !t.filtering {
+		return
+	}
+	t.filtering = false
+	t.filterQuery = ""
+	t.filterVisible = nil
+	t.ChildUIDs = t.filterSrcChildUIDs
+
+	t.propertyLock.Lock()
+	t.open = t.filterOpen
+	t.propertyLock.Unlock()
+	t.filterOpen = nil
+	t.Refresh()
+}
+
+// matchesFilter reports whether a single node matches the current query.
+func (t *Tree) matchesFilter(uid TreeNodeID) bool {
+	if t.filterQuery == "" {
+		return false
+	}
+	if f := t.FilterMatch; f != nil {
+		return f(uid, t.filterQuery)
+	}
+	return strings.Contains(strings.ToLower(uid), strings.ToLower(t.filterQuery))
+}
+
+// rebuildFilter recomputes the set of visible nodes, matches and their
+// ancestors, and expands every visible branch.
+func (t *Tree) rebuildFilter() {
+	visible := make(map[TreeNodeID]bool)
+	var walk func(uid TreeNodeID) bool
+	walk = func(uid TreeNodeID) bool {
+		matched := t.matchesFilter(uid)
+		if f := t.filterSrcChildUIDs; f != nil {
+			for _, c := range f(uid) {
+				if walk(c) {
+					matched = true
+				}
+			}
+		}
+		if matched {
+			visible[uid] = true
+		}
+		return matched
+	}
+	walk(t.Root)
+	t.filterVisible = visible
+
+	t.propertyLock.Lock()
+	t.open = make(map[TreeNodeID]bool, len(visible))
+	for id := range visible {
+		t.open[id] = true
+	}
+	t.propertyLock.Unlock()
+}
+
+// filteredChildUIDs narrows the children of a node to those leading to a match,
+// installed as ChildUIDs while the tree is filtered.
+func (t *Tree) filteredChildUIDs(uid TreeNodeID) []TreeNodeID {
+	children := t.filterSrcChildUIDs(uid)
+	filtered := make([]TreeNodeID, 0, len(children))
+	for _, c := range children {
+		if t.filterVisible[c] {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// filteredUpdateNode applies the original node update and then highlights the
+// text of nodes matching the filter. It stays installed once filtering has been
+// used, so highlights are removed again when the filter is cleared.
+func (t *Tree) filteredUpdateNode(uid TreeNodeID, branch bool, node fyne.CanvasObject) {
+	if f := t.filterSrcUpdate; f != nil {
+		f(uid, branch, node)
+	}
+	highlightFilterMatch(node, t.matchesFilter(uid))
+}
+
+// highlightFilterMatch raises the importance of label text in nodes matching the
+// filter, lowering it again on templates reused for other nodes.
+func highlightFilterMatch(o fyne.CanvasObject, matched bool) {
+	switch c := o.(type) {
+	case *Label:
+		importance := MediumImportance
+		if matched {
+			importance = HighImportance
+		}
+		if c.Importance != importance {
+			c.Importance = importance
+			c.Refresh()
+		}
+	case *fyne.Container:
+		for _, child := range c.Objects {
+			highlightFilterMatch(child, matched)
+		}
+	}
+}
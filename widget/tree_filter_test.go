@@ -0,0 +1,58 @@
+package widget_test
+
+import (
+	"strings"
+	"testing"
+
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var filterTreeData = map[string][]string{
+	"":        {"animals", "plants"},
+	"animals": {"cat", "dog"},
+	"plants":  {"grass", "tree"},
+}
+
+func TestTree_Filter(t *testing.T) {
+	tree := widget.NewTreeWithStrings(filterTreeData)
+	tree.OpenBranch("animals")
+
+	tree.Filter("do")
+	assert.Equal(t, []string{"animals"}, tree.ChildUIDs(""))
+	assert.Equal(t, []string{"dog"}, tree.ChildUIDs("animals"))
+	assert.True(t, tree.IsBranchOpen("animals"), "ancestors of matches are expanded")
+
+	tree.Filter("gras")
+	assert.Equal(t, []string{"plants"}, tree.ChildUIDs(""))
+	assert.True(t, tree.IsBranchOpen("plants"))
+
+	tree.Filter("")
+	assert.Equal(t, []string{"animals", "plants"}, tree.ChildUIDs(""))
+	assert.True(t, tree.IsBranchOpen("animals"), "the expansion state from before filtering is restored")
+	assert.False(t, tree.IsBranchOpen("plants"))
+}
+
+func TestTree_Filter_NoMatch(t *testing.T) {
+	tree := widget.NewTreeWithStrings(filterTreeData)
+
+	tree.Filter("missing")
+	assert.Empty(t, tree.ChildUIDs(""))
+
+	tree.Filter("")
+	assert.Equal(t, []string{"animals", "plants"}, tree.ChildUIDs(""))
+}
+
+func TestTree_Filter_CustomMatch(t *testing.T) {
+	tree := widget.NewTreeWithStrings(filterTreeData)
+	tree.FilterMatch = func(uid widget.TreeNodeID, query string) bool {
+		return strings.HasPrefix(uid, query)
+	}
+
+	tree.Filter("Dog")
+	assert.Empty(t, tree.ChildUIDs(""), "the custom match is case-sensitive")
+
+	tree.Filter("dog")
+	assert.Equal(t, []string{"dog"}, tree.ChildUIDs("animals"))
+}
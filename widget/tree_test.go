@@ -48,6 +48,38 @@ func TestNewTreeWithData(t *testing.T) {
 	assert.GreaterOrEqual(t, tree.MinSize().Width, template.MinSize().Width)
 }
 
+type branchCheckerTree struct {
+	binding.DataTree
+
+	branches map[string]bool
+	checks   int
+}
+
+func (b *branchCheckerTree) IsBranch(id string) bool {
+	b.checks++
+	return b.branches[id]
+}
+
+func TestNewTreeWithData_BranchChecker(t *testing.T) {
+	data := binding.NewStringTree()
+	data.Append("", "1", "Branch Item")
+	data.Append("1", "2", "Leaf Item")
+
+	checker := &branchCheckerTree{DataTree: data, branches: map[string]bool{"": true, "1": true}}
+	tree := widget.NewTreeWithData(checker,
+		func(bool) fyne.CanvasObject {
+			return widget.NewLabel("Template Object")
+		},
+		func(data binding.DataItem, _ bool, item fyne.CanvasObject) {
+			item.(*widget.Label).Bind(data.(binding.String))
+		},
+	)
+
+	assert.True(t, tree.IsBranch("1"), "branches are resolved through the checker")
+	assert.False(t, tree.IsBranch("2"))
+	assert.Equal(t, 2, checker.checks)
+}
+
 func TestTree_OpenClose(t *testing.T) {
 	t.Run("Exists", func(t *testing.T) {
 		tree := widget.NewTreeWithStrings(treeData)
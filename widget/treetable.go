@@ -0,0 +1,264 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// TreeTable combines the hierarchy of Tree with the columns of Table. The first column
+// shows each node indented by its depth with a disclosure arrow on branches, while the
+// remaining columns stay aligned across all rows. Children are requested lazily, only
+// when their parent branch is opened.
+//
+// Since: 2.6
+type TreeTable struct {
+	Table
+
+	// ChildUIDs returns a sorted slice of children for the given node, with the empty
+	// TreeNodeID representing the root of the hierarchy.
+	ChildUIDs func(uid TreeNodeID) []TreeNodeID `json:"-"`
+	// IsBranch returns true if the given TreeNodeID represents a branch.
+	IsBranch func(uid TreeNodeID) bool `json:"-"`
+	// CreateNodeCell returns a new template object to show in one cell of a node row.
+	CreateNodeCell func() fyne.CanvasObject `json:"-"`
+	// UpdateNodeCell applies the data of the given node and column to a template cell.
+	UpdateNodeCell func(uid TreeNodeID, col int, template fyne.CanvasObject) `json:"-"`
+	// OnBranchClosed is called when a branch is closed.
+	OnBranchClosed func(uid TreeNodeID) `json:"-"`
+	// OnBranchOpened is called when a branch is opened.
+	OnBranchOpened func(uid TreeNodeID) `json:"-"`
+
+	columns      func() int
+	open         map[TreeNodeID]bool
+	visibleNodes []TreeNodeID
+	nodeDepths   []int
+}
+
+// NewTreeTable returns a new tree-table widget with the given number of columns,
+// hierarchy callbacks and cell template callbacks.
+//
+// Since: 2.6
+func NewTreeTable(columns func() int, childUIDs func(TreeNodeID) []TreeNodeID, isBranch func(TreeNodeID) bool,
+	create func() fyne.CanvasObject, update func(TreeNodeID, int, fyne.CanvasObject)) *TreeTable {
+	t := &TreeTable{ChildUIDs: childUIDs, IsBranch: isBranch, CreateNodeCell: create, UpdateNodeCell: update,
+		columns: columns, open: make(map[TreeNodeID]bool)}
+	t.Length = t.dataSize
+	t.CreateCell = t.createNodeCell
+	t.UpdateCell = t.updateNodeCell
+	t.ExtendBaseWidget(t)
+	t.rebuild()
+	return t
+}
+
+// CloseBranch closes the branch with the given TreeNodeID.
+func (t *TreeTable) CloseBranch(uid TreeNodeID) {
+	if !t.open[uid] {
+		return
+	}
+	delete(t.open, uid)
+	if f := t.OnBranchClosed; f != nil {
+		f(uid)
+	}
+	t.Refresh()
+}
+
+// IsBranchOpen returns true if the branch with the given TreeNodeID is expanded.
+func (t *TreeTable) IsBranchOpen(uid TreeNodeID) bool {
+	return t.open[uid]
+}
+
+// NodeForRow returns the TreeNodeID shown at the given visible row,
+// or an empty string if the row is out of bounds.
+func (t *TreeTable) NodeForRow(row int) TreeNodeID {
+	if row < 0 || row >= len(t.visibleNodes) {
+		return ""
+	}
+	return t.visibleNodes[row]
+}
+
+// OpenBranch opens the branch with the given TreeNodeID.
+func (t *TreeTable) OpenBranch(uid TreeNodeID) {
+	if t.IsBranch == nil || !t.IsBranch(uid) || t.open[uid] {
+		return
+	}
+	t.open[uid] = true
+	if f := t.OnBranchOpened; f != nil {
+		f(uid)
+	}
+	t.Refresh()
+}
+
+// Refresh updates this tree-table to the latest state of the hierarchy.
+func (t *TreeTable) Refresh() {
+	t.rebuild()
+	t.Table.Refresh()
+}
+
+// ToggleBranch flips the open state of the branch with the given TreeNodeID.
+func (t *TreeTable) ToggleBranch(uid TreeNodeID) {
+	if t.open[uid] {
+		t.CloseBranch(uid)
+	} else {
+		t.OpenBranch(uid)
+	}
+}
+
+func (t *TreeTable) createNodeCell() fyne.CanvasObject {
+	var content fyne.CanvasObject
+	if f := t.CreateNodeCell; f != nil {
+		content = f()
+	} else {
+		content = NewLabel("")
+	}
+	return newTreeTableCell(content)
+}
+
+func (t *TreeTable) dataSize() (rows, cols int) {
+	cols = 1
+	if f := t.columns; f != nil {
+		cols = f()
+	}
+	return len(t.visibleNodes), cols
+}
+
+// rebuild flattens the open parts of the hierarchy into the list of visible rows.
+func (t *TreeTable) rebuild() {
+	t.visibleNodes = t.visibleNodes[:0]
+	t.nodeDepths = t.nodeDepths[:0]
+	childUIDs := t.ChildUIDs
+	if childUIDs == nil {
+		return
+	}
+
+	var walk func(uid TreeNodeID, depth int)
+	walk = func(uid TreeNodeID, depth int) {
+		for _, child := range childUIDs(uid) {
+			t.visibleNodes = append(t.visibleNodes, child)
+			t.nodeDepths = append(t.nodeDepths, depth)
+			if t.open[child] {
+				walk(child, depth+1)
+			}
+		}
+	}
+	walk("", 0)
+}
+
+func (t *TreeTable) updateNodeCell(id TableCellID, o fyne.CanvasObject) {
+	if id.Row >= len(t.visibleNodes) {
+		return
+	}
+	uid := t.visibleNodes[id.Row]
+	cell := o.(*treeTableCell)
+	cell.hierarchy = id.Col == 0
+	if cell.hierarchy {
+		cell.depth = t.nodeDepths[id.Row]
+		cell.branch = t.IsBranch != nil && t.IsBranch(uid)
+		cell.opened = t.open[uid]
+		cell.onToggle = func() {
+			t.ToggleBranch(uid)
+		}
+	}
+	if f := t.UpdateNodeCell; f != nil {
+		f(uid, id.Col, cell.content)
+	}
+	cell.Refresh()
+}
+
+// treeTableCell wraps a template cell, indenting it by the node depth and showing a
+// disclosure arrow for branches in the hierarchy column.
+type treeTableCell struct {
+	BaseWidget
+
+	content   fyne.CanvasObject
+	toggle    *treeTableToggle
+	hierarchy bool
+	branch    bool
+	opened    bool
+	depth     int
+	onToggle  func()
+}
+
+func newTreeTableCell(content fyne.CanvasObject) *treeTableCell {
+	c := &treeTableCell{content: content, toggle: newTreeTableToggle()}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+func (c *treeTableCell) CreateRenderer() fyne.WidgetRenderer {
+	return &treeTableCellRenderer{cell: c}
+}
+
+type treeTableCellRenderer struct {
+	cell *treeTableCell
+}
+
+func (r *treeTableCellRenderer) Destroy() {
+}
+
+func (r *treeTableCellRenderer) Layout(size fyne.Size) {
+	x := r.contentOffset()
+	if r.cell.hierarchy {
+		iconSize := theme.IconInlineSize()
+		r.cell.toggle.Move(fyne.NewPos(x-iconSize-theme.Padding(), (size.Height-iconSize)/2))
+		r.cell.toggle.Resize(fyne.NewSquareSize(iconSize))
+	}
+	r.cell.content.Move(fyne.NewPos(x, 0))
+	r.cell.content.Resize(fyne.NewSize(size.Width-x, size.Height))
+}
+
+func (r *treeTableCellRenderer) MinSize() fyne.Size {
+	min := r.cell.content.MinSize()
+	return fyne.NewSize(min.Width+r.contentOffset(), min.Height)
+}
+
+func (r *treeTableCellRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.cell.toggle, r.cell.content}
+}
+
+func (r *treeTableCellRenderer) Refresh() {
+	cell := r.cell
+	cell.toggle.onTapped = cell.onToggle
+	if cell.hierarchy && cell.branch {
+		if cell.opened {
+			cell.toggle.Resource = theme.MoveDownIcon()
+		} else {
+			cell.toggle.Resource = theme.NavigateNextIcon()
+		}
+		cell.toggle.Show()
+	} else {
+		cell.toggle.Hide()
+	}
+	cell.toggle.Refresh()
+	r.Layout(cell.Size())
+	cell.content.Refresh()
+}
+
+// contentOffset returns the x position of the template content, leaving room for the
+// indentation and disclosure arrow in the hierarchy column.
+func (r *treeTableCellRenderer) contentOffset() float32 {
+	if !r.cell.hierarchy {
+		return 0
+	}
+	iconSize := theme.IconInlineSize()
+	return float32(r.cell.depth)*(iconSize+theme.Padding()) + iconSize + theme.Padding()
+}
+
+var _ fyne.Tappable = (*treeTableToggle)(nil)
+
+type treeTableToggle struct {
+	Icon
+
+	onTapped func()
+}
+
+func newTreeTableToggle() *treeTableToggle {
+	t := &treeTableToggle{}
+	t.ExtendBaseWidget(t)
+	return t
+}
+
+func (t *treeTableToggle) Tapped(*fyne.PointEvent) {
+	if f := t.onTapped; f != nil {
+		f()
+	}
+}
@@ -0,0 +1,99 @@
+package widget
+
+import (
+	"fmt"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func treeTableData() (map[string][]string, *TreeTable) {
+	data := map[string][]string{
+		"":    {"a", "b"},
+		"a":   {"a.1", "a.2"},
+		"a.1": {"a.1.1"},
+	}
+	tt := NewTreeTable(
+		func() int { return 2 },
+		func(uid TreeNodeID) []TreeNodeID { return data[uid] },
+		func(uid TreeNodeID) bool { return len(data[uid]) > 0 },
+		func() fyne.CanvasObject { return NewLabel("template") },
+		func(uid TreeNodeID, col int, o fyne.CanvasObject) {
+			o.(*Label).SetText(fmt.Sprintf("%s:%d", uid, col))
+		})
+	return data, tt
+}
+
+func TestTreeTable(t *testing.T) {
+	test.NewTempApp(t)
+
+	_, tt := treeTableData()
+	rows, cols := tt.Length()
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, 2, cols)
+	assert.Equal(t, "a", tt.NodeForRow(0))
+	assert.Equal(t, "b", tt.NodeForRow(1))
+
+	opened := ""
+	tt.OnBranchOpened = func(uid TreeNodeID) { opened = uid }
+	tt.OpenBranch("a")
+	assert.Equal(t, "a", opened)
+	assert.True(t, tt.IsBranchOpen("a"))
+	rows, _ = tt.Length()
+	assert.Equal(t, 4, rows)
+	assert.Equal(t, "a.1", tt.NodeForRow(1))
+
+	tt.OpenBranch("b") // not a branch, should be ignored
+	assert.False(t, tt.IsBranchOpen("b"))
+
+	tt.ToggleBranch("a.1")
+	rows, _ = tt.Length()
+	assert.Equal(t, 5, rows)
+	assert.Equal(t, "a.1.1", tt.NodeForRow(2))
+
+	closed := ""
+	tt.OnBranchClosed = func(uid TreeNodeID) { closed = uid }
+	tt.CloseBranch("a")
+	assert.Equal(t, "a", closed)
+	rows, _ = tt.Length()
+	assert.Equal(t, 2, rows)
+	assert.True(t, tt.IsBranchOpen("a.1")) // hidden but still open
+}
+
+func TestTreeTable_CellContent(t *testing.T) {
+	test.NewTempApp(t)
+
+	_, tt := treeTableData()
+	tt.OpenBranch("a")
+
+	cell := tt.CreateCell().(*treeTableCell)
+	tt.UpdateCell(TableCellID{Row: 1, Col: 0}, cell)
+	assert.Equal(t, "a.1:0", cell.content.(*Label).Text)
+	assert.True(t, cell.hierarchy)
+	assert.True(t, cell.branch)
+	assert.False(t, cell.opened)
+	assert.Equal(t, 1, cell.depth)
+
+	cell.toggle.Tapped(&fyne.PointEvent{})
+	assert.True(t, tt.IsBranchOpen("a.1"))
+
+	tt.UpdateCell(TableCellID{Row: 1, Col: 1}, cell)
+	assert.Equal(t, "a.1:1", cell.content.(*Label).Text)
+	assert.False(t, cell.hierarchy)
+}
+
+func TestTreeTable_Render(t *testing.T) {
+	test.NewTempApp(t)
+
+	_, tt := treeTableData()
+	w := test.NewWindow(tt)
+	defer w.Close()
+	w.Resize(fyne.NewSize(300, 200))
+
+	tt.OpenBranch("a")
+	rows, _ := tt.Length()
+	assert.Equal(t, 4, rows)
+}
@@ -147,6 +147,26 @@ func (w *BaseWidget) Theme() fyne.Theme {
 	return w.themeWithLock()
 }
 
+// SetTheme overrides the theme used by this widget and any content below it,
+// like wrapping it in a container.ThemeOverride. Pass nil to return the widget
+// to the application theme. This should be used sparingly to avoid a jarring
+// user experience.
+//
+// Since: 2.6
+func (w *BaseWidget) SetTheme(th fyne.Theme) {
+	impl := w.super()
+	if impl == nil {
+		return
+	}
+
+	if th == nil {
+		cache.ClearThemeOverride(impl)
+	} else {
+		cache.OverrideTheme(impl, th)
+	}
+	w.Refresh()
+}
+
 func (w *BaseWidget) themeWithLock() fyne.Theme {
 	cached := w.themeCache
 	if cached == nil {
@@ -59,6 +59,19 @@ func TestApplyThemeCalledChild(t *testing.T) {
 	}()
 }
 
+func TestBaseWidget_SetTheme(t *testing.T) {
+	btn := NewButton("Test", nil)
+	w := test.NewTempWindow(t, btn)
+	w.Resize(fyne.NewSize(100, 100))
+
+	custom := test.NewTheme()
+	btn.SetTheme(custom)
+	assert.Same(t, custom, btn.Theme())
+
+	btn.SetTheme(nil)
+	assert.NotSame(t, custom, btn.Theme(), "clearing returns to the app theme")
+}
+
 func TestSimpleRenderer(t *testing.T) {
 	test.NewTempApp(t)
 